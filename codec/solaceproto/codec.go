@@ -0,0 +1,75 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceproto
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/codec"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// ContentType is the content type of payloads encoded by the protobuf codec.
+const ContentType = "application/x-protobuf"
+
+func init() {
+	codec.Register(Codec())
+}
+
+// Codec returns a codec encoding values implementing proto.Message in the protobuf binary
+// wire format. Payloads are decoded into non-nil pointers to generated message types.
+func Codec() solace.Codec {
+	return protoCodec{}
+}
+
+type protoCodec struct{}
+
+func (protoCodec) ContentType() string {
+	return ContentType
+}
+
+func (protoCodec) Encode(builder solace.OutboundMessageBuilder, value interface{}, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	protoMessage, ok := value.(proto.Message)
+	if !ok {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecUnsupportedValueType, ContentType, value), nil)
+	}
+	payload, err := proto.Marshal(protoMessage)
+	if err != nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecFailedToEncode, ContentType)+err.Error(), err)
+	}
+	return builder.BuildWithByteArrayPayload(payload, codec.WithContentType(ContentType, additionalConfiguration)...)
+}
+
+func (protoCodec) Decode(msg message.InboundMessage, value interface{}) error {
+	protoMessage, ok := value.(proto.Message)
+	if !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecUnsupportedTargetType, ContentType, value), nil)
+	}
+	payload, ok := msg.GetPayloadAsBytes()
+	if !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecMissingPayload, ContentType), nil)
+	}
+	if err := proto.Unmarshal(payload, protoMessage); err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecFailedToDecode, ContentType)+err.Error(), err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceproto_test
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"solace.dev/go/messaging/codec/solaceproto"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/codec"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// recordingBuilder captures the payload and configuration of the built message
+type recordingBuilder struct {
+	solace.OutboundMessageBuilder
+	bytes      []byte
+	properties config.MessagePropertyMap
+}
+
+func (builder *recordingBuilder) BuildWithByteArrayPayload(payload []byte, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	builder.bytes = payload
+	builder.properties = make(config.MessagePropertyMap)
+	for _, provider := range additionalConfiguration {
+		for key, value := range provider.GetConfiguration() {
+			builder.properties[key] = value
+		}
+	}
+	return nil, nil
+}
+
+// fakeInboundMessage returns the configured payload and content type
+type fakeInboundMessage struct {
+	message.InboundMessage
+	bytes       []byte
+	contentType string
+}
+
+func (msg *fakeInboundMessage) GetPayloadAsBytes() ([]byte, bool) {
+	return msg.bytes, msg.bytes != nil
+}
+
+func (msg *fakeInboundMessage) GetHTTPContentType() (string, bool) {
+	return msg.contentType, msg.contentType != ""
+}
+
+func (msg *fakeInboundMessage) GetApplicationMessageType() (string, bool) {
+	return "", false
+}
+
+// fakeDecoder decodes payloads with the protobuf codec
+type fakeDecoder struct{}
+
+func (fakeDecoder) DecodePayload(msg message.InboundMessage, value interface{}) error {
+	return solaceproto.Codec().Decode(msg, value)
+}
+
+func encode(t *testing.T, value interface{}) *fakeInboundMessage {
+	builder := &recordingBuilder{}
+	if _, err := solaceproto.Codec().Encode(builder, value); err != nil {
+		t.Fatalf("expected encode to succeed, got %s", err)
+	}
+	contentType, _ := builder.properties[config.MessagePropertyHTTPContentType].(string)
+	return &fakeInboundMessage{bytes: builder.bytes, contentType: contentType}
+}
+
+func TestCodecEncodeSetsContentType(t *testing.T) {
+	msg := encode(t, wrapperspb.String("hello"))
+	if msg.contentType != solaceproto.ContentType {
+		t.Errorf("expected content type %s, got %s", solaceproto.ContentType, msg.contentType)
+	}
+	builder := &recordingBuilder{}
+	override := config.MessagePropertyMap{config.MessagePropertyHTTPContentType: "application/vnd.greeting+protobuf"}
+	if _, err := solaceproto.Codec().Encode(builder, wrapperspb.String("hello"), override); err != nil {
+		t.Fatalf("expected encode to succeed, got %s", err)
+	}
+	if builder.properties[config.MessagePropertyHTTPContentType] != "application/vnd.greeting+protobuf" {
+		t.Errorf("expected additional configuration to override the content type, got %v", builder.properties[config.MessagePropertyHTTPContentType])
+	}
+}
+
+func TestCodecEncodeUnsupportedValue(t *testing.T) {
+	_, err := solaceproto.Codec().Encode(&recordingBuilder{}, "hello")
+	if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected illegal argument error, got %v", err)
+	}
+}
+
+func TestDecodePayloadWithProtobufCodec(t *testing.T) {
+	msg := encode(t, wrapperspb.String("hello"))
+	decoded, err := codec.DecodePayload[*wrapperspb.StringValue](msg)
+	if err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if decoded.GetValue() != "hello" {
+		t.Errorf("expected decoded value hello, got %v", decoded)
+	}
+	decoded, err = codec.DecodePayloadWith[*wrapperspb.StringValue](fakeDecoder{}, msg)
+	if err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if decoded.GetValue() != "hello" {
+		t.Errorf("expected decoded value hello, got %v", decoded)
+	}
+}
+
+func TestDecodePayloadWithProtobufCodecInvalidPayload(t *testing.T) {
+	decoded, err := codec.DecodePayload[*wrapperspb.StringValue](&fakeInboundMessage{bytes: []byte{0xff}, contentType: solaceproto.ContentType})
+	if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected illegal argument error, got %v", err)
+	}
+	if decoded != nil {
+		t.Errorf("expected nil value on error, got %v", decoded)
+	}
+}
@@ -0,0 +1,29 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package solaceproto provides a solace.Codec carrying Protocol Buffers messages as binary
+// message payloads for the Solace PubSub+ API for Go.
+//
+// The codec is registered in the default codec registry when the package is imported, so that
+// received messages carrying the protobuf content type can be decoded with codec.DecodePayload:
+//
+//	builder := messagingService.MessageBuilder().WithCodec(solaceproto.Codec())
+//	msg, _ := builder.BuildWithValue(&pb.Order{Id: "1234"})
+//	...
+//	order, err := codec.DecodePayload[*pb.Order](received)
+//
+// It is provided as a separate module such that the API itself does not depend on protobuf.
+package solaceproto
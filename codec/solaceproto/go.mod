@@ -0,0 +1,10 @@
+module solace.dev/go/messaging/codec/solaceproto
+
+go 1.18
+
+require (
+	google.golang.org/protobuf v1.27.1
+	solace.dev/go/messaging v0.0.0
+)
+
+replace solace.dev/go/messaging v0.0.0 => ../../
@@ -1,3 +1,3 @@
 module solace.dev/go/messaging
 
-go 1.17
+go 1.18
@@ -0,0 +1,34 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+// Package solacemetrics exports the metrics.APIMetrics of the Solace PubSub+ API for Go to
+// Prometheus and OpenTelemetry.
+//
+// An Exporter reads every metric of the services added to it whenever it is collected, so that
+// applications no longer need to poll APIMetrics.GetValue. Every metric is exported as a counter
+// labelled by the application ID and client name of the service, and the counters remain
+// monotonic when the metrics are reset with APIMetrics.Reset.
+//
+//	exporter := solacemetrics.New()
+//	exporter.AddService(messagingService)
+//	prometheus.MustRegister(exporter)
+//
+// or, for OpenTelemetry:
+//
+//	registration, err := exporter.RegisterMeterProvider(meterProvider)
+//
+// It is provided as a separate module such that the API itself does not depend on Prometheus
+// or OpenTelemetry.
+package solacemetrics
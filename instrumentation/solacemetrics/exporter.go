@@ -0,0 +1,212 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacemetrics
+
+import (
+	"sync"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+const (
+	// instrumentationName is the name of the meter used to create instruments.
+	instrumentationName = "solace.dev/go/messaging/instrumentation/solacemetrics"
+
+	defaultNamespace = "solace_messaging"
+)
+
+// Labels set on every exported metric.
+const (
+	// LabelApplicationID carries the application ID of the MessagingService.
+	LabelApplicationID = "application_id"
+	// LabelClientName carries the client name of the MessagingService.
+	LabelClientName = "client_name"
+)
+
+// Option configures an Exporter.
+type Option func(*options)
+
+type options struct {
+	namespace   string
+	resetGauges bool
+}
+
+// WithNamespace sets the prefix of the exported metric names. Defaults to "solace_messaging".
+// The namespace is joined to the metric name with "_" for Prometheus and "." for OpenTelemetry.
+func WithNamespace(namespace string) Option {
+	return func(opts *options) {
+		if namespace != "" {
+			opts.namespace = namespace
+		}
+	}
+}
+
+// WithResetGauges additionally exports every metric as a gauge holding the value returned by
+// metrics.APIMetrics.GetValue, that is the value accumulated since the last call to Reset.
+// The gauges carry the suffix "_since_reset".
+func WithResetGauges() Option {
+	return func(opts *options) {
+		opts.resetGauges = true
+	}
+}
+
+// ServiceOption configures how the metrics of a single MessagingService are labelled.
+type ServiceOption func(*trackedService)
+
+// WithApplicationID overrides the value of the application_id label, which defaults to
+// the value returned by solace.MessagingService.GetApplicationID.
+func WithApplicationID(applicationID string) ServiceOption {
+	return func(tracked *trackedService) {
+		tracked.applicationID = applicationID
+	}
+}
+
+// Exporter exports the metrics.APIMetrics of one or more MessagingService instances.
+// Every metric up to metrics.MetricCount is exported as a counter labelled by application ID
+// and client name. Exporter implements prometheus.Collector, and can be bridged to an
+// OpenTelemetry meter provider with RegisterMeterProvider.
+//
+// metrics.APIMetrics.Reset sets all values back to zero, which would break the monotonic
+// semantics expected of counters. The exporter detects a reset through metrics.ResetCounter, which
+// is implemented by the metrics of every MessagingService of this API, and carries the values
+// observed on the previous collection forward, such that the exported counters never decrease.
+// Increments made between the last collection and the reset are lost. For metrics that do not
+// implement metrics.ResetCounter, a reset is only detected when a value decreases between two
+// collections, and is missed if the value climbs back past the previous one in between.
+// The raw values can be exported as gauges in addition to the counters with WithResetGauges.
+type Exporter struct {
+	options
+	lock     sync.Mutex
+	services []*trackedService
+}
+
+// New creates a new Exporter with no services. Services are added with AddService.
+func New(opts ...Option) *Exporter {
+	exporter := &Exporter{
+		options: options{namespace: defaultNamespace},
+	}
+	for _, opt := range opts {
+		opt(&exporter.options)
+	}
+	return exporter
+}
+
+// AddService adds the given MessagingService to the exporter. Adding a service that is
+// already exported has no effect.
+func (exporter *Exporter) AddService(service solace.MessagingService, opts ...ServiceOption) {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	for _, tracked := range exporter.services {
+		if tracked.service == service {
+			return
+		}
+	}
+	tracked := &trackedService{
+		service:       service,
+		applicationID: service.GetApplicationID(),
+		clientName:    service.GetClientName(),
+	}
+	if counter, ok := service.Metrics().(metrics.ResetCounter); ok {
+		tracked.resetCount = counter.GetResetCount()
+	}
+	for _, opt := range opts {
+		opt(tracked)
+	}
+	exporter.services = append(exporter.services, tracked)
+}
+
+// RemoveService removes the given MessagingService from the exporter, typically after it
+// is disconnected. Its metrics are no longer reported on subsequent collections.
+func (exporter *Exporter) RemoveService(service solace.MessagingService) {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	for i, tracked := range exporter.services {
+		if tracked.service == service {
+			exporter.services = append(exporter.services[:i], exporter.services[i+1:]...)
+			return
+		}
+	}
+}
+
+// serviceSample holds the values of all metrics of a service at the time of a collection.
+type serviceSample struct {
+	applicationID, clientName string
+	// cumulative holds the monotonic values including any values carried over resets.
+	cumulative [metrics.MetricCount]uint64
+	// current holds the values since the last reset.
+	current [metrics.MetricCount]uint64
+}
+
+// collect samples all metrics of all exported services
+func (exporter *Exporter) collect() []serviceSample {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	samples := make([]serviceSample, len(exporter.services))
+	for i, tracked := range exporter.services {
+		samples[i] = tracked.sample()
+	}
+	return samples
+}
+
+// trackedService holds the state required to keep the counters of a service monotonic.
+type trackedService struct {
+	service                   solace.MessagingService
+	applicationID, clientName string
+	// last holds the values observed on the previous sample.
+	last [metrics.MetricCount]uint64
+	// resetCount holds the reset count observed on the previous sample, if the metrics implement metrics.ResetCounter.
+	resetCount uint64
+	// carried holds the sum of the values observed before each reset.
+	carried [metrics.MetricCount]uint64
+}
+
+// sample reads all metrics of the service, accounting for resets since the previous sample.
+// Must be called while holding the exporter lock.
+func (tracked *trackedService) sample() serviceSample {
+	sample := serviceSample{applicationID: tracked.applicationID, clientName: tracked.clientName}
+	apiMetrics := tracked.service.Metrics()
+	counter, hasResetCount := apiMetrics.(metrics.ResetCounter)
+	reset := false
+	for {
+		var resetCount uint64
+		if hasResetCount {
+			resetCount = counter.GetResetCount()
+		}
+		for i := 0; i < metrics.MetricCount; i++ {
+			sample.current[i] = apiMetrics.GetValue(metrics.Metric(i))
+		}
+		if !hasResetCount {
+			break
+		}
+		// read the values again if they were reset while being read
+		if counter.GetResetCount() == resetCount {
+			reset = resetCount != tracked.resetCount
+			tracked.resetCount = resetCount
+			break
+		}
+	}
+	for i, value := range sample.current {
+		if reset || (!hasResetCount && value < tracked.last[i]) {
+			// the metrics were reset since the previous sample
+			tracked.carried[i] += tracked.last[i]
+		}
+		tracked.last[i] = value
+		sample.cumulative[i] = tracked.carried[i] + value
+	}
+	return sample
+}
@@ -0,0 +1,202 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacemetrics
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+type fakeAPIMetrics struct {
+	values     [metrics.MetricCount]uint64
+	resetCount uint64
+}
+
+func (apiMetrics *fakeAPIMetrics) GetValue(metric metrics.Metric) uint64 {
+	return apiMetrics.values[metric]
+}
+
+func (apiMetrics *fakeAPIMetrics) Reset() {
+	apiMetrics.values = [metrics.MetricCount]uint64{}
+	apiMetrics.resetCount++
+}
+
+func (apiMetrics *fakeAPIMetrics) GetResetCount() uint64 {
+	return apiMetrics.resetCount
+}
+
+// legacyAPIMetrics hides the reset count of the wrapped metrics
+type legacyAPIMetrics struct {
+	metrics.APIMetrics
+}
+
+type fakeMessagingService struct {
+	solace.MessagingService
+	apiMetrics *fakeAPIMetrics
+	legacy     bool
+}
+
+func (service *fakeMessagingService) GetApplicationID() string {
+	return "test-application"
+}
+
+func (service *fakeMessagingService) GetClientName() string {
+	return "test-client"
+}
+
+func (service *fakeMessagingService) Metrics() metrics.APIMetrics {
+	if service.legacy {
+		return legacyAPIMetrics{service.apiMetrics}
+	}
+	return service.apiMetrics
+}
+
+func TestMetricInfosCoverAllMetrics(t *testing.T) {
+	names := make(map[string]bool)
+	for i, info := range metricInfos {
+		if info.name == "" || info.help == "" {
+			t.Errorf("expected metric %d to have a name and description", i)
+		}
+		if names[info.name] {
+			t.Errorf("expected metric names to be unique, found %s twice", info.name)
+		}
+		names[info.name] = true
+	}
+}
+
+func TestExporterCountersAreMonotonicAcrossReset(t *testing.T) {
+	service := &fakeMessagingService{apiMetrics: &fakeAPIMetrics{}}
+	exporter := New()
+	exporter.AddService(service)
+	service.apiMetrics.values[metrics.DirectMessagesSent] = 10
+	if sample := exporter.collect()[0]; sample.cumulative[metrics.DirectMessagesSent] != 10 {
+		t.Errorf("expected 10 messages sent, got %d", sample.cumulative[metrics.DirectMessagesSent])
+	}
+	service.apiMetrics.Reset()
+	service.apiMetrics.values[metrics.DirectMessagesSent] = 4
+	sample := exporter.collect()[0]
+	if sample.cumulative[metrics.DirectMessagesSent] != 14 {
+		t.Errorf("expected counter to carry its value over the reset, got %d", sample.cumulative[metrics.DirectMessagesSent])
+	}
+	if sample.current[metrics.DirectMessagesSent] != 4 {
+		t.Errorf("expected value since reset to be 4, got %d", sample.current[metrics.DirectMessagesSent])
+	}
+	exporter.RemoveService(service)
+	if samples := exporter.collect(); len(samples) != 0 {
+		t.Errorf("expected no samples after removing the service, got %d", len(samples))
+	}
+}
+
+func TestExporterDetectsResetWhenValueClimbsBack(t *testing.T) {
+	service := &fakeMessagingService{apiMetrics: &fakeAPIMetrics{}}
+	exporter := New()
+	exporter.AddService(service)
+	service.apiMetrics.values[metrics.DirectMessagesSent] = 10
+	exporter.collect()
+	service.apiMetrics.Reset()
+	service.apiMetrics.values[metrics.DirectMessagesSent] = 12
+	if sample := exporter.collect()[0]; sample.cumulative[metrics.DirectMessagesSent] != 22 {
+		t.Errorf("expected counter to carry its value over the reset, got %d", sample.cumulative[metrics.DirectMessagesSent])
+	}
+}
+
+func TestExporterDetectsResetWithoutResetCount(t *testing.T) {
+	service := &fakeMessagingService{apiMetrics: &fakeAPIMetrics{}, legacy: true}
+	exporter := New()
+	exporter.AddService(service)
+	service.apiMetrics.values[metrics.DirectMessagesSent] = 10
+	exporter.collect()
+	service.apiMetrics.Reset()
+	service.apiMetrics.values[metrics.DirectMessagesSent] = 4
+	if sample := exporter.collect()[0]; sample.cumulative[metrics.DirectMessagesSent] != 14 {
+		t.Errorf("expected counter to carry its value over the reset, got %d", sample.cumulative[metrics.DirectMessagesSent])
+	}
+}
+
+func TestPrometheusCollector(t *testing.T) {
+	service := &fakeMessagingService{apiMetrics: &fakeAPIMetrics{}}
+	service.apiMetrics.values[metrics.ConnectionAttempts] = 3
+	exporter := New(WithResetGauges())
+	exporter.AddService(service, WithApplicationID("orders"))
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(exporter); err != nil {
+		t.Fatalf("expected exporter to register, got %s", err)
+	}
+	if count := testutil.CollectAndCount(exporter); count != 2*metrics.MetricCount {
+		t.Errorf("expected %d metrics, got %d", 2*metrics.MetricCount, count)
+	}
+	expected := `
+# HELP solace_messaging_connection_attempts_total The total number of TCP connection attempts.
+# TYPE solace_messaging_connection_attempts_total counter
+solace_messaging_connection_attempts_total{application_id="orders",client_name="test-client"} 3
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "solace_messaging_connection_attempts_total"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestOpenTelemetryMeter(t *testing.T) {
+	service := &fakeMessagingService{apiMetrics: &fakeAPIMetrics{}}
+	service.apiMetrics.values[metrics.PersistentMessagesSent] = 7
+	exporter := New()
+	exporter.AddService(service)
+	reader := sdkmetric.NewManualReader()
+	registration, err := exporter.RegisterMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	if err != nil {
+		t.Fatalf("expected meter registration to succeed, got %s", err)
+	}
+	defer registration.Unregister()
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatalf("expected collection to succeed, got %s", err)
+	}
+	found := false
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			if m.Name != "solace_messaging.persistent_messages_sent" {
+				continue
+			}
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			if !ok || !sum.IsMonotonic {
+				t.Fatalf("expected a monotonic sum, got %T", m.Data)
+			}
+			found = true
+			if len(sum.DataPoints) != 1 || sum.DataPoints[0].Value != 7 {
+				t.Fatalf("expected a single data point with value 7, got %v", sum.DataPoints)
+			}
+			attributes := sum.DataPoints[0].Attributes
+			if value, _ := attributes.Value(LabelApplicationID); value.AsString() != "test-application" {
+				t.Errorf("expected application ID test-application, got %s", value.AsString())
+			}
+			if value, _ := attributes.Value(LabelClientName); value.AsString() != "test-client" {
+				t.Errorf("expected client name test-client, got %s", value.AsString())
+			}
+		}
+	}
+	if !found {
+		t.Error("expected persistent messages sent to be exported")
+	}
+}
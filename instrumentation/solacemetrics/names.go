@@ -0,0 +1,74 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacemetrics
+
+import "solace.dev/go/messaging/pkg/solace/metrics"
+
+// metricInfo describes how a metrics.Metric is exported.
+type metricInfo struct {
+	// name is the snake case name of the metric without namespace or unit suffix.
+	name string
+	// help is the description of the metric.
+	help string
+}
+
+// metricInfos holds the exported name and description of every metric, indexed by metrics.Metric.
+var metricInfos = [metrics.MetricCount]metricInfo{
+	metrics.BrokerDiscardNotificationsReceived:        {"broker_discard_notifications_received", "The number of received messages with discard indication set."},
+	metrics.CompressedBytesReceived:                   {"compressed_bytes_received", "The number of bytes received before decompression."},
+	metrics.ConnectionAttempts:                        {"connection_attempts", "The total number of TCP connection attempts."},
+	metrics.ControlBytesReceived:                      {"control_bytes_received", "The number of control (non-data) bytes received by the MessagingService."},
+	metrics.ControlBytesSent:                          {"control_bytes_sent", "The total number of control (non-data) bytes transmitted by the MessagingService."},
+	metrics.ControlMessagesReceived:                   {"control_messages_received", "The total number of control (non-data) messages received by MessagingService."},
+	metrics.ControlMessagesSent:                       {"control_messages_sent", "The total number of control (non-data) messages transmitted by MessagingService."},
+	metrics.DirectBytesReceived:                       {"direct_bytes_received", "The number of direct messaging bytes received across all direct message publishers on the MessagingService."},
+	metrics.DirectBytesSent:                           {"direct_bytes_sent", "The number of direct messaging bytes sent across all direct message publishers on the MessagingService."},
+	metrics.DirectMessagesReceived:                    {"direct_messages_received", "The number of direct messages received across all direct message publishers on the MessagingService."},
+	metrics.DirectMessagesSent:                        {"direct_messages_sent", "The number of direct messages sent across all direct message publishers on the MessagingService."},
+	metrics.InternalDiscardNotifications:              {"internal_discard_notifications", "The number of messages received with internal discard notifications set."},
+	metrics.PersistentAcknowledgeSent:                 {"persistent_acknowledge_sent", "The number of acknowledgements sent for guaranteed messaging across all persistent message receivers on the MessagingService."},
+	metrics.PersistentBytesReceived:                   {"persistent_bytes_received", "The number of persistent bytes received across all persistent message receivers on the MessagingService."},
+	metrics.PersistentBytesRedelivered:                {"persistent_bytes_redelivered", "The number of persistent bytes redelivered across all persistent message publishers on the MessagingService."},
+	metrics.PersistentBytesSent:                       {"persistent_bytes_sent", "The number of persistent bytes sent across all persistent message publishers on the MessagingService."},
+	metrics.PersistentDuplicateMessagesDiscarded:      {"persistent_duplicate_messages_discarded", "The number of guaranteed messages dropped for being duplicates."},
+	metrics.PersistentMessagesReceived:                {"persistent_messages_received", "The number of persistent messages received across all persistent message receivers on the MessagingService."},
+	metrics.PersistentMessagesRedelivered:             {"persistent_messages_redelivered", "The number of persistent messages redelivered across all persistent message publishers on the MessagingService."},
+	metrics.PersistentMessagesSent:                    {"persistent_messages_sent", "The number of persistent messages sent across all persistent message publishers on the MessagingService."},
+	metrics.PersistentNoMatchingFlowMessagesDiscarded: {"persistent_no_matching_flow_messages_discarded", "The number of persistent messages discarded for not having a matching flow on the MessagingService."},
+	metrics.PersistentOutOfOrderMessagesDiscarded:     {"persistent_out_of_order_messages_discarded", "The number of persistent messages discarded for being received out of order across all persistent message receivers on the MessagingService."},
+	metrics.PersistentMessagesAccepted:                {"persistent_messages_accepted", "Number of messages settled with \"ACCEPTED\" outcome."},
+	metrics.PersistentMessagesFailed:                  {"persistent_messages_failed", "Number of messages settled with \"FAILED\" outcome."},
+	metrics.PersistentMessagesRejected:                {"persistent_messages_rejected", "Number of messages settled with \"REJECTED\" outcome."},
+	metrics.PublishMessagesDiscarded:                  {"publish_messages_discarded", "The number of messages discarded due to channel failure."},
+	metrics.PublishedMessagesAcknowledged:             {"published_messages_acknowledged", "The number of guaranteed messages that have been published and acknowledged across all persistent message receivers on the MessagingService."},
+	metrics.PublisherAcknowledgementReceived:          {"publisher_acknowledgement_received", "The number of publisher acknowledgements received by all persistent message publishers on the MessagingService."},
+	metrics.PublisherAcknowledgementTimeouts:          {"publisher_acknowledgement_timeouts", "The number of expired acknowledgement timers across all persistent message publishers on the MessagingService."},
+	metrics.PublisherWindowClosed:                     {"publisher_window_closed", "The number of times the transmit window closed across all message publishers on the MessagingService."},
+	metrics.PublisherWouldBlock:                       {"publisher_would_block", "The number of messages not accepted due to would block (non-blocking publish only)."},
+	metrics.TotalBytesReceived:                        {"total_bytes_received", "The total number of bytes received by the MessagingService and all of its message receivers."},
+	metrics.TotalBytesSent:                            {"total_bytes_sent", "The total number of bytes sent by the MessagingService and all of its message publishers."},
+	metrics.TotalMessagesReceived:                     {"total_messages_received", "The total number of messages received by the MessagingService and all of its receivers."},
+	metrics.TotalMessagesSent:                         {"total_messages_sent", "The total number of messages sent by the MessagingService and all of its publishers."},
+	metrics.TooBigMessagesDiscarded:                   {"too_big_messages_discarded", "The number of messages discarded due to being too large."},
+	metrics.UnknownParameterMessagesDiscarded:         {"unknown_parameter_messages_discarded", "The number of messages discarded due to the presence of an unknown element or unknown protocol in the Solace Message Format (SMF) header."},
+	metrics.ReceivedMessagesTerminationDiscarded:      {"received_messages_termination_discarded", "The number of messages discarded due to a receiver being terminated either by application initiated termination or failure event termination."},
+	metrics.ReceivedMessagesBackpressureDiscarded:     {"received_messages_backpressure_discarded", "The number of messages discarded due to a receiver not having buffer space to queue a message."},
+	metrics.PublishMessagesTerminationDiscarded:       {"publish_messages_termination_discarded", "The number of messages discarded due to a publisher being terminated either by application initiated termination or failure event termination."},
+	metrics.PublishMessagesBackpressureDiscarded:      {"publish_messages_backpressure_discarded", "The number of messages discarded due to a publisher not having buffer space to queue a message when in a buffered backpressure configuration."},
+	metrics.CacheRequestsSent:                         {"cache_requests_sent", "Number of sent cache requests."},
+	metrics.CacheRequestsFailed:                       {"cache_requests_failed", "Number of cache requests that failed."},
+}
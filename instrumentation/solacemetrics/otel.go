@@ -0,0 +1,71 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacemetrics
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// RegisterMeterProvider creates an observable counter for every metric on a meter of the given
+// provider, and an observable gauge for every metric when WithResetGauges is set. The instruments
+// are observed with the metrics of every exported service whenever the meter collects. The names are
+// joined to the namespace with ".", for example "solace_messaging.direct_messages_sent", and
+// are labelled with the application_id and client_name attributes.
+// The returned registration unregisters the callback from the meter.
+func (exporter *Exporter) RegisterMeterProvider(provider metric.MeterProvider) (metric.Registration, error) {
+	meter := provider.Meter(instrumentationName)
+	counters := make([]metric.Int64ObservableCounter, len(metricInfos))
+	instruments := make([]metric.Observable, 0, 2*len(metricInfos))
+	for i, info := range metricInfos {
+		counter, err := meter.Int64ObservableCounter(exporter.namespace+"."+info.name, metric.WithDescription(info.help))
+		if err != nil {
+			return nil, err
+		}
+		counters[i] = counter
+		instruments = append(instruments, counter)
+	}
+	var gauges []metric.Int64ObservableGauge
+	if exporter.resetGauges {
+		gauges = make([]metric.Int64ObservableGauge, len(metricInfos))
+		for i, info := range metricInfos {
+			gauge, err := meter.Int64ObservableGauge(exporter.namespace+"."+info.name+".since_reset", metric.WithDescription(info.help+" Reset with APIMetrics.Reset."))
+			if err != nil {
+				return nil, err
+			}
+			gauges[i] = gauge
+			instruments = append(instruments, gauge)
+		}
+	}
+	return meter.RegisterCallback(func(_ context.Context, observer metric.Observer) error {
+		for _, sample := range exporter.collect() {
+			attributes := metric.WithAttributes(
+				attribute.String(LabelApplicationID, sample.applicationID),
+				attribute.String(LabelClientName, sample.clientName),
+			)
+			for i, counter := range counters {
+				observer.ObserveInt64(counter, int64(sample.cumulative[i]), attributes)
+			}
+			for i, gauge := range gauges {
+				observer.ObserveInt64(gauge, int64(sample.current[i]), attributes)
+			}
+		}
+		return nil
+	}, instruments...)
+}
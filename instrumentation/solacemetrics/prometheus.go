@@ -0,0 +1,68 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacemetrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var labelNames = []string{LabelApplicationID, LabelClientName}
+
+// Describe implements prometheus.Collector. It sends the descriptors of all exported metrics.
+func (exporter *Exporter) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range exporter.counterDescs() {
+		ch <- desc
+	}
+	if exporter.resetGauges {
+		for _, desc := range exporter.gaugeDescs() {
+			ch <- desc
+		}
+	}
+}
+
+// Collect implements prometheus.Collector. It reads the metrics of every exported service.
+func (exporter *Exporter) Collect(ch chan<- prometheus.Metric) {
+	counters := exporter.counterDescs()
+	var gauges []*prometheus.Desc
+	if exporter.resetGauges {
+		gauges = exporter.gaugeDescs()
+	}
+	for _, sample := range exporter.collect() {
+		for i, desc := range counters {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(sample.cumulative[i]), sample.applicationID, sample.clientName)
+		}
+		for i, desc := range gauges {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(sample.current[i]), sample.applicationID, sample.clientName)
+		}
+	}
+}
+
+func (exporter *Exporter) counterDescs() []*prometheus.Desc {
+	descs := make([]*prometheus.Desc, len(metricInfos))
+	for i, info := range metricInfos {
+		descs[i] = prometheus.NewDesc(prometheus.BuildFQName(exporter.namespace, "", info.name+"_total"), info.help, labelNames, nil)
+	}
+	return descs
+}
+
+func (exporter *Exporter) gaugeDescs() []*prometheus.Desc {
+	descs := make([]*prometheus.Desc, len(metricInfos))
+	for i, info := range metricInfos {
+		descs[i] = prometheus.NewDesc(prometheus.BuildFQName(exporter.namespace, "", info.name+"_since_reset"), info.help+" Reset with APIMetrics.Reset.", labelNames, nil)
+	}
+	return descs
+}
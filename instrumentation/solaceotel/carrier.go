@@ -0,0 +1,207 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceotel
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+const (
+	traceparentHeader = "traceparent"
+	tracestateHeader  = "tracestate"
+	baggageHeader     = "baggage"
+
+	// traceparentVersion is the only W3C trace context version written to messages.
+	traceparentVersion = "00"
+	// traceparentSampledFlag is the W3C trace flag indicating that the trace is sampled.
+	traceparentSampledFlag = 0x01
+)
+
+// InboundCarrier adapts a received message to a propagation.TextMapCarrier so that the
+// W3C trace context and baggage carried by the message can be extracted by a propagator.
+// The transport trace context of the message is preferred over the creation trace context.
+// InboundCarrier is read only, calls to Set are ignored.
+type InboundCarrier struct {
+	message message.InboundMessage
+}
+
+// NewInboundCarrier returns a new InboundCarrier reading from the given message.
+func NewInboundCarrier(msg message.InboundMessage) *InboundCarrier {
+	return &InboundCarrier{message: msg}
+}
+
+// Get returns the value for the given key, or an empty string if it is not present.
+// Implements propagation.TextMapCarrier.
+func (carrier *InboundCarrier) Get(key string) string {
+	return getFromMessage(carrier.message, key)
+}
+
+// Set is a no-op as received messages are not modified through an InboundCarrier.
+// Implements propagation.TextMapCarrier.
+func (carrier *InboundCarrier) Set(key string, value string) {}
+
+// Keys returns the keys present on the message. Implements propagation.TextMapCarrier.
+func (carrier *InboundCarrier) Keys() []string {
+	return keysFromMessage(carrier.message)
+}
+
+// OutboundCarrier adapts a message about to be published to a propagation.TextMapCarrier so
+// that a propagator can inject the W3C trace context and baggage into the message.
+// The injected trace context is set as the transport trace context of the message, and also as
+// the creation trace context if the message does not carry one yet.
+type OutboundCarrier struct {
+	message message.OutboundMessage
+
+	traceID    [16]byte
+	spanID     [8]byte
+	sampled    bool
+	hasContext bool
+	traceState *string
+	// transportOnly is set when injecting a producer span, which never becomes the creation context
+	transportOnly bool
+}
+
+// NewOutboundCarrier returns a new OutboundCarrier writing to the given message.
+func NewOutboundCarrier(msg message.OutboundMessage) *OutboundCarrier {
+	return &OutboundCarrier{message: msg}
+}
+
+// Get returns the value for the given key, or an empty string if it is not present.
+// Implements propagation.TextMapCarrier.
+func (carrier *OutboundCarrier) Get(key string) string {
+	return getFromMessage(carrier.message, key)
+}
+
+// Set stores the given key and value on the message. Keys other than traceparent,
+// tracestate and baggage are ignored as they cannot be carried by a Solace message.
+// Implements propagation.TextMapCarrier.
+func (carrier *OutboundCarrier) Set(key string, value string) {
+	switch strings.ToLower(key) {
+	case traceparentHeader:
+		traceID, spanID, sampled, err := parseTraceparent(value)
+		if err != nil {
+			return
+		}
+		carrier.traceID, carrier.spanID, carrier.sampled = traceID, spanID, sampled
+		carrier.hasContext = true
+		carrier.apply()
+	case tracestateHeader:
+		traceState := value
+		carrier.traceState = &traceState
+		// propagators may set the trace state before the trace parent, only apply once both are known
+		if carrier.hasContext {
+			carrier.apply()
+		}
+	case baggageHeader:
+		carrier.message.SetBaggage(value)
+	}
+}
+
+// Keys returns the keys present on the message. Implements propagation.TextMapCarrier.
+func (carrier *OutboundCarrier) Keys() []string {
+	return keysFromMessage(carrier.message)
+}
+
+// apply writes the stored trace context to the message
+func (carrier *OutboundCarrier) apply() {
+	if creationTraceID, _, _, _, ok := carrier.message.GetCreationTraceContext(); !carrier.transportOnly && (!ok || creationTraceID == [16]byte{}) {
+		carrier.message.SetCreationTraceContext(carrier.traceID, carrier.spanID, carrier.sampled, carrier.traceState)
+	}
+	carrier.message.SetTransportTraceContext(carrier.traceID, carrier.spanID, carrier.sampled, carrier.traceState)
+}
+
+var (
+	_ propagation.TextMapCarrier = (*InboundCarrier)(nil)
+	_ propagation.TextMapCarrier = (*OutboundCarrier)(nil)
+)
+
+// getFromMessage reads the value for the given carrier key from the message
+func getFromMessage(msg message.Message, key string) string {
+	switch strings.ToLower(key) {
+	case traceparentHeader:
+		if traceID, spanID, sampled, _, ok := transportOrCreationContext(msg); ok {
+			return formatTraceparent(traceID, spanID, sampled)
+		}
+	case tracestateHeader:
+		if _, _, _, traceState, ok := transportOrCreationContext(msg); ok {
+			return traceState
+		}
+	case baggageHeader:
+		if baggage, ok := msg.GetBaggage(); ok {
+			return baggage
+		}
+	}
+	return ""
+}
+
+// keysFromMessage lists the carrier keys that have a value on the message
+func keysFromMessage(msg message.Message) []string {
+	keys := []string{}
+	for _, key := range []string{traceparentHeader, tracestateHeader, baggageHeader} {
+		if getFromMessage(msg, key) != "" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// transportOrCreationContext returns the transport trace context of the message, falling back to
+// the creation trace context when no valid transport context is present
+func transportOrCreationContext(msg message.Message) (traceID [16]byte, spanID [8]byte, sampled bool, traceState string, ok bool) {
+	traceID, spanID, sampled, traceState, ok = msg.GetTransportTraceContext()
+	if ok && traceID != [16]byte{} && spanID != [8]byte{} {
+		return traceID, spanID, sampled, traceState, true
+	}
+	traceID, spanID, sampled, traceState, ok = msg.GetCreationTraceContext()
+	if ok && traceID != [16]byte{} && spanID != [8]byte{} {
+		return traceID, spanID, sampled, traceState, true
+	}
+	return [16]byte{}, [8]byte{}, false, "", false
+}
+
+// formatTraceparent formats the given trace context as a W3C traceparent header value
+func formatTraceparent(traceID [16]byte, spanID [8]byte, sampled bool) string {
+	var flags byte
+	if sampled {
+		flags |= traceparentSampledFlag
+	}
+	return fmt.Sprintf("%s-%s-%s-%02x", traceparentVersion, hex.EncodeToString(traceID[:]), hex.EncodeToString(spanID[:]), flags)
+}
+
+// parseTraceparent parses a W3C traceparent header value
+func parseTraceparent(value string) (traceID [16]byte, spanID [8]byte, sampled bool, err error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return traceID, spanID, false, fmt.Errorf("invalid traceparent %q", value)
+	}
+	if _, err = hex.Decode(traceID[:], []byte(parts[1])); err != nil {
+		return traceID, spanID, false, err
+	}
+	if _, err = hex.Decode(spanID[:], []byte(parts[2])); err != nil {
+		return traceID, spanID, false, err
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil {
+		return traceID, spanID, false, err
+	}
+	return traceID, spanID, flags[0]&traceparentSampledFlag != 0, nil
+}
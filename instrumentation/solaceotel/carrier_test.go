@@ -0,0 +1,207 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceotel
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+type traceContext struct {
+	traceID    [16]byte
+	spanID     [8]byte
+	sampled    bool
+	traceState string
+	set        bool
+}
+
+// fakeMessage implements the tracing related functions of a message, any other call panics
+type fakeMessage struct {
+	message.InboundMessage
+	creation, transport traceContext
+	baggage             string
+	destination         string
+}
+
+func (msg *fakeMessage) GetCreationTraceContext() ([16]byte, [8]byte, bool, string, bool) {
+	return msg.creation.traceID, msg.creation.spanID, msg.creation.sampled, msg.creation.traceState, msg.creation.set
+}
+
+func (msg *fakeMessage) SetCreationTraceContext(traceID [16]byte, spanID [8]byte, sampled bool, traceState *string) bool {
+	msg.creation = newTraceContext(traceID, spanID, sampled, traceState)
+	return true
+}
+
+func (msg *fakeMessage) GetTransportTraceContext() ([16]byte, [8]byte, bool, string, bool) {
+	return msg.transport.traceID, msg.transport.spanID, msg.transport.sampled, msg.transport.traceState, msg.transport.set
+}
+
+func (msg *fakeMessage) SetTransportTraceContext(traceID [16]byte, spanID [8]byte, sampled bool, traceState *string) bool {
+	msg.transport = newTraceContext(traceID, spanID, sampled, traceState)
+	return true
+}
+
+func (msg *fakeMessage) GetBaggage() (string, bool) {
+	return msg.baggage, true
+}
+
+func (msg *fakeMessage) SetBaggage(baggage string) error {
+	msg.baggage = baggage
+	return nil
+}
+
+func (msg *fakeMessage) GetDestinationName() string {
+	return msg.destination
+}
+
+func (msg *fakeMessage) GetCorrelationID() (string, bool) {
+	return "", false
+}
+
+func (msg *fakeMessage) GetApplicationMessageID() (string, bool) {
+	return "", false
+}
+
+func (msg *fakeMessage) GetReplyToDestination() (resource.Destination, bool) {
+	return nil, false
+}
+
+func (msg *fakeMessage) IsRedelivered() bool {
+	return false
+}
+
+func (msg *fakeMessage) Dispose() {}
+
+func newTraceContext(traceID [16]byte, spanID [8]byte, sampled bool, traceState *string) traceContext {
+	ctx := traceContext{traceID: traceID, spanID: spanID, sampled: sampled, set: true}
+	if traceState != nil {
+		ctx.traceState = *traceState
+	}
+	return ctx
+}
+
+func testSpanContext(t *testing.T) trace.SpanContext {
+	traceID, _ := trace.TraceIDFromHex("79f90916c9a3dad1eb4b328e00469e45")
+	spanID, _ := trace.SpanIDFromHex("3b364712c4e1f17f")
+	traceState, err := trace.ParseTraceState("vendor=value")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		TraceState: traceState,
+		Remote:     true,
+	})
+}
+
+func TestOutboundCarrierInjectSetsCreationAndTransportContext(t *testing.T) {
+	spanContext := testSpanContext(t)
+	msg := &fakeMessage{}
+	propagation.TraceContext{}.Inject(trace.ContextWithRemoteSpanContext(context.Background(), spanContext), NewOutboundCarrier(msg))
+	for name, ctx := range map[string]traceContext{"creation": msg.creation, "transport": msg.transport} {
+		if !ctx.set {
+			t.Fatalf("expected %s context to be set", name)
+		}
+		if trace.TraceID(ctx.traceID) != spanContext.TraceID() {
+			t.Errorf("expected %s trace ID %s, got %x", name, spanContext.TraceID(), ctx.traceID)
+		}
+		if trace.SpanID(ctx.spanID) != spanContext.SpanID() {
+			t.Errorf("expected %s span ID %s, got %x", name, spanContext.SpanID(), ctx.spanID)
+		}
+		if !ctx.sampled {
+			t.Errorf("expected %s context to be sampled", name)
+		}
+		if ctx.traceState != spanContext.TraceState().String() {
+			t.Errorf("expected %s trace state %s, got %s", name, spanContext.TraceState(), ctx.traceState)
+		}
+	}
+}
+
+func TestOutboundCarrierDoesNotOverwriteCreationContext(t *testing.T) {
+	creationTraceID := [16]byte{1}
+	creationSpanID := [8]byte{2}
+	msg := &fakeMessage{}
+	msg.SetCreationTraceContext(creationTraceID, creationSpanID, true, nil)
+	spanContext := testSpanContext(t)
+	propagation.TraceContext{}.Inject(trace.ContextWithRemoteSpanContext(context.Background(), spanContext), NewOutboundCarrier(msg))
+	if msg.creation.traceID != creationTraceID || msg.creation.spanID != creationSpanID {
+		t.Errorf("expected creation context to be preserved, got %x-%x", msg.creation.traceID, msg.creation.spanID)
+	}
+	if trace.SpanID(msg.transport.spanID) != spanContext.SpanID() {
+		t.Errorf("expected transport span ID %s, got %x", spanContext.SpanID(), msg.transport.spanID)
+	}
+}
+
+func TestInboundCarrierExtractPrefersTransportContext(t *testing.T) {
+	spanContext := testSpanContext(t)
+	msg := &fakeMessage{}
+	msg.SetCreationTraceContext([16]byte{1}, [8]byte{2}, false, nil)
+	traceState := spanContext.TraceState().String()
+	msg.SetTransportTraceContext(spanContext.TraceID(), spanContext.SpanID(), true, &traceState)
+
+	extracted := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), NewInboundCarrier(msg)))
+	if !extracted.Equal(spanContext) {
+		t.Errorf("expected extracted span context %v, got %v", spanContext, extracted)
+	}
+}
+
+func TestInboundCarrierExtractFallsBackToCreationContext(t *testing.T) {
+	spanContext := testSpanContext(t)
+	msg := &fakeMessage{}
+	traceState := spanContext.TraceState().String()
+	msg.SetCreationTraceContext(spanContext.TraceID(), spanContext.SpanID(), true, &traceState)
+
+	extracted := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), NewInboundCarrier(msg)))
+	if !extracted.Equal(spanContext) {
+		t.Errorf("expected extracted span context %v, got %v", spanContext, extracted)
+	}
+}
+
+func TestCarrierBaggage(t *testing.T) {
+	msg := &fakeMessage{}
+	NewOutboundCarrier(msg).Set("baggage", "key=value")
+	if got := NewInboundCarrier(msg).Get("baggage"); got != "key=value" {
+		t.Errorf("expected baggage key=value, got %s", got)
+	}
+	keys := NewInboundCarrier(msg).Keys()
+	if len(keys) != 1 || keys[0] != "baggage" {
+		t.Errorf("expected only the baggage key, got %v", keys)
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	traceID, spanID, sampled, err := parseTraceparent("00-79f90916c9a3dad1eb4b328e00469e45-3b364712c4e1f17f-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if formatTraceparent(traceID, spanID, sampled) != "00-79f90916c9a3dad1eb4b328e00469e45-3b364712c4e1f17f-01" {
+		t.Errorf("expected traceparent to round trip, got %s", formatTraceparent(traceID, spanID, sampled))
+	}
+	for _, invalid := range []string{"", "00-abc-def-01", "00-79f90916c9a3dad1eb4b328e00469e4z-3b364712c4e1f17f-01"} {
+		if _, _, _, err := parseTraceparent(invalid); err == nil {
+			t.Errorf("expected error parsing %q", invalid)
+		}
+	}
+}
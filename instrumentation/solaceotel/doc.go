@@ -0,0 +1,35 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package solaceotel provides OpenTelemetry instrumentation for the Solace PubSub+ API for Go.
+//
+// The package offers two levels of integration. InboundCarrier and OutboundCarrier implement
+// propagation.TextMapCarrier on top of the W3C trace context and baggage fields carried natively
+// by Solace messages, and can be used with any propagator to manually inject or extract a
+// span context. Tracing wraps publishers and receivers built from a MessagingService and
+// automatically starts producer and consumer spans around publish, receive, process, ack,
+// settle and request-reply operations.
+//
+// A typical setup wraps the publishers and receivers as they are built:
+//
+//	tracing := solaceotel.New(messagingService, solaceotel.WithTracerProvider(tracerProvider))
+//	publisher, _ := messagingService.CreatePersistentMessagePublisherBuilder().Build()
+//	publisher = tracing.PersistentMessagePublisher(publisher)
+//
+// Message handlers registered through a wrapped receiver can retrieve the context of the
+// span processing the message with ContextFromMessage, so that any downstream work is
+// correlated with the received message.
+package solaceotel
@@ -0,0 +1,286 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceotel
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// DirectMessagePublisher wraps the given publisher so that a producer span is created for
+// every published message and injected into the message as its transport trace context. The
+// parent of the span is the creation trace context of the message, if any.
+func (tracing *Tracing) DirectMessagePublisher(publisher solace.DirectMessagePublisher) solace.DirectMessagePublisher {
+	return &directMessagePublisher{DirectMessagePublisher: publisher, tracing: tracing}
+}
+
+type directMessagePublisher struct {
+	solace.DirectMessagePublisher
+	tracing *Tracing
+}
+
+func (publisher *directMessagePublisher) PublishBytes(bytes []byte, destination *resource.Topic) error {
+	msg, err := publisher.tracing.service.MessageBuilder().BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.Publish(msg, destination)
+}
+
+func (publisher *directMessagePublisher) PublishString(str string, destination *resource.Topic) error {
+	msg, err := publisher.tracing.service.MessageBuilder().BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.Publish(msg, destination)
+}
+
+func (publisher *directMessagePublisher) Publish(msg message.OutboundMessage, destination *resource.Topic) error {
+	_, span := publisher.tracing.startProducerSpan(context.Background(), msg, destination, operationPublish, trace.SpanKindProducer)
+	err := publisher.DirectMessagePublisher.Publish(msg, destination)
+	endSpan(span, err)
+	return err
+}
+
+func (publisher *directMessagePublisher) PublishWithProperties(msg message.OutboundMessage, destination *resource.Topic, properties config.MessagePropertiesConfigurationProvider) error {
+	_, span := publisher.tracing.startProducerSpan(context.Background(), msg, destination, operationPublish, trace.SpanKindProducer)
+	err := publisher.DirectMessagePublisher.PublishWithProperties(msg, destination, properties)
+	endSpan(span, err)
+	return err
+}
+
+// PublishBatch creates a producer span for every message of the batch, ending the spans when the batch is published.
+func (publisher *directMessagePublisher) PublishBatch(msgs []message.OutboundMessage, destinations ...*resource.Topic) error {
+	if len(destinations) != 1 && len(destinations) != len(msgs) {
+		// the wrapped publisher rejects the batch
+		return publisher.DirectMessagePublisher.PublishBatch(msgs, destinations...)
+	}
+	spans := make([]trace.Span, len(msgs))
+	for i, msg := range msgs {
+		destination := destinations[0]
+		if len(destinations) > 1 {
+			destination = destinations[i]
+		}
+		_, spans[i] = publisher.tracing.startProducerSpan(context.Background(), msg, destination, operationPublish, trace.SpanKindProducer)
+	}
+	err := publisher.DirectMessagePublisher.PublishBatch(msgs, destinations...)
+	for _, span := range spans {
+		endSpan(span, err)
+	}
+	return err
+}
+
+// PersistentMessagePublisher wraps the given publisher so that a producer span is created for
+// every published message and injected into the message. The span ends when the publish receipt
+// for the message is received, recording whether the message was persisted. A publish receipt
+// listener already set on the publisher is kept and notified through the wrapper. Publish receipt
+// listeners receive the original user context, and must be set through the wrapper once wrapped.
+func (tracing *Tracing) PersistentMessagePublisher(publisher solace.PersistentMessagePublisher) solace.PersistentMessagePublisher {
+	wrapper := &persistentMessagePublisher{PersistentMessagePublisher: publisher, tracing: tracing}
+	wrapper.SetMessagePublishReceiptListener(publisher.GetMessagePublishReceiptListener())
+	// always register a listener so spans are ended even when the application does not listen for receipts
+	publisher.SetMessagePublishReceiptListener(wrapper.onPublishReceipt)
+	return wrapper
+}
+
+type persistentMessagePublisher struct {
+	solace.PersistentMessagePublisher
+	tracing  *Tracing
+	listener atomic.Value
+}
+
+// tracedUserContext is passed to the wrapped publisher as the user context of a publish
+type tracedUserContext struct {
+	span        trace.Span
+	userContext interface{}
+}
+
+// tracedPublishReceipt is a publish receipt reporting the original user context
+type tracedPublishReceipt struct {
+	solace.PublishReceipt
+	userContext interface{}
+}
+
+func (receipt *tracedPublishReceipt) GetUserContext() interface{} {
+	return receipt.userContext
+}
+
+func (publisher *persistentMessagePublisher) onPublishReceipt(receipt solace.PublishReceipt) {
+	if traced, ok := receipt.GetUserContext().(*tracedUserContext); ok {
+		traced.span.SetAttributes(AttributeSolaceMessagePersisted.Bool(receipt.IsPersisted()))
+		endSpan(traced.span, receipt.GetError())
+		receipt = &tracedPublishReceipt{PublishReceipt: receipt, userContext: traced.userContext}
+	}
+	if listener, ok := publisher.listener.Load().(solace.MessagePublishReceiptListener); ok && listener != nil {
+		listener(receipt)
+	}
+}
+
+func (publisher *persistentMessagePublisher) SetMessagePublishReceiptListener(listener solace.MessagePublishReceiptListener) {
+	publisher.listener.Store(listener)
+}
+
+func (publisher *persistentMessagePublisher) GetMessagePublishReceiptListener() solace.MessagePublishReceiptListener {
+	listener, _ := publisher.listener.Load().(solace.MessagePublishReceiptListener)
+	return listener
+}
+
+func (publisher *persistentMessagePublisher) PublishBytes(bytes []byte, destination resource.Destination) error {
+	msg, err := publisher.tracing.service.MessageBuilder().BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.Publish(msg, destination, nil, nil)
+}
+
+func (publisher *persistentMessagePublisher) PublishString(str string, destination resource.Destination) error {
+	msg, err := publisher.tracing.service.MessageBuilder().BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.Publish(msg, destination, nil, nil)
+}
+
+func (publisher *persistentMessagePublisher) Publish(msg message.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider, userContext interface{}) error {
+	_, span := publisher.tracing.startProducerSpan(context.Background(), msg, destination, operationPublish, trace.SpanKindProducer)
+	err := publisher.PersistentMessagePublisher.Publish(msg, destination, properties, &tracedUserContext{span: span, userContext: userContext})
+	if err != nil {
+		// no receipt will be delivered for a failed publish
+		endSpan(span, err)
+	}
+	return err
+}
+
+func (publisher *persistentMessagePublisher) PublishAwaitAcknowledgement(msg message.OutboundMessage, destination resource.Destination, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error {
+	_, span := publisher.tracing.startProducerSpan(context.Background(), msg, destination, operationPublish, trace.SpanKindProducer)
+	err := publisher.PersistentMessagePublisher.PublishAwaitAcknowledgement(msg, destination, timeout, properties)
+	span.SetAttributes(AttributeSolaceMessagePersisted.Bool(err == nil))
+	endSpan(span, err)
+	return err
+}
+
+func (publisher *persistentMessagePublisher) PublishAwaitAcknowledgementWithContext(ctx context.Context, msg message.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider) error {
+	_, span := publisher.tracing.startProducerSpan(ctx, msg, destination, operationPublish, trace.SpanKindProducer)
+	err := publisher.PersistentMessagePublisher.PublishAwaitAcknowledgementWithContext(ctx, msg, destination, properties)
+	span.SetAttributes(AttributeSolaceMessagePersisted.Bool(err == nil))
+	endSpan(span, err)
+	return err
+}
+
+// PublishBatch creates a producer span for every message of the batch. As batches carry no user context, the
+// spans end when the batch is published rather than on the publish receipts.
+func (publisher *persistentMessagePublisher) PublishBatch(msgs []message.OutboundMessage, destinations ...resource.Destination) error {
+	if len(destinations) != 1 && len(destinations) != len(msgs) {
+		// the wrapped publisher rejects the batch
+		return publisher.PersistentMessagePublisher.PublishBatch(msgs, destinations...)
+	}
+	spans := make([]trace.Span, len(msgs))
+	for i, msg := range msgs {
+		destination := destinations[0]
+		if len(destinations) > 1 {
+			destination = destinations[i]
+		}
+		_, spans[i] = publisher.tracing.startProducerSpan(context.Background(), msg, destination, operationPublish, trace.SpanKindProducer)
+	}
+	err := publisher.PersistentMessagePublisher.PublishBatch(msgs, destinations...)
+	for _, span := range spans {
+		endSpan(span, err)
+	}
+	return err
+}
+
+// RequestReplyMessagePublisher wraps the given publisher so that a client span is created for
+// every request, covering the time until the reply is received or the request fails.
+func (tracing *Tracing) RequestReplyMessagePublisher(publisher solace.RequestReplyMessagePublisher) solace.RequestReplyMessagePublisher {
+	return &requestReplyMessagePublisher{RequestReplyMessagePublisher: publisher, tracing: tracing}
+}
+
+type requestReplyMessagePublisher struct {
+	solace.RequestReplyMessagePublisher
+	tracing *Tracing
+}
+
+func (publisher *requestReplyMessagePublisher) PublishBytes(bytes []byte, replyMessageHandler solace.ReplyMessageHandler, destination *resource.Topic, replyTimeout time.Duration, userContext interface{}) error {
+	msg, err := publisher.tracing.service.MessageBuilder().BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.Publish(msg, replyMessageHandler, destination, replyTimeout, nil, userContext)
+}
+
+func (publisher *requestReplyMessagePublisher) PublishString(str string, replyMessageHandler solace.ReplyMessageHandler, destination *resource.Topic, replyTimeout time.Duration, userContext interface{}) error {
+	msg, err := publisher.tracing.service.MessageBuilder().BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.Publish(msg, replyMessageHandler, destination, replyTimeout, nil, userContext)
+}
+
+func (publisher *requestReplyMessagePublisher) Publish(requestMessage message.OutboundMessage, replyMessageHandler solace.ReplyMessageHandler,
+	requestsDestination *resource.Topic, replyTimeout time.Duration,
+	properties config.MessagePropertiesConfigurationProvider, userContext interface{}) error {
+	if replyMessageHandler == nil {
+		// let the wrapped publisher reject the request
+		return publisher.RequestReplyMessagePublisher.Publish(requestMessage, nil, requestsDestination, replyTimeout, properties, userContext)
+	}
+	_, span := publisher.tracing.startProducerSpan(context.Background(), requestMessage, requestsDestination, operationRequest, trace.SpanKindClient)
+	var ended int32
+	tracedHandler := func(reply message.InboundMessage, userContext interface{}, err error) {
+		if atomic.CompareAndSwapInt32(&ended, 0, 1) {
+			span.SetAttributes(AttributeSolaceRequestReplyResponse.Bool(reply != nil))
+			endSpan(span, err)
+		}
+		replyMessageHandler(reply, userContext, err)
+	}
+	err := publisher.RequestReplyMessagePublisher.Publish(requestMessage, tracedHandler, requestsDestination, replyTimeout, properties, userContext)
+	if err != nil && atomic.CompareAndSwapInt32(&ended, 0, 1) {
+		endSpan(span, err)
+	}
+	return err
+}
+
+func (publisher *requestReplyMessagePublisher) PublishAwaitResponse(requestMessage message.OutboundMessage, requestDestination *resource.Topic,
+	replyTimeout time.Duration, properties config.MessagePropertiesConfigurationProvider) (message.InboundMessage, error) {
+	_, span := publisher.tracing.startProducerSpan(context.Background(), requestMessage, requestDestination, operationRequest, trace.SpanKindClient)
+	reply, err := publisher.RequestReplyMessagePublisher.PublishAwaitResponse(requestMessage, requestDestination, replyTimeout, properties)
+	span.SetAttributes(AttributeSolaceRequestReplyResponse.Bool(reply != nil))
+	endSpan(span, err)
+	return reply, err
+}
+
+func (publisher *requestReplyMessagePublisher) PublishAwaitResponseWithContext(ctx context.Context, requestMessage message.OutboundMessage, requestDestination *resource.Topic,
+	properties config.MessagePropertiesConfigurationProvider) (message.InboundMessage, error) {
+	_, span := publisher.tracing.startProducerSpan(ctx, requestMessage, requestDestination, operationRequest, trace.SpanKindClient)
+	reply, err := publisher.RequestReplyMessagePublisher.PublishAwaitResponseWithContext(ctx, requestMessage, requestDestination, properties)
+	span.SetAttributes(AttributeSolaceRequestReplyResponse.Bool(reply != nil))
+	endSpan(span, err)
+	return reply, err
+}
@@ -0,0 +1,213 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceotel
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// DirectMessageReceiver wraps the given receiver so that a consumer span is created for every
+// message delivered to a handler registered with ReceiveAsync or AddRoute, and for every message
+// returned by ReceiveMessage.
+func (tracing *Tracing) DirectMessageReceiver(receiver solace.DirectMessageReceiver) solace.DirectMessageReceiver {
+	return &directMessageReceiver{DirectMessageReceiver: receiver, tracing: tracing}
+}
+
+type directMessageReceiver struct {
+	solace.DirectMessageReceiver
+	tracing *Tracing
+}
+
+func (receiver *directMessageReceiver) ReceiveAsync(callback solace.MessageHandler) error {
+	return receiver.DirectMessageReceiver.ReceiveAsync(receiver.tracing.messageHandler(callback))
+}
+
+func (receiver *directMessageReceiver) AddRoute(pattern string, handler solace.TopicRouteHandler) error {
+	if handler == nil {
+		return receiver.DirectMessageReceiver.AddRoute(pattern, nil)
+	}
+	return receiver.DirectMessageReceiver.AddRoute(pattern, func(msg message.InboundMessage, levels map[string]string) {
+		receiver.tracing.messageHandler(func(msg message.InboundMessage) {
+			handler(msg, levels)
+		})(msg)
+	})
+}
+
+func (receiver *directMessageReceiver) ReceiveMessage(timeout time.Duration) (message.InboundMessage, error) {
+	start := time.Now()
+	msg, err := receiver.DirectMessageReceiver.ReceiveMessage(timeout)
+	receiver.tracing.received(msg, start)
+	return msg, err
+}
+
+func (receiver *directMessageReceiver) ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, error) {
+	start := time.Now()
+	msg, err := receiver.DirectMessageReceiver.ReceiveMessageWithContext(ctx)
+	receiver.tracing.received(msg, start)
+	return msg, err
+}
+
+// PersistentMessageReceiver wraps the given receiver so that a consumer span is created for every
+// message delivered to a handler registered with ReceiveAsync, for every message returned by
+// ReceiveMessage, and for every call to Ack and Settle.
+func (tracing *Tracing) PersistentMessageReceiver(receiver solace.PersistentMessageReceiver) solace.PersistentMessageReceiver {
+	return &persistentMessageReceiver{PersistentMessageReceiver: receiver, tracing: tracing}
+}
+
+type persistentMessageReceiver struct {
+	solace.PersistentMessageReceiver
+	tracing *Tracing
+}
+
+func (receiver *persistentMessageReceiver) ReceiveAsync(callback solace.MessageHandler) error {
+	return receiver.PersistentMessageReceiver.ReceiveAsync(receiver.tracing.messageHandler(callback))
+}
+
+func (receiver *persistentMessageReceiver) ReceiveMessage(timeout time.Duration) (message.InboundMessage, error) {
+	start := time.Now()
+	msg, err := receiver.PersistentMessageReceiver.ReceiveMessage(timeout)
+	receiver.tracing.received(msg, start)
+	return msg, err
+}
+
+func (receiver *persistentMessageReceiver) ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, error) {
+	start := time.Now()
+	msg, err := receiver.PersistentMessageReceiver.ReceiveMessageWithContext(ctx)
+	receiver.tracing.received(msg, start)
+	return msg, err
+}
+
+func (receiver *persistentMessageReceiver) Ack(msg message.InboundMessage) error {
+	if msg == nil {
+		return receiver.PersistentMessageReceiver.Ack(msg)
+	}
+	_, span := receiver.tracing.startConsumerSpan(msg, operationAck, trace.SpanKindConsumer)
+	err := receiver.PersistentMessageReceiver.Ack(msg)
+	endSpan(span, err)
+	return err
+}
+
+func (receiver *persistentMessageReceiver) Settle(msg message.InboundMessage, outcome config.MessageSettlementOutcome) error {
+	if msg == nil {
+		return receiver.PersistentMessageReceiver.Settle(msg, outcome)
+	}
+	_, span := receiver.tracing.startConsumerSpan(msg, operationSettle, trace.SpanKindConsumer,
+		trace.WithAttributes(AttributeSolaceSettlementOutcome.String(string(outcome))))
+	err := receiver.PersistentMessageReceiver.Settle(msg, outcome)
+	endSpan(span, err)
+	return err
+}
+
+// RequestReplyMessageReceiver wraps the given receiver so that a server span is created for every
+// request delivered to a handler registered with ReceiveAsync, and a producer span is created for
+// every reply published through the replier passed to the handler.
+func (tracing *Tracing) RequestReplyMessageReceiver(receiver solace.RequestReplyMessageReceiver) solace.RequestReplyMessageReceiver {
+	return &requestReplyMessageReceiver{RequestReplyMessageReceiver: receiver, tracing: tracing}
+}
+
+type requestReplyMessageReceiver struct {
+	solace.RequestReplyMessageReceiver
+	tracing *Tracing
+}
+
+func (receiver *requestReplyMessageReceiver) ReceiveAsync(messageHandler solace.RequestMessageHandler) error {
+	if messageHandler == nil {
+		return receiver.RequestReplyMessageReceiver.ReceiveAsync(nil)
+	}
+	return receiver.RequestReplyMessageReceiver.ReceiveAsync(func(msg message.InboundMessage, replier solace.Replier) {
+		receiver.tracing.process(msg, trace.SpanKindServer, func(ctx context.Context) {
+			messageHandler(msg, receiver.tracing.replier(ctx, msg, replier))
+		})
+	})
+}
+
+func (receiver *requestReplyMessageReceiver) ReceiveMessage(timeout time.Duration) (message.InboundMessage, solace.Replier, error) {
+	start := time.Now()
+	msg, replier, err := receiver.RequestReplyMessageReceiver.ReceiveMessage(timeout)
+	if msg == nil {
+		return msg, replier, err
+	}
+	ctx := receiver.tracing.received(msg, start)
+	return msg, receiver.tracing.replier(ctx, msg, replier), err
+}
+
+func (receiver *requestReplyMessageReceiver) ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, solace.Replier, error) {
+	start := time.Now()
+	msg, replier, err := receiver.RequestReplyMessageReceiver.ReceiveMessageWithContext(ctx)
+	if msg == nil {
+		return msg, replier, err
+	}
+	spanCtx := receiver.tracing.received(msg, start)
+	return msg, receiver.tracing.replier(spanCtx, msg, replier), err
+}
+
+type tracedReplier struct {
+	solace.Replier
+	tracing *Tracing
+	ctx     context.Context
+	request message.InboundMessage
+}
+
+func (replier *tracedReplier) Reply(msg message.OutboundMessage) error {
+	if msg == nil {
+		return replier.Replier.Reply(msg)
+	}
+	// the reply is a child of the span that received the request
+	replyTo, _ := replier.request.GetReplyToDestination()
+	_, span := replier.tracing.startProducerSpan(replier.ctx, msg, replyTo, operationReply, trace.SpanKindProducer)
+	err := replier.Replier.Reply(msg)
+	endSpan(span, err)
+	return err
+}
+
+// replier wraps the given replier so that replies are traced as children of ctx
+func (tracing *Tracing) replier(ctx context.Context, request message.InboundMessage, replier solace.Replier) solace.Replier {
+	if replier == nil {
+		// not a request reply message, there is nothing to reply to
+		return nil
+	}
+	return &tracedReplier{Replier: replier, tracing: tracing, ctx: ctx, request: request}
+}
+
+// messageHandler wraps the given handler with a process span
+func (tracing *Tracing) messageHandler(handler solace.MessageHandler) solace.MessageHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(msg message.InboundMessage) {
+		tracing.process(msg, trace.SpanKindConsumer, func(ctx context.Context) {
+			handler(msg)
+		})
+	}
+}
+
+// received records a receive span for a message returned from a blocking receive started at start
+func (tracing *Tracing) received(msg message.InboundMessage, start time.Time) context.Context {
+	if msg == nil {
+		return context.Background()
+	}
+	ctx, span := tracing.startConsumerSpan(msg, operationReceive, trace.SpanKindConsumer, trace.WithTimestamp(start))
+	span.End()
+	return ctx
+}
@@ -0,0 +1,272 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceotel
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+const (
+	// instrumentationName is the name of the tracer used to create spans.
+	instrumentationName = "solace.dev/go/messaging/instrumentation/solaceotel"
+
+	messagingSystem = "solace"
+
+	operationPublish = "publish"
+	operationReceive = "receive"
+	operationProcess = "process"
+	operationAck     = "ack"
+	operationSettle  = "settle"
+	operationRequest = "request"
+	operationReply   = "reply"
+)
+
+// Attribute keys set on the spans created by Tracing.
+const (
+	AttributeMessagingSystem            = attribute.Key("messaging.system")
+	AttributeMessagingOperation         = attribute.Key("messaging.operation")
+	AttributeMessagingDestinationName   = attribute.Key("messaging.destination.name")
+	AttributeMessagingClientID          = attribute.Key("messaging.client_id")
+	AttributeMessagingMessageID         = attribute.Key("messaging.message.id")
+	AttributeMessagingConversationID    = attribute.Key("messaging.message.conversation_id")
+	AttributeSolaceDestinationType      = attribute.Key("messaging.solace.destination_type")
+	AttributeSolaceSettlementOutcome    = attribute.Key("messaging.solace.settlement_outcome")
+	AttributeSolaceMessageRedelivered   = attribute.Key("messaging.solace.message.redelivered")
+	AttributeSolaceReplyToDestination   = attribute.Key("messaging.solace.reply_to.name")
+	AttributeSolaceMessagePersisted     = attribute.Key("messaging.solace.message.persisted")
+	AttributeSolaceRequestReplyResponse = attribute.Key("messaging.solace.request_reply.response")
+)
+
+// Option configures a Tracing instance.
+type Option func(*options)
+
+type options struct {
+	tracerProvider trace.TracerProvider
+	propagator     propagation.TextMapPropagator
+}
+
+// WithTracerProvider sets the TracerProvider used to create spans.
+// If not set, the global TracerProvider is used.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(opts *options) {
+		if provider != nil {
+			opts.tracerProvider = provider
+		}
+	}
+}
+
+// WithPropagator sets the propagator used to inject and extract span contexts from messages.
+// If not set, a composite of the W3C trace context and W3C baggage propagators is used as
+// these are the formats carried natively by Solace messages.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(opts *options) {
+		if propagator != nil {
+			opts.propagator = propagator
+		}
+	}
+}
+
+// Tracing creates spans for the publishers and receivers of a single MessagingService.
+// Wrap each publisher and receiver after it is built to have spans created automatically.
+type Tracing struct {
+	service    solace.MessagingService
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+
+	// active holds the context of the process span of each message currently being handled
+	active sync.Map
+}
+
+// New returns a new Tracing instance for the given MessagingService.
+func New(service solace.MessagingService, opts ...Option) *Tracing {
+	options := &options{
+		tracerProvider: otel.GetTracerProvider(),
+		propagator:     propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}),
+	}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Tracing{
+		service:    service,
+		tracer:     options.tracerProvider.Tracer(instrumentationName),
+		propagator: options.propagator,
+	}
+}
+
+// Inject writes the span context and baggage of ctx to the given message.
+func (tracing *Tracing) Inject(ctx context.Context, msg message.OutboundMessage) {
+	tracing.propagator.Inject(ctx, NewOutboundCarrier(msg))
+}
+
+// Extract returns a copy of ctx containing the span context and baggage carried by the given message.
+func (tracing *Tracing) Extract(ctx context.Context, msg message.InboundMessage) context.Context {
+	return tracing.propagator.Extract(ctx, NewInboundCarrier(msg))
+}
+
+// ContextFromMessage returns the context of the span processing the given message. When called from
+// a message handler registered on a wrapped receiver, the returned context contains the process span
+// created for the message. Otherwise the span context carried by the message is extracted.
+func (tracing *Tracing) ContextFromMessage(msg message.InboundMessage) context.Context {
+	if ctx, ok := tracing.active.Load(msg); ok {
+		return ctx.(context.Context)
+	}
+	return tracing.Extract(context.Background(), msg)
+}
+
+// startProducerSpan starts a span publishing the given message. The parent of the span is taken
+// from the given context, falling back to the creation trace context of the message. The transport
+// trace context of the message is never used as the parent as it is left by any previous publish of
+// the message. The new span is injected into the message as its transport trace context.
+func (tracing *Tracing) startProducerSpan(ctx context.Context, msg message.OutboundMessage, destination resource.Destination, operation string, kind trace.SpanKind) (context.Context, trace.Span) {
+	parent := ctx
+	if !trace.SpanContextFromContext(parent).IsValid() {
+		if creation, ok := creationSpanContext(msg); ok {
+			parent = trace.ContextWithRemoteSpanContext(parent, creation)
+		}
+	}
+	name, destinationType := describeDestination(destination)
+	ctx, span := tracing.tracer.Start(parent, spanName(name, operation),
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(tracing.commonAttributes(name, operation)...),
+	)
+	if destinationType != "" {
+		span.SetAttributes(AttributeSolaceDestinationType.String(destinationType))
+	}
+	if correlationID, ok := msg.GetCorrelationID(); ok {
+		span.SetAttributes(AttributeMessagingConversationID.String(correlationID))
+	}
+	tracing.propagator.Inject(ctx, &OutboundCarrier{message: msg, transportOnly: true})
+	return ctx, span
+}
+
+// startConsumerSpan starts a span for an operation on a received message. The parent of the span is
+// the context processing the message, or the transport context carried by the message, and the span
+// is linked to the creation context of the message when the two differ.
+func (tracing *Tracing) startConsumerSpan(msg message.InboundMessage, operation string, kind trace.SpanKind, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	parent := tracing.ContextFromMessage(msg)
+	name := msg.GetDestinationName()
+	startOptions := []trace.SpanStartOption{
+		trace.WithSpanKind(kind),
+		trace.WithAttributes(tracing.commonAttributes(name, operation)...),
+		trace.WithAttributes(AttributeSolaceMessageRedelivered.Bool(msg.IsRedelivered())),
+	}
+	if creation, ok := creationSpanContext(msg); ok && !creation.Equal(trace.SpanContextFromContext(parent)) {
+		startOptions = append(startOptions, trace.WithLinks(trace.Link{SpanContext: creation}))
+	}
+	startOptions = append(startOptions, opts...)
+	ctx, span := tracing.tracer.Start(parent, spanName(name, operation), startOptions...)
+	if messageID, ok := msg.GetApplicationMessageID(); ok {
+		span.SetAttributes(AttributeMessagingMessageID.String(messageID))
+	}
+	if correlationID, ok := msg.GetCorrelationID(); ok {
+		span.SetAttributes(AttributeMessagingConversationID.String(correlationID))
+	}
+	if replyTo, ok := msg.GetReplyToDestination(); ok {
+		span.SetAttributes(AttributeSolaceReplyToDestination.String(replyTo.GetName()))
+	}
+	return ctx, span
+}
+
+// process runs the given function within a process span for the given message, making the
+// span context available through ContextFromMessage for the duration of the call
+func (tracing *Tracing) process(msg message.InboundMessage, kind trace.SpanKind, handle func(ctx context.Context)) {
+	ctx, span := tracing.startConsumerSpan(msg, operationProcess, kind)
+	tracing.active.Store(msg, ctx)
+	defer func() {
+		tracing.active.Delete(msg)
+		span.End()
+	}()
+	handle(ctx)
+}
+
+func (tracing *Tracing) commonAttributes(destinationName string, operation string) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{
+		AttributeMessagingSystem.String(messagingSystem),
+		AttributeMessagingOperation.String(operation),
+	}
+	if tracing.service != nil {
+		attributes = append(attributes, AttributeMessagingClientID.String(tracing.service.GetApplicationID()))
+	}
+	if destinationName != "" {
+		attributes = append(attributes, AttributeMessagingDestinationName.String(destinationName))
+	}
+	return attributes
+}
+
+// endSpan ends the given span, recording the error if one occurred
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// spanName returns the span name for the operation on the given destination
+func spanName(destinationName string, operation string) string {
+	if destinationName == "" {
+		return operation
+	}
+	return destinationName + " " + operation
+}
+
+// describeDestination returns the name and type of the given destination
+func describeDestination(destination resource.Destination) (name string, destinationType string) {
+	switch typed := destination.(type) {
+	case *resource.Topic:
+		if typed != nil {
+			return typed.GetName(), "topic"
+		}
+	case *resource.Queue:
+		if typed != nil {
+			return typed.GetName(), "queue"
+		}
+	}
+	return "", ""
+}
+
+// creationSpanContext returns the remote span context of the creation trace context of the message
+func creationSpanContext(msg message.Message) (trace.SpanContext, bool) {
+	traceID, spanID, sampled, traceState, ok := msg.GetCreationTraceContext()
+	if !ok || traceID == [16]byte{} || spanID == [8]byte{} {
+		return trace.SpanContext{}, false
+	}
+	config := trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+		Remote:  true,
+	}
+	if sampled {
+		config.TraceFlags = trace.FlagsSampled
+	}
+	if state, err := trace.ParseTraceState(traceState); err == nil {
+		config.TraceState = state
+	}
+	spanContext := trace.NewSpanContext(config)
+	return spanContext, spanContext.IsValid()
+}
@@ -0,0 +1,286 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solaceotel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+type fakeMessagingService struct {
+	solace.MessagingService
+}
+
+func (service *fakeMessagingService) GetApplicationID() string {
+	return "test-application"
+}
+
+type fakePersistentPublisher struct {
+	solace.PersistentMessagePublisher
+	listener    solace.MessagePublishReceiptListener
+	userContext interface{}
+	published   message.OutboundMessage
+}
+
+func (publisher *fakePersistentPublisher) SetMessagePublishReceiptListener(listener solace.MessagePublishReceiptListener) {
+	publisher.listener = listener
+}
+
+func (publisher *fakePersistentPublisher) GetMessagePublishReceiptListener() solace.MessagePublishReceiptListener {
+	return publisher.listener
+}
+
+func (publisher *fakePersistentPublisher) Publish(msg message.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider, userContext interface{}) error {
+	publisher.published = msg
+	publisher.userContext = userContext
+	return nil
+}
+
+func (publisher *fakePersistentPublisher) PublishAwaitAcknowledgement(msg message.OutboundMessage, destination resource.Destination, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error {
+	publisher.published = msg
+	return nil
+}
+
+type fakePublishReceipt struct {
+	solace.PublishReceipt
+	userContext interface{}
+	err         error
+}
+
+func (receipt *fakePublishReceipt) GetUserContext() interface{} {
+	return receipt.userContext
+}
+
+func (receipt *fakePublishReceipt) GetError() error {
+	return receipt.err
+}
+
+func (receipt *fakePublishReceipt) IsPersisted() bool {
+	return receipt.err == nil
+}
+
+type fakePersistentReceiver struct {
+	solace.PersistentMessageReceiver
+	handler solace.MessageHandler
+	acked   message.InboundMessage
+}
+
+func (receiver *fakePersistentReceiver) ReceiveAsync(handler solace.MessageHandler) error {
+	receiver.handler = handler
+	return nil
+}
+
+func (receiver *fakePersistentReceiver) Ack(msg message.InboundMessage) error {
+	receiver.acked = msg
+	return nil
+}
+
+func newTestTracing() (*Tracing, *tracetest.SpanRecorder) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return New(&fakeMessagingService{}, WithTracerProvider(provider)), recorder
+}
+
+func TestPersistentPublisherSpanEndsOnReceipt(t *testing.T) {
+	tracing, recorder := newTestTracing()
+	inner := &fakePersistentPublisher{}
+	publisher := tracing.PersistentMessagePublisher(inner)
+
+	var receivedContext interface{}
+	publisher.SetMessagePublishReceiptListener(func(receipt solace.PublishReceipt) {
+		receivedContext = receipt.GetUserContext()
+	})
+
+	msg := &fakeMessage{}
+	if err := publisher.Publish(msg, resource.TopicOf("hello/world"), nil, "user context"); err != nil {
+		t.Fatal(err)
+	}
+	if !msg.transport.set {
+		t.Error("expected the span context to be injected into the published message")
+	}
+	if len(recorder.Ended()) != 0 {
+		t.Fatal("expected the publish span to remain open until the receipt is received")
+	}
+	inner.listener(&fakePublishReceipt{userContext: inner.userContext})
+	if receivedContext != "user context" {
+		t.Errorf("expected the application listener to receive the original user context, got %v", receivedContext)
+	}
+	ended := recorder.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected one ended span, got %d", len(ended))
+	}
+	if ended[0].Name() != "hello/world publish" {
+		t.Errorf("expected span name 'hello/world publish', got %s", ended[0].Name())
+	}
+	if ended[0].SpanKind() != trace.SpanKindProducer {
+		t.Errorf("expected producer span, got %s", ended[0].SpanKind())
+	}
+	if trace.SpanID(msg.transport.spanID) != ended[0].SpanContext().SpanID() {
+		t.Error("expected the message to carry the publish span context")
+	}
+}
+
+func TestPersistentPublisherKeepsExistingListener(t *testing.T) {
+	tracing, _ := newTestTracing()
+	inner := &fakePersistentPublisher{}
+	var receivedContext interface{}
+	inner.SetMessagePublishReceiptListener(func(receipt solace.PublishReceipt) {
+		receivedContext = receipt.GetUserContext()
+	})
+	publisher := tracing.PersistentMessagePublisher(inner)
+	if publisher.GetMessagePublishReceiptListener() == nil {
+		t.Error("expected the wrapper to report the listener set before wrapping")
+	}
+	if err := publisher.Publish(&fakeMessage{}, resource.TopicOf("hello/world"), nil, "user context"); err != nil {
+		t.Fatal(err)
+	}
+	inner.listener(&fakePublishReceipt{userContext: inner.userContext})
+	if receivedContext != "user context" {
+		t.Errorf("expected the listener set before wrapping to receive the original user context, got %v", receivedContext)
+	}
+}
+
+func TestPersistentPublisherReusedMessageSpansAreNotChained(t *testing.T) {
+	tracing, recorder := newTestTracing()
+	inner := &fakePersistentPublisher{}
+	publisher := tracing.PersistentMessagePublisher(inner)
+
+	msg := &fakeMessage{}
+	for i := 0; i < 2; i++ {
+		if err := publisher.PublishAwaitAcknowledgement(msg, resource.TopicOf("hello/world"), 0, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if msg.creation.set {
+		t.Error("expected the publish spans to not be set as the creation context of the message")
+	}
+	ended := recorder.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected two ended spans, got %d", len(ended))
+	}
+	if ended[1].Parent().IsValid() {
+		t.Error("expected the second publish span to not be a child of the first")
+	}
+	if trace.SpanID(msg.transport.spanID) != ended[1].SpanContext().SpanID() {
+		t.Error("expected the message to carry the latest publish span context")
+	}
+}
+
+func TestPersistentPublisherSpanParentIsCreationContext(t *testing.T) {
+	tracing, recorder := newTestTracing()
+	inner := &fakePersistentPublisher{}
+	publisher := tracing.PersistentMessagePublisher(inner)
+
+	msg := &fakeMessage{}
+	creation := testSpanContext(t)
+	msg.SetCreationTraceContext(creation.TraceID(), creation.SpanID(), true, nil)
+	if err := publisher.PublishAwaitAcknowledgement(msg, resource.TopicOf("hello/world"), 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	ended := recorder.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected one ended span, got %d", len(ended))
+	}
+	if ended[0].Parent().SpanID() != creation.SpanID() {
+		t.Error("expected the publish span to be a child of the creation context")
+	}
+}
+
+func TestPersistentPublisherSpanRecordsFailedReceipt(t *testing.T) {
+	tracing, recorder := newTestTracing()
+	inner := &fakePersistentPublisher{}
+	publisher := tracing.PersistentMessagePublisher(inner)
+	if err := publisher.Publish(&fakeMessage{}, resource.QueueDurableExclusive("q"), nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	inner.listener(&fakePublishReceipt{userContext: inner.userContext, err: errors.New("nack")})
+	ended := recorder.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected one ended span, got %d", len(ended))
+	}
+	if len(ended[0].Events()) == 0 {
+		t.Error("expected the receipt error to be recorded on the span")
+	}
+}
+
+func TestPersistentReceiverProcessAndAckSpans(t *testing.T) {
+	tracing, recorder := newTestTracing()
+	inner := &fakePersistentReceiver{}
+	receiver := tracing.PersistentMessageReceiver(inner)
+
+	producerContext := testSpanContext(t)
+	msg := &fakeMessage{destination: "hello/world"}
+	NewOutboundCarrier(msg).Set("traceparent", formatTraceparent(producerContext.TraceID(), producerContext.SpanID(), true))
+
+	var handlerContext context.Context
+	err := receiver.ReceiveAsync(func(received message.InboundMessage) {
+		handlerContext = tracing.ContextFromMessage(received)
+		receiver.Ack(received)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	inner.handler(msg)
+
+	if inner.acked != msg {
+		t.Error("expected the message to be acknowledged by the wrapped receiver")
+	}
+	ended := recorder.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected an ack and a process span, got %d spans", len(ended))
+	}
+	ack, process := ended[0], ended[1]
+	if process.Name() != "hello/world process" || ack.Name() != "hello/world ack" {
+		t.Errorf("unexpected span names %s and %s", process.Name(), ack.Name())
+	}
+	if process.Parent().SpanID() != producerContext.SpanID() {
+		t.Error("expected the process span to be a child of the producer span")
+	}
+	if ack.Parent().SpanID() != process.SpanContext().SpanID() {
+		t.Error("expected the ack span to be a child of the process span")
+	}
+	if !trace.SpanContextFromContext(handlerContext).Equal(process.SpanContext()) {
+		t.Error("expected ContextFromMessage to return the process span within the handler")
+	}
+	if _, ok := tracing.active.Load(msg); ok {
+		t.Error("expected the process context to be released after the handler returns")
+	}
+}
+
+func TestReceivedMessageSpanCoversReceiveCall(t *testing.T) {
+	tracing, recorder := newTestTracing()
+	start := time.Now().Add(-time.Second)
+	tracing.received(&fakeMessage{destination: "hello/world"}, start)
+	ended := recorder.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected one receive span, got %d", len(ended))
+	}
+	if !ended[0].StartTime().Equal(start) {
+		t.Errorf("expected receive span to start at %s, got %s", start, ended[0].StartTime())
+	}
+}
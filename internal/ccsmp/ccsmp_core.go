@@ -362,6 +362,26 @@ func (session *SolClientSession) SolClientSessionPublish(message SolClientMessag
 	})
 }
 
+// SolClientSessionSendMultipleLimit is the maximum number of messages that can be passed to
+// SolClientSessionPublishMultiple in a single call
+const SolClientSessionSendMultipleLimit = int(C.SOLCLIENT_SESSION_SEND_MULTIPLE_LIMIT)
+
+// SolClientSessionPublishMultiple wraps solClient_session_sendMultipleMsg. It returns the number of
+// messages written to the session, which may be less than len(messages) when an error is returned.
+func (session *SolClientSession) SolClientSessionPublishMultiple(messages []SolClientMessagePt) (int, *SolClientErrorInfoWrapper) {
+	if len(messages) == 0 {
+		return 0, nil
+	}
+	var written C.solClient_uint32_t
+	errorInfo := handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_session_sendMultipleMsg(session.pointer,
+			(*C.solClient_opaqueMsg_pt)(unsafe.Pointer(&messages[0])),
+			C.solClient_uint32_t(len(messages)),
+			&written)
+	})
+	return int(written), errorInfo
+}
+
 // solClientSessionSubscribeWithFlags wraps solClient_session_topicSubscribeWithDispatch
 func (session *SolClientSession) solClientSessionSubscribeWithFlags(topic string, flags C.solClient_subscribeFlags_t, dispatchID uintptr, correlationID uintptr) *SolClientErrorInfoWrapper {
 	return handleCcsmpError(func() SolClientReturnCode {
@@ -583,6 +603,29 @@ func (session *SolClientSession) SolClientSessionGetP2PTopicPrefix() (string, *S
 	return string(p2pTopicInUse[:endIndex]), nil
 }
 
+// SolClientSessionGetWebTransportProtocolInUse wraps solClient_session_getProperty
+func (session *SolClientSession) SolClientSessionGetWebTransportProtocolInUse() (string, *SolClientErrorInfoWrapper) {
+	const maxProtocolSize = 32
+	protocolInUseKey := C.CString(SolClientSessionPropWebTransportProtocolInUse)
+	defer C.free(unsafe.Pointer(protocolInUseKey))
+	protocolInUse := make([]byte, maxProtocolSize)
+	// An empty string is returned when the session is not connected or not connected over a web transport
+	errorInfo := handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_session_getProperty(session.pointer, protocolInUseKey, (*C.char)(unsafe.Pointer(&protocolInUse[0])), maxProtocolSize)
+	})
+	if errorInfo != nil {
+		return "", errorInfo
+	}
+	endIndex := maxProtocolSize
+	for i := 0; i < maxProtocolSize; i++ {
+		if protocolInUse[i] == 0 {
+			endIndex = i
+			break
+		}
+	}
+	return string(protocolInUse[:endIndex]), nil
+}
+
 // SolClientVersionGet wraps solClient_version_get
 func SolClientVersionGet() (err *SolClientErrorInfoWrapper, version, dateTime, variant string) {
 	var versionInfo *SolClientVersionInfo
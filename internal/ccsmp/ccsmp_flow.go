@@ -126,6 +126,11 @@ func (session *SolClientSession) SolClientSessionCreateFlow(properties []string,
 	return flow, nil
 }
 
+// SolClientFlowGetID returns the client side identifier of the flow
+func (flow *SolClientFlow) SolClientFlowGetID() uint64 {
+	return uint64(flow.userP)
+}
+
 // SolClientFlowRemoveCallbacks function
 func (flow *SolClientFlow) SolClientFlowRemoveCallbacks() {
 	flowToRXCallbackMap.Delete(flow.userP)
@@ -142,6 +142,25 @@ SessionFlowCreate( solClient_opaqueSession_pt   opaqueSession_p,
     return solClient_session_createFlow(flowPropsP, opaqueSession_p, opaqueFlow_p, &flowCreateFuncInfo, sizeof(flowCreateFuncInfo));
 }
 
+solClient_returnCode_t
+TransactedSessionFlowCreate( solClient_opaqueTransactedSession_pt opaqueTransactedSession_p,
+                             solClient_propertyArray_pt           flowPropsP,
+                             solClient_opaqueFlow_pt              *opaqueFlow_p,
+                             solClient_uint64_t                   flowID)
+{
+    /* set the flowID in the flow create struct */
+    solClient_flow_createFuncInfo_t flowCreateFuncInfo;
+	flowCreateFuncInfo.rxMsgInfo.callback_p = flowMessageReceiveCallback;
+	flowCreateFuncInfo.rxMsgInfo.user_p = (void *)flowID;
+	flowCreateFuncInfo.eventInfo.callback_p = (solClient_flow_eventCallbackFunc_t)flowEventCallback;
+	flowCreateFuncInfo.eventInfo.user_p = (void *)flowID;
+    // allocate these struct fields too
+	flowCreateFuncInfo.rxInfo.user_p = NULL;
+	flowCreateFuncInfo.rxInfo.callback_p = NULL;
+
+    return solClient_transactedSession_createFlow(flowPropsP, opaqueTransactedSession_p, opaqueFlow_p, &flowCreateFuncInfo, sizeof(flowCreateFuncInfo));
+}
+
 solClient_returnCode_t  
 FlowTopicSubscribeWithDispatch( solClient_opaqueFlow_pt opaqueFlow_p,
                                 solClient_subscribeFlags_t flags,
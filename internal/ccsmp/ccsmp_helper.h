@@ -71,6 +71,12 @@ solClient_returnCode_t  SessionFlowCreate(
                         solClient_opaqueFlow_pt         *opaqueFlow_p,
                         solClient_uint64_t              flowID_p);
 
+solClient_returnCode_t  TransactedSessionFlowCreate(
+                        solClient_opaqueTransactedSession_pt opaqueTransactedSession_p,
+                        solClient_propertyArray_pt      flowPropsP,
+                        solClient_opaqueFlow_pt         *opaqueFlow_p,
+                        solClient_uint64_t              flowID_p);
+
 solClient_returnCode_t  FlowTopicSubscribeWithDispatch(
                         solClient_opaqueFlow_pt opaqueFlow_p,
                         solClient_subscribeFlags_t flags,
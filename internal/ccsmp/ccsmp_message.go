@@ -39,12 +39,27 @@ type SolClientMessagePt = C.solClient_opaqueMsg_pt
 // SolClientDestination is assigned a value
 type SolClientDestination = C.solClient_destination_t
 
+// SolClientDestinationType is assigned a value
+type SolClientDestinationType = C.solClient_destinationType_t
+
 // SolClientMessageID is assigned a value
 type SolClientMessageID = C.solClient_msgId_t
 
 // SolClientCacheStatus is assigned a value
 type SolClientCacheStatus = C.solClient_cacheStatus_t
 
+// SolClientDestinationTypeTopic is assigned a value
+const SolClientDestinationTypeTopic = C.SOLCLIENT_TOPIC_DESTINATION
+
+// SolClientDestinationTypeQueue is assigned a value
+const SolClientDestinationTypeQueue = C.SOLCLIENT_QUEUE_DESTINATION
+
+// SolClientDestinationTypeTopicTemp is assigned a value
+const SolClientDestinationTypeTopicTemp = C.SOLCLIENT_TOPIC_TEMP_DESTINATION
+
+// SolClientDestinationTypeQueueTemp is assigned a value
+const SolClientDestinationTypeQueueTemp = C.SOLCLIENT_QUEUE_TEMP_DESTINATION
+
 // SolClientDeliveryModeDirect is assigned a value
 const SolClientDeliveryModeDirect = C.SOLCLIENT_DELIVERY_MODE_DIRECT
 
@@ -115,6 +130,17 @@ func SolClientMessageGetBinaryAttachmentAsBytes(messageP SolClientMessagePt) ([]
 	return C.GoBytes(dataPtr, C.int(size)), true
 }
 
+// SolClientMessageGetBinaryAttachmentSize function returns the size of the binary attachment
+// of the given message, or 0 if the message has no binary attachment
+func SolClientMessageGetBinaryAttachmentSize(messageP SolClientMessagePt) uint64 {
+	var dataPtr unsafe.Pointer
+	var size C.uint
+	if C.solClient_msg_getBinaryAttachmentPtr(messageP, &dataPtr, &size) != C.SOLCLIENT_OK {
+		return 0
+	}
+	return uint64(size)
+}
+
 // SolClientMessageGetXMLAttachmentAsBytes function
 func SolClientMessageGetXMLAttachmentAsBytes(messageP SolClientMessagePt) ([]byte, bool) {
 	var dataPtr unsafe.Pointer
@@ -296,20 +322,32 @@ func SolClientMessageGetDestinationName(messageP SolClientMessagePt) (destName s
 
 // SolClientMessageGetReplyToDestinationName function
 func SolClientMessageGetReplyToDestinationName(messageP SolClientMessagePt) (destName string, errorInfo *SolClientErrorInfoWrapper) {
+	destName, _, errorInfo = SolClientMessageGetReplyToDestination(messageP)
+	return destName, errorInfo
+}
+
+// SolClientMessageGetReplyToDestination function
+func SolClientMessageGetReplyToDestination(messageP SolClientMessagePt) (destName string, destType SolClientDestinationType, errorInfo *SolClientErrorInfoWrapper) {
 	var dest *SolClientDestination = &SolClientDestination{}
 	errorInfo = handleCcsmpError(func() SolClientReturnCode {
 		return C.solClient_msg_getReplyTo(messageP, dest, (C.size_t)(unsafe.Sizeof(*dest)))
 	})
 	if errorInfo == nil {
 		destName = C.GoString(dest.dest)
+		destType = dest.destType
 	}
-	return destName, errorInfo
+	return destName, destType, errorInfo
 }
 
 // SolClientMessageSetDestination function
 func SolClientMessageSetDestination(messageP SolClientMessagePt, destinationString string) *SolClientErrorInfoWrapper {
+	return SolClientMessageSetDestinationWithType(messageP, destinationString, SolClientDestinationTypeTopic)
+}
+
+// SolClientMessageSetDestinationWithType function
+func SolClientMessageSetDestinationWithType(messageP SolClientMessagePt, destinationString string, destinationType SolClientDestinationType) *SolClientErrorInfoWrapper {
 	destination := &SolClientDestination{}
-	destination.destType = C.SOLCLIENT_TOPIC_DESTINATION
+	destination.destType = destinationType
 	destination.dest = C.CString(destinationString)
 	defer C.free(unsafe.Pointer(destination.dest))
 	return handleCcsmpError(func() SolClientReturnCode {
@@ -319,8 +357,13 @@ func SolClientMessageSetDestination(messageP SolClientMessagePt, destinationStri
 
 // SolClientMessageSetReplyToDestination function
 func SolClientMessageSetReplyToDestination(messageP SolClientMessagePt, replyToDestinationString string) *SolClientErrorInfoWrapper {
+	return SolClientMessageSetReplyToDestinationWithType(messageP, replyToDestinationString, SolClientDestinationTypeTopic)
+}
+
+// SolClientMessageSetReplyToDestinationWithType function
+func SolClientMessageSetReplyToDestinationWithType(messageP SolClientMessagePt, replyToDestinationString string, destinationType SolClientDestinationType) *SolClientErrorInfoWrapper {
 	destination := &SolClientDestination{}
-	destination.destType = C.SOLCLIENT_TOPIC_DESTINATION
+	destination.destType = destinationType
 	destination.dest = C.CString(replyToDestinationString)
 	defer C.free(unsafe.Pointer(destination.dest))
 	return handleCcsmpError(func() SolClientReturnCode {
@@ -571,6 +614,19 @@ func SolClientMessageSetCorrelationTag(messageP SolClientMessagePt, correlationT
 	return nil
 }
 
+// SolClientMessageGetCorrelationTag function
+func SolClientMessageGetCorrelationTag(messageP SolClientMessagePt) ([]byte, *SolClientErrorInfoWrapper) {
+	var dataPtr unsafe.Pointer
+	var size C.solClient_uint32_t
+	errorInfo := handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_msg_getCorrelationTagPtr(messageP, &dataPtr, &size)
+	})
+	if errorInfo != nil {
+		return nil, errorInfo
+	}
+	return C.GoBytes(dataPtr, C.int(size)), nil
+}
+
 // SolClientMessageSetDeliveryMode function
 func SolClientMessageSetDeliveryMode(messageP SolClientMessagePt, deliveryMode uint32) *SolClientErrorInfoWrapper {
 	return handleCcsmpError(func() SolClientReturnCode {
@@ -0,0 +1,135 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ccsmp
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+/*
+#cgo CFLAGS: -DSOLCLIENT_PSPLUS_GO
+#include <stdlib.h>
+#include <stdio.h>
+
+#include <string.h>
+
+#include "solclient/solClient.h"
+#include "solclient/solClientMsg.h"
+#include "./ccsmp_helper.h"
+*/
+import "C"
+
+// SolClientTransactedSessionPt is assigned a value
+type SolClientTransactedSessionPt = C.solClient_opaqueTransactedSession_pt
+
+const (
+	// SolClientTransactedSessionPropHasPublisher: If it is enabled, a publisher flow is created when a Transacted Session is created successfully.
+	SolClientTransactedSessionPropHasPublisher = C.SOLCLIENT_TRANSACTEDSESSION_PROP_HAS_PUBLISHER
+	// SolClientTransactedSessionPropCreateMessageDispatcher: If it is enabled, a TransactedSession-bound Message Dispatcher is lazily created for asynchronous message delivery within a Transacted Session.
+	SolClientTransactedSessionPropCreateMessageDispatcher = C.SOLCLIENT_TRANSACTEDSESSION_PROP_CREATE_MESSAGE_DISPATCHER
+	// SolClientTransactedSessionPropRequestreplyTimeoutMs: Timeout (in milliseconds) to wait for a response. The minimum configuration value is 1000.
+	SolClientTransactedSessionPropRequestreplyTimeoutMs = C.SOLCLIENT_TRANSACTEDSESSION_PROP_REQUESTREPLY_TIMEOUT_MS
+	// SolClientTransactedSessionPropPubWindowSize: Transacted publisher window size, if supported by broker. Range is 1-255.
+	SolClientTransactedSessionPropPubWindowSize = C.SOLCLIENT_TRANSACTEDSESSION_PROP_PUB_WINDOW_SIZE
+)
+
+// SolClientTransactedSession structure
+type SolClientTransactedSession struct {
+	pointer SolClientTransactedSessionPt
+}
+
+// SolClientSessionCreateTransactedSession function
+func (session *SolClientSession) SolClientSessionCreateTransactedSession(properties []string) (*SolClientTransactedSession, *SolClientErrorInfoWrapper) {
+	transactedSessionPropsP, transactedSessionPropertiesFreeFunction := ToCArray(properties, true)
+	defer transactedSessionPropertiesFreeFunction()
+
+	transactedSession := &SolClientTransactedSession{}
+	err := handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_session_createTransactedSession(transactedSessionPropsP, session.pointer, &transactedSession.pointer, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transactedSession, nil
+}
+
+// SolClientTransactedSessionCreateFlow function
+func (transactedSession *SolClientTransactedSession) SolClientTransactedSessionCreateFlow(properties []string, msgCallback SolClientFlowMessageCallback, eventCallback SolClientFlowEventCallback) (*SolClientFlow, *SolClientErrorInfoWrapper) {
+	flowPropsP, flowPropertiesFreeFunction := ToCArray(properties, true)
+	defer flowPropertiesFreeFunction()
+
+	flowID := atomic.AddUintptr(&flowID, 1)
+
+	flowToRXCallbackMap.Store(flowID, msgCallback)
+	flowToEventCallbackMap.Store(flowID, eventCallback)
+
+	flow := &SolClientFlow{}
+	flow.userP = flowID
+	err := handleCcsmpError(func() SolClientReturnCode {
+		// this will register the goFlowMessageReceiveCallback and goFlowEventCallback callbacks with the flowID
+		return C.TransactedSessionFlowCreate(transactedSession.pointer,
+			flowPropsP,
+			&flow.pointer,
+			C.solClient_uint64_t(flowID))
+	})
+	if err != nil {
+		flow.SolClientFlowRemoveCallbacks()
+		return nil, err
+	}
+	return flow, nil
+}
+
+// SolClientTransactedSessionPublish function
+func (transactedSession *SolClientTransactedSession) SolClientTransactedSessionPublish(message SolClientMessagePt) *SolClientErrorInfoWrapper {
+	return handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_transactedSession_sendMsg(transactedSession.pointer, message)
+	})
+}
+
+// SolClientTransactedSessionCommit function commits the current transaction. When the broker rolls back
+// the transaction instead, the returned error info carries the SolClientReturnCodeRollback return code
+// together with the subcode describing the rollback reason.
+func (transactedSession *SolClientTransactedSession) SolClientTransactedSessionCommit() *SolClientErrorInfoWrapper {
+	// the rollback reason is only available from the thread that made the call
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	returnCode := C.solClient_transactedSession_commit(transactedSession.pointer)
+	switch returnCode {
+	case SolClientReturnCodeOk:
+		return nil
+	case SolClientReturnCodeFail, SolClientReturnCodeRollback:
+		return GetLastErrorInfo(returnCode)
+	default:
+		return GetLastErrorInfoReturnCodeOnly(returnCode)
+	}
+}
+
+// SolClientTransactedSessionRollback function
+func (transactedSession *SolClientTransactedSession) SolClientTransactedSessionRollback() *SolClientErrorInfoWrapper {
+	return handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_transactedSession_rollback(transactedSession.pointer)
+	})
+}
+
+// SolClientTransactedSessionDestroy function
+func (transactedSession *SolClientTransactedSession) SolClientTransactedSessionDestroy() *SolClientErrorInfoWrapper {
+	return handleCcsmpError(func() SolClientReturnCode {
+		return C.solClient_transactedSession_destroy(&transactedSession.pointer)
+	})
+}
@@ -62,7 +62,19 @@ var DefaultDirectReceiverProperties = config.ReceiverPropertyMap{
 }
 
 // DefaultPersistentReceiverProperties contains the default properties for a PersistentReceiver
-var DefaultPersistentReceiverProperties = config.ReceiverPropertyMap{}
+var DefaultPersistentReceiverProperties = config.ReceiverPropertyMap{
+	config.ReceiverPropertyPersistentFlowWindowSize:         255,
+	config.ReceiverPropertyPersistentFlowMaxUnackedMessages: -1,    // defer to the broker configured maximum
+	config.ReceiverPropertyPersistentFlowAckThreshold:       60,    // percent of the window size
+	config.ReceiverPropertyPersistentFlowAckTimer:           1000,  // milliseconds
+	config.ReceiverPropertyPersistentFlowReconnectAttempts:  -1,    // retry forever
+	config.ReceiverPropertyPersistentFlowBindTimeout:        10000, // milliseconds
+}
+
+// DefaultQueueBrowserProperties contains the default properties for a QueueBrowser
+var DefaultQueueBrowserProperties = config.ReceiverPropertyMap{
+	config.ReceiverPropertyQueueBrowserWindowSize: 255,
+}
 
 // DefaultEndpointProperties contains the default properties to provision an Endpoint
 var DefaultEndpointProperties = config.EndpointPropertyMap{
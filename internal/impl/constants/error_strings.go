@@ -94,6 +94,18 @@ const PersistentReceiverUnsupportedSubscriptionType = "PersistentMessageReceiver
 // PersistentReceiverMissingQueue error string
 const PersistentReceiverMissingQueue = "queue must be provided when building a new PersistentMessageReceiver"
 
+// PersistentReceiverMissingTopicEndpoint error string
+const PersistentReceiverMissingTopicEndpoint = "topic endpoint must be provided when building a new PersistentMessageReceiver with a topic endpoint"
+
+// PersistentReceiverTopicEndpointMissingName error string
+const PersistentReceiverTopicEndpointMissingName = "durable topic endpoint must have a name"
+
+// PersistentReceiverTopicEndpointMissingSubscription error string
+const PersistentReceiverTopicEndpointMissingSubscription = "topic subscription must be provided when building a new PersistentMessageReceiver with a topic endpoint"
+
+// PersistentReceiverTopicEndpointSubscriptions error string
+const PersistentReceiverTopicEndpointSubscriptions = "subscriptions cannot be added to or removed from a receiver bound to a topic endpoint, the topic endpoint is bound with a single topic subscription"
+
 // PersistentReceiverCannotPauseBadState error string
 const PersistentReceiverCannotPauseBadState = "cannot pause message receiption when not in started state"
 
@@ -106,9 +118,45 @@ const PersistentReceiverMustSpecifyRGMID = "must specify ReceiverPropertyPersist
 // PersistentReceiverMustSpecifyTime error string
 const PersistentReceiverMustSpecifyTime = "must specify ReceiverPropertyPersistentMessageReplayStrategyTimeBasedStartTime when replay from time is selected"
 
+// QueueBrowserMissingQueue error string
+const QueueBrowserMissingQueue = "queue must be provided when building a new QueueBrowser"
+
+// QueueBrowserAnonymousQueue error string
+const QueueBrowserAnonymousQueue = "cannot browse an anonymous queue, queue name must not be empty"
+
+// UnableToRemoveAlreadyTerminated error string
+const UnableToRemoveAlreadyTerminated = "unable to remove message: queue browser has been terminated"
+
+// UnableToRemoveNotStarted error string
+const UnableToRemoveNotStarted = "unable to remove message: queue browser is not yet started"
+
+// PersistentPublisherUnsupportedDestinationType error string
+const PersistentPublisherUnsupportedDestinationType = "PersistentMessagePublisher does not support destinations of type %T"
+
+// PersistentPublisherMissingDestination error string
+const PersistentPublisherMissingDestination = "destination must be provided when publishing a persistent message"
+
+// PersistentPublisherAnonymousQueueDestination error string
+const PersistentPublisherAnonymousQueueDestination = "cannot publish to an anonymous queue, queue name must not be empty"
+
+// PublishBatchDestinationMismatch error string
+const PublishBatchDestinationMismatch = "expected one destination or one destination per message, got %d destinations for %d messages"
+
+// UnsupportedReplyToDestinationType error string
+const UnsupportedReplyToDestinationType = "reply to destination of type %T is not supported, expected *resource.Topic or *resource.Queue"
+
+// MissingReplyToDestination error string
+const MissingReplyToDestination = "reply to destination must not be nil"
+
+// AnonymousQueueReplyToDestination error string
+const AnonymousQueueReplyToDestination = "cannot use an anonymous queue as a reply to destination, queue name must not be empty"
+
 // WouldBlock error string
 const WouldBlock = "buffer is full, cannot queue additional messages"
 
+// PublishAbandoned error string
+const PublishAbandoned = "stopped waiting for the message to be acknowledged before it was published"
+
 // UnableToRetrieveMessageID error string
 const UnableToRetrieveMessageID = "unable to retrieve message ID from message, was the message received by a persistent receiver?"
 
@@ -160,6 +208,12 @@ const MissingServiceProperty = "property %s is required to build a messaging ser
 // MissingServicePropertyGivenScheme error string
 const MissingServicePropertyGivenScheme = "property %s is required when using authentication scheme %s"
 
+// ConflictingServiceProperties error string
+const ConflictingServiceProperties = "properties %s and %s cannot be configured together"
+
+// EmptyServiceProperty error string
+const EmptyServiceProperty = "property %s must not be empty"
+
 // MissingServicePropertiesGivenScheme error string
 const MissingServicePropertiesGivenScheme = "one of properties %s is required when using authentication scheme %s"
 
@@ -210,3 +264,51 @@ const WithCacheSessionPointer = "with cache session pointer:"
 
 // FailedToDestroyCacheSession error string
 const FailedToDestroyCacheSession = "Failed to destroy cache session"
+
+// UnableToConnectTransactionalServiceParentNotConnected error string
+const UnableToConnectTransactionalServiceParentNotConnected = "cannot connect transactional messaging service unless parent MessagingService is connected"
+
+// TransactionalServiceNotConnected error string
+const TransactionalServiceNotConnected = "transactional messaging service is not connected"
+
+// FailedToCreateTransactedSession error string
+const FailedToCreateTransactedSession = "failed to create transacted session: "
+
+// TransactionRolledBack error string
+const TransactionRolledBack = "transaction was rolled back: "
+
+// FailedToCommitTransaction error string
+const FailedToCommitTransaction = "failed to commit transaction: "
+
+// FailedToRollbackTransaction error string
+const FailedToRollbackTransaction = "failed to roll back transaction: "
+
+// OutboundMessageBuilderMissingCodec error string
+const OutboundMessageBuilderMissingCodec = "no codec registered on OutboundMessageBuilder, a codec must be set with WithCodec to build messages with BuildWithValue"
+
+// NoCodecForMessage error string
+const NoCodecForMessage = "no codec registered for HTTP content type '%s' or application message type '%s'"
+
+// CodecUnsupportedValueType error string
+const CodecUnsupportedValueType = "codec for content type '%s' cannot encode value of type %T"
+
+// CodecUnsupportedTargetType error string
+const CodecUnsupportedTargetType = "codec for content type '%s' cannot decode into value of type %T, expected a non-nil pointer"
+
+// CodecMissingPayload error string
+const CodecMissingPayload = "codec for content type '%s' found no payload to decode"
+
+// CodecFailedToEncode error string
+const CodecFailedToEncode = "failed to encode value with codec for content type '%s': "
+
+// CodecFailedToDecode error string
+const CodecFailedToDecode = "failed to decode payload with codec for content type '%s': "
+
+// TopicRouteAlreadyAdded error string
+const TopicRouteAlreadyAdded = "a route is already added for topic pattern '%s'"
+
+// TopicRouteNotFound error string
+const TopicRouteNotFound = "no route is added for topic pattern '%s'"
+
+// TopicRouteHandlerNil error string
+const TopicRouteHandlerNil = "route handler for topic pattern '%s' may not be nil"
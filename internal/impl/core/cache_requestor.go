@@ -23,7 +23,6 @@ import (
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/constants"
-	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
 	"solace.dev/go/messaging/pkg/solace/resource"
@@ -65,9 +64,9 @@ func (receiver *ccsmpBackedReceiver) CancelPendingCacheRequests(cacheRequestInde
 			 * have a cache session pointer, so we generate a cache response to notify
 			 * the application that something went wrong and defer destroying the cache
 			 * session to a later point.*/
-			logging.Default.Info(fmt.Sprintf("Failed to cancel cache request %s %d and %s %s.", constants.WithCacheRequestID, cacheRequest.ID(), constants.WithCacheSessionPointer, cacheSession.String()))
-			if logging.Default.IsDebugEnabled() {
-				logging.Default.Debug("Attempting to generate a cache request cancellation event now.")
+			receiver.logger.Info(fmt.Sprintf("Failed to cancel cache request %s %d and %s %s.", constants.WithCacheRequestID, cacheRequest.ID(), constants.WithCacheSessionPointer, cacheSession.String()))
+			if receiver.logger.IsDebugEnabled() {
+				receiver.logger.Debug("Attempting to generate a cache request cancellation event now.")
 			}
 
 			generatedEvent = ccsmp.NewCacheEventInfoForCancellation(cacheSession, cacheRequest.ID(), cacheRequest.RequestConfig().GetName(), ToNativeError(errorInfo, "Failed to cancel cache request."))
@@ -98,11 +97,11 @@ func (receiver *ccsmpBackedReceiver) CreateCacheRequest(cachedMessageSubscriptio
 
 	if errInfo != nil {
 		errorString := fmt.Sprintf("Failed to create cache session %s %d", constants.WithCacheRequestID, cacheRequestID)
-		logging.Default.Warning(errorString)
+		receiver.logger.Warning(errorString)
 		return nil, ToNativeError(errInfo, errorString)
 	}
-	if logging.Default.IsDebugEnabled() {
-		logging.Default.Debug(fmt.Sprintf("Created cache session %s", cacheSession.String()))
+	if receiver.logger.IsDebugEnabled() {
+		receiver.logger.Debug(fmt.Sprintf("Created cache session %s", cacheSession.String()))
 	}
 	cacheRequest := NewCacheRequest(cachedMessageSubscriptionRequest, cacheRequestID, cacheResponseHandler, cacheSession, dispatchID)
 	return cacheRequest, nil
@@ -126,7 +125,7 @@ func (receiver *ccsmpBackedReceiver) DestroyCacheRequest(cacheRequest CacheReque
 		/* NOTE: If we can't destroy the cache session, there is no follow up action that can be taken, so
 		 * there is no point in returning an error. We just log it and move on. */
 		errorString := fmt.Sprintf("%s %s and %s. ErrorInfo is: [%s]", constants.FailedToDestroyCacheSession, constants.WithCacheSessionPointer, constants.WithCacheRequestID, errorInfo.GetMessageAsString())
-		logging.Default.Error(errorString)
+		receiver.logger.Error(errorString)
 		err = ToNativeError(errorInfo, errorString)
 	}
 	return err
@@ -139,7 +138,7 @@ func (receiver *ccsmpBackedReceiver) CleanupCacheRequestSubscriptions(cacheReque
 			messageFilter = (*filter).Filter()
 		} else {
 			errorString := "API tried to clean up subscription for cache request that was configured for local dispatch but that did not have a configured message filter. Unable to cleanup subscription so exiting."
-			logging.Default.Info(errorString)
+			receiver.logger.Info(errorString)
 			return solace.NewError(&solace.InvalidConfigurationError{}, errorString, nil)
 		}
 		errInfo := receiver.session.UnsubscribeFromCacheRequestTopic(cacheRequest.RequestConfig().GetCacheName(),
@@ -147,8 +146,8 @@ func (receiver *ccsmpBackedReceiver) CleanupCacheRequestSubscriptions(cacheReque
 			uintptr(unsafe.Pointer(messageFilter)),
 			uintptr(0))
 		if errInfo != nil {
-			if logging.Default.IsDebugEnabled() {
-				logging.Default.Debug(fmt.Sprintf("Got error [%s] when trying to unsubscribe from cache topic [%s]", errInfo.String(), cacheRequest.RequestConfig().GetCacheName()))
+			if receiver.logger.IsDebugEnabled() {
+				receiver.logger.Debug(fmt.Sprintf("Got error [%s] when trying to unsubscribe from cache topic [%s]", errInfo.String(), cacheRequest.RequestConfig().GetCacheName()))
 			}
 			return ToNativeError(errInfo)
 		}
@@ -174,11 +173,11 @@ func (receiver *ccsmpBackedReceiver) SendCacheRequest(cacheRequest CacheRequest,
 	cacheStrategy := cacheRequest.RequestConfig().GetCachedMessageSubscriptionRequestStrategy()
 	if cacheStrategy == nil {
 		errorString := fmt.Sprintf("%s %s %d and %s %s because an invalid CachedMessageSubscriptionStrategy was passed", constants.FailedToSendCacheRequest, constants.WithCacheRequestID, cacheRequest.ID(), constants.WithCacheSessionPointer, cacheSession.String())
-		logging.Default.Warning(errorString)
+		receiver.logger.Warning(errorString)
 		return solace.NewError(&solace.IllegalArgumentError{}, errorString, nil)
 	}
-	if logging.Default.IsDebugEnabled() {
-		logging.Default.Debug(fmt.Sprintf("Sending cache request with cache request ID %d and dispatchID 0x%x on cache session %s", cacheRequest.ID(), dispatchID, cacheSession.String()))
+	if receiver.logger.IsDebugEnabled() {
+		receiver.logger.Debug(fmt.Sprintf("Sending cache request with cache request ID %d and dispatchID 0x%x on cache session %s", cacheRequest.ID(), dispatchID, cacheSession.String()))
 	}
 
 	var filterConfig MessageFilterConfig = defaultMessageFilterConfigValue
@@ -195,10 +194,10 @@ func (receiver *ccsmpBackedReceiver) SendCacheRequest(cacheRequest CacheRequest,
 		filterConfig)
 	if errInfo != nil {
 		errorString := fmt.Sprintf("%s %s %d and %s %s. Related errInfo was %s", constants.FailedToSendCacheRequest, constants.WithCacheRequestID, cacheRequest.ID(), constants.WithCacheSessionPointer, cacheSession.String(), errInfo.String())
-		logging.Default.Warning(errorString)
+		receiver.logger.Warning(errorString)
 		return ToNativeError(errInfo, errorString)
 	}
 
-	logging.Default.Debug("Sent cache request")
+	receiver.logger.Debug("Sent cache request")
 	return err
 }
@@ -22,7 +22,6 @@ import (
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/constants"
-	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
 	"solace.dev/go/messaging/pkg/solace/subcode"
@@ -78,15 +77,15 @@ func (cacheResp *cacheResponse) GetError() error {
 
 // ProcessCacheEvent is intended to be run by any agent trying to process a cache response. This can be run from a polling go routine, or during termination to cleanup remaining resources, and possibly by other agents as well.
 func (receiver *ccsmpBackedReceiver) ProcessCacheEvent(cacheRequestMap *sync.Map, cacheEventInfo CoreCacheEventInfo) {
-	if logging.Default.IsDebugEnabled() {
-		logging.Default.Debug(fmt.Sprintf("ProcessCacheEvent::cacheEventInfo is:\n%s\n", cacheEventInfo.String()))
+	if receiver.logger.IsDebugEnabled() {
+		receiver.logger.Debug(fmt.Sprintf("ProcessCacheEvent::cacheEventInfo is:\n%s\n", cacheEventInfo.String()))
 	}
 	cacheSessionP := cacheEventInfo.GetCacheSessionPointer()
 	cacheSession := ccsmp.WrapSolClientCacheSessionPt(cacheSessionP)
 	cacheRequestIndex := GetCacheRequestMapIndexFromCacheSession(cacheSession)
 	foundCacheRequest, found := cacheRequestMap.Load(cacheRequestIndex)
 	if !found {
-		if logging.Default.IsDebugEnabled() {
+		if receiver.logger.IsDebugEnabled() {
 			/* NOTE: This can occur when there has been a duplicate event, where for some reason CCSMP was able
 			 * produce an event, but PSPGo thought CCSMP was not, so PSPGo generated an event on CCSMP's
 			 * behalf, but after CCSMP's event was put on the channel. This would result in the CCSMP-
@@ -95,7 +94,7 @@ func (receiver *ccsmpBackedReceiver) ProcessCacheEvent(cacheRequestMap *sync.Map
 			 * but no matching entry in the table since it was already removed by the original entry. This
 			 * is not a bug, and the application doesn't need to be concerned about this, so we log it as
 			 * debug. */
-			logging.Default.Debug("Unable to process cache response because: The cache session associated with the given cache request/response was invalid")
+			receiver.logger.Debug("Unable to process cache response because: The cache session associated with the given cache request/response was invalid")
 		}
 	} else {
 		cacheRequest := foundCacheRequest.(CacheRequest)
@@ -122,8 +121,8 @@ func (receiver *ccsmpBackedReceiver) ProcessCacheEvent(cacheRequestMap *sync.Map
 				cacheRequestOutcome = solace.CacheRequestOutcomeFailed
 				// cacheRespError should be a timeout error
 				cacheRespError = solace.NewError(&solace.TimeoutError{}, "the cache request timed out", nil)
-				if logging.Default.IsDebugEnabled() {
-					logging.Default.Debug(
+				if receiver.logger.IsDebugEnabled() {
+					receiver.logger.Debug(
 						fmt.Sprintf(
 							"ProcessCacheEvent: The cache request timed out.\nReturnCode is: %d\nSubCode is: %d\n",
 							cacheEventInfo.GetReturnCode(),
@@ -141,8 +140,8 @@ func (receiver *ccsmpBackedReceiver) ProcessCacheEvent(cacheRequestMap *sync.Map
 			if cacheEventInfo.GetSubCode() != ccsmp.SolClientSubCodeCacheSuspectData && cacheEventInfo.GetSubCode() != ccsmp.SolClientSubCodeCacheNoData {
 				// cacheRespError should be a solaceError
 				cacheRespError = solace.NewNativeError("the cache request failed", subcode.Code(cacheEventInfo.GetSubCode()))
-				if logging.Default.IsDebugEnabled() {
-					logging.Default.Debug(
+				if receiver.logger.IsDebugEnabled() {
+					receiver.logger.Debug(
 						fmt.Sprintf(
 							"ProcessCacheEvent: The cache request failed.\nReturnCode is: %d\nSubCode is: %d\n",
 							cacheEventInfo.GetReturnCode(),
@@ -173,6 +172,6 @@ func (receiver *ccsmpBackedReceiver) ProcessCacheEvent(cacheRequestMap *sync.Map
 	if errorInfo := cacheSession.DestroyCacheSession(); errorInfo != nil {
 		/* NOTE: If we can't destroy the cache session, there is no follow up action that can be taken, so
 		 * there is no point in returning an error. We just log it and move on. */
-		logging.Default.Error(fmt.Sprintf("%s %s %s and %s 0x%x. ErrorInfo is: [%s]", constants.FailedToDestroyCacheSession, constants.WithCacheSessionPointer, cacheSession.String(), constants.WithCacheRequestID, cacheRequestIndex, errorInfo.GetMessageAsString()))
+		receiver.logger.Error(fmt.Sprintf("%s %s %s and %s 0x%x. ErrorInfo is: [%s]", constants.FailedToDestroyCacheSession, constants.WithCacheSessionPointer, cacheSession.String(), constants.WithCacheRequestID, cacheRequestIndex, errorInfo.GetMessageAsString()))
 	}
 }
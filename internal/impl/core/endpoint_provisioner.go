@@ -48,11 +48,14 @@ type EndpointProvisioner interface {
 	Deprovision(properties []string, ignoreMissingErrors bool) (ProvisionCorrelationID, <-chan ProvisionEvent, ErrorInfo)
 	// ClearProvisionCorrelation clears the provison correlation with the given ID
 	ClearProvisionCorrelation(id ProvisionCorrelationID)
+	// Logger returns the logger of the messaging service the provisioner belongs to
+	Logger() logging.LogLevelLogger
 }
 
 type ccsmpBackedEndpointProvisioner struct {
 	events    *ccsmpBackedEvents
 	session   *ccsmp.SolClientSession
+	logger    logging.LogLevelLogger
 	isRunning int32
 	rxLock    sync.RWMutex
 
@@ -63,10 +66,16 @@ type ccsmpBackedEndpointProvisioner struct {
 	provisionOkEvent, provisionErrorEvent uint
 }
 
+// Logger returns the logger of the messaging service the provisioner belongs to
+func (provisioner *ccsmpBackedEndpointProvisioner) Logger() logging.LogLevelLogger {
+	return provisioner.logger
+}
+
 func newCcsmpEndpointProvisioner(session *ccsmp.SolClientSession, events *ccsmpBackedEvents) *ccsmpBackedEndpointProvisioner {
 	provisioner := &ccsmpBackedEndpointProvisioner{}
 	provisioner.events = events
 	provisioner.session = session
+	provisioner.logger = logging.Default
 	provisioner.isRunning = 0
 	provisioner.provisionCorrelation = make(map[ProvisionCorrelationID]chan ProvisionEvent)
 	provisioner.provisionCorrelationID = 0
@@ -173,8 +182,8 @@ func (provisioner *ccsmpBackedEndpointProvisioner) handleProvisionAndDeprovision
 			id:  corrP,
 			err: err,
 		}
-	} else if logging.Default.IsDebugEnabled() {
-		logging.Default.Debug(fmt.Sprintf("Handle Provision/Deprovision callback called but no provision correlation channel is registered for CorrelationID %v", corrP))
+	} else if provisioner.logger.IsDebugEnabled() {
+		provisioner.logger.Debug(fmt.Sprintf("Handle Provision/Deprovision callback called but no provision correlation channel is registered for CorrelationID %v", corrP))
 	}
 }
 
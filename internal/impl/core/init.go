@@ -68,4 +68,5 @@ func init() {
 		logging.Default.Error("Encountered error while setting native log callback: " + err.GetMessageAsString())
 	}
 	SetNativeLogLevel(logging.Default.GetLevel())
+	logging.OnLevelChange(updateNativeLogLevel)
 }
@@ -0,0 +1,142 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"math"
+	"math/bits"
+	"sync/atomic"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+// latencySubBucketBits is the number of bits of precision kept below the most significant bit
+// of a recorded value, each power of two is split into 1<<latencySubBucketBits buckets
+const latencySubBucketBits = 4
+
+const latencySubBucketCount = 1 << latencySubBucketBits
+
+// latencyBucketCount covers every positive int64 nanosecond value
+const latencyBucketCount = (64 - latencySubBucketBits) * latencySubBucketCount
+
+// latencyHistogram is a lock free log-linear histogram of nanosecond latencies
+type latencyHistogram struct {
+	sum     uint64
+	min     uint64
+	max     uint64
+	buckets [latencyBucketCount]uint64
+}
+
+func newLatencyHistogram() *latencyHistogram {
+	return &latencyHistogram{min: math.MaxUint64}
+}
+
+// latencyBucketIndex returns the index of the bucket holding the given value
+func latencyBucketIndex(value uint64) int {
+	if value < latencySubBucketCount {
+		return int(value)
+	}
+	// the shift that keeps latencySubBucketBits+1 significant bits
+	shift := bits.Len64(value) - latencySubBucketBits - 1
+	return shift*latencySubBucketCount + int(value>>uint(shift))
+}
+
+// latencyBucketUpperBound returns the highest value held by the bucket with the given index
+func latencyBucketUpperBound(index int) uint64 {
+	if index < latencySubBucketCount {
+		return uint64(index)
+	}
+	shift := index/latencySubBucketCount - 1
+	mantissa := uint64(index%latencySubBucketCount + latencySubBucketCount)
+	return (mantissa+1)<<uint(shift) - 1
+}
+
+func (histogram *latencyHistogram) record(latency time.Duration) {
+	if latency < 0 {
+		return
+	}
+	value := uint64(latency)
+	atomic.AddUint64(&histogram.buckets[latencyBucketIndex(value)], 1)
+	atomic.AddUint64(&histogram.sum, value)
+	for current := atomic.LoadUint64(&histogram.min); value < current; current = atomic.LoadUint64(&histogram.min) {
+		if atomic.CompareAndSwapUint64(&histogram.min, current, value) {
+			break
+		}
+	}
+	for current := atomic.LoadUint64(&histogram.max); value > current; current = atomic.LoadUint64(&histogram.max) {
+		if atomic.CompareAndSwapUint64(&histogram.max, current, value) {
+			break
+		}
+	}
+}
+
+func (histogram *latencyHistogram) reset() {
+	for i := range histogram.buckets {
+		atomic.StoreUint64(&histogram.buckets[i], 0)
+	}
+	atomic.StoreUint64(&histogram.sum, 0)
+	atomic.StoreUint64(&histogram.min, math.MaxUint64)
+	atomic.StoreUint64(&histogram.max, 0)
+}
+
+// snapshot summarizes the histogram. Values recorded concurrently may or may not be included.
+func (histogram *latencyHistogram) snapshot() metrics.LatencySnapshot {
+	var buckets [latencyBucketCount]uint64
+	count := uint64(0)
+	for i := range histogram.buckets {
+		buckets[i] = atomic.LoadUint64(&histogram.buckets[i])
+		count += buckets[i]
+	}
+	if count == 0 {
+		return metrics.LatencySnapshot{}
+	}
+	min, max := atomic.LoadUint64(&histogram.min), atomic.LoadUint64(&histogram.max)
+	if min > max {
+		// a concurrent record has updated the buckets but not yet the bounds
+		min = max
+	}
+	percentile := func(quantile float64) time.Duration {
+		rank := uint64(math.Ceil(quantile * float64(count)))
+		seen := uint64(0)
+		for i, bucketCount := range buckets {
+			seen += bucketCount
+			if seen >= rank {
+				value := latencyBucketUpperBound(i)
+				if value > max {
+					value = max
+				}
+				if value < min {
+					value = min
+				}
+				return time.Duration(value)
+			}
+		}
+		return time.Duration(max)
+	}
+	return metrics.LatencySnapshot{
+		Count: count,
+		Min:   time.Duration(min),
+		Max:   time.Duration(max),
+		Mean:  time.Duration(atomic.LoadUint64(&histogram.sum) / count),
+		P50:   percentile(0.5),
+		P90:   percentile(0.9),
+		P95:   percentile(0.95),
+		P99:   percentile(0.99),
+		P999:  percentile(0.999),
+	}
+}
@@ -0,0 +1,97 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+func TestLatencyBucketBounds(t *testing.T) {
+	values := []uint64{0, 1, 15, 16, 17, 31, 32, 33, 1000, 123456789, math.MaxInt64}
+	for _, value := range values {
+		index := latencyBucketIndex(value)
+		if index < 0 || index >= latencyBucketCount {
+			t.Fatalf("expected value %d to map to a valid bucket, got %d", value, index)
+		}
+		upper := latencyBucketUpperBound(index)
+		if upper < value {
+			t.Errorf("expected upper bound %d of bucket %d to be at least %d", upper, index, value)
+		}
+		if float64(upper-value) > float64(value)/latencySubBucketCount {
+			t.Errorf("expected upper bound %d to be within 1/%d of %d", upper, latencySubBucketCount, value)
+		}
+		if index > 0 && latencyBucketUpperBound(index-1) >= value {
+			t.Errorf("expected value %d to be above the previous bucket", value)
+		}
+	}
+}
+
+func TestLatencyHistogramSnapshot(t *testing.T) {
+	histogram := newLatencyHistogram()
+	if snapshot := histogram.snapshot(); snapshot != (metrics.LatencySnapshot{}) {
+		t.Errorf("expected empty snapshot, got %v", snapshot)
+	}
+	for i := 1; i <= 1000; i++ {
+		histogram.record(time.Duration(i) * time.Microsecond)
+	}
+	histogram.record(-time.Second)
+	snapshot := histogram.snapshot()
+	if snapshot.Count != 1000 {
+		t.Errorf("expected 1000 latencies, got %d", snapshot.Count)
+	}
+	if snapshot.Min != time.Microsecond || snapshot.Max != time.Millisecond {
+		t.Errorf("expected min 1us and max 1ms, got %s and %s", snapshot.Min, snapshot.Max)
+	}
+	if snapshot.Mean != 500500*time.Nanosecond {
+		t.Errorf("expected mean 500.5us, got %s", snapshot.Mean)
+	}
+	percentiles := map[time.Duration]time.Duration{
+		500 * time.Microsecond: snapshot.P50,
+		900 * time.Microsecond: snapshot.P90,
+		950 * time.Microsecond: snapshot.P95,
+		990 * time.Microsecond: snapshot.P99,
+		999 * time.Microsecond: snapshot.P999,
+	}
+	for expected, actual := range percentiles {
+		if actual < expected || actual > expected+expected/latencySubBucketCount {
+			t.Errorf("expected percentile near %s, got %s", expected, actual)
+		}
+	}
+	histogram.reset()
+	if snapshot := histogram.snapshot(); snapshot.Count != 0 {
+		t.Errorf("expected reset histogram to be empty, got %v", snapshot)
+	}
+}
+
+func TestMetricsLatency(t *testing.T) {
+	metricsImpl := newCcsmpMetrics(nil)
+	metricsImpl.RecordLatency(metrics.PublishAcknowledgementLatency, time.Millisecond)
+	if snapshot := metricsImpl.GetLatencySnapshot(metrics.PublishAcknowledgementLatency); snapshot.Count != 1 || snapshot.P50 != time.Millisecond {
+		t.Errorf("expected a single latency of 1ms, got %v", snapshot)
+	}
+	if snapshot := metricsImpl.GetLatencySnapshot(metrics.RequestReplyLatency); snapshot.Count != 0 {
+		t.Errorf("expected no request reply latency, got %v", snapshot)
+	}
+	metricsImpl.ResetLatencies()
+	if snapshot := metricsImpl.GetLatencySnapshot(metrics.PublishAcknowledgementLatency); snapshot.Count != 0 {
+		t.Errorf("expected latencies to be reset, got %v", snapshot)
+	}
+}
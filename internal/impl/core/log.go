@@ -18,14 +18,70 @@ package core
 
 import (
 	"strconv"
+	"strings"
+	"sync"
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/logging"
 )
 
-var logger = logging.Default.For(nil)
+// nativeComponent is the component attribute of records logged by ccsmp
+const nativeComponent = "ccsmp"
+
+var logger = logging.Default.With(logging.Attribute{Key: logging.AttributeComponent, Value: nativeComponent})
+
+// nativeLoggers holds the loggers of connected transports by client name
+var nativeLoggers = struct {
+	sync.RWMutex
+	byClientName map[string]logging.LogLevelLogger
+}{byClientName: make(map[string]logging.LogLevelLogger)}
+
+// registerNativeLogger attributes native logs mentioning the given client name to the given logger
+func registerNativeLogger(clientName string, transportLogger logging.LogLevelLogger) {
+	nativeLoggers.Lock()
+	nativeLoggers.byClientName[clientName] = transportLogger.With(logging.Attribute{Key: logging.AttributeComponent, Value: nativeComponent})
+	nativeLoggers.Unlock()
+	updateNativeLogLevel()
+}
+
+// unregisterNativeLogger removes the logger registered for the given client name
+func unregisterNativeLogger(clientName string) {
+	nativeLoggers.Lock()
+	delete(nativeLoggers.byClientName, clientName)
+	nativeLoggers.Unlock()
+	updateNativeLogLevel()
+}
+
+// nativeLoggerFor returns the logger of the transport whose client name is mentioned by the given
+// native log message, preferring the longest client name, or the global native logger if there is none
+func nativeLoggerFor(message string) logging.LogLevelLogger {
+	nativeLoggers.RLock()
+	defer nativeLoggers.RUnlock()
+	match, matchLength := logger, 0
+	for clientName, transportLogger := range nativeLoggers.byClientName {
+		if len(clientName) > matchLength && strings.Contains(message, clientName) {
+			match, matchLength = transportLogger, len(clientName)
+		}
+	}
+	return match
+}
+
+// updateNativeLogLevel sets the native log level to the most verbose level of the global logger and
+// the loggers of all connected transports, such that native logs are filtered by the logger they are attributed to
+func updateNativeLogLevel() {
+	level := logging.Default.GetLevel()
+	nativeLoggers.RLock()
+	for _, transportLogger := range nativeLoggers.byClientName {
+		if transportLevel := transportLogger.GetLevel(); transportLevel > level {
+			level = transportLevel
+		}
+	}
+	nativeLoggers.RUnlock()
+	SetNativeLogLevel(level)
+}
 
 func logCallback(logInfo *ccsmp.LogInfo) {
+	logger := nativeLoggerFor(logInfo.Message)
 	switch logInfo.Level {
 
 	// Critical
@@ -56,16 +112,15 @@ func logCallback(logInfo *ccsmp.LogInfo) {
 
 	// Default
 	default:
-		logging.Default.Error("UNKNOWN LOG LEVEL " + strconv.Itoa(int(logInfo.Level)))
-		logging.Default.Error(logInfo.Message)
+		logger.Error("UNKNOWN LOG LEVEL " + strconv.Itoa(int(logInfo.Level)))
+		logger.Error(logInfo.Message)
 	}
 }
 
 // SetLogLevel function
 func SetLogLevel(level logging.LogLevel) {
-	// Set log level
+	// Set log level, the native log level is updated by the level change listener
 	logging.Default.SetLevel(level)
-	SetNativeLogLevel(level)
 }
 
 // SetNativeLogLevel function
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/logging"
@@ -119,6 +120,10 @@ type Metrics interface {
 	GetStat(metric metrics.Metric) uint64
 	IncrementMetric(metric NextGenMetric, amount uint64)
 	ResetStats()
+	GetResetCount() uint64
+	RecordLatency(metric metrics.LatencyMetric, latency time.Duration)
+	GetLatencySnapshot(metric metrics.LatencyMetric) metrics.LatencySnapshot
+	ResetLatencies()
 }
 
 // Implementation
@@ -126,6 +131,8 @@ type ccsmpBackedMetrics struct {
 	session       *ccsmp.SolClientSession
 	metrics       []uint64
 	duplicateAcks uint64
+	resetCount    uint64
+	latencies     []*latencyHistogram
 
 	metricLock        sync.RWMutex
 	capturedTxMetrics map[ccsmp.SolClientStatsTX]uint64
@@ -133,9 +140,14 @@ type ccsmpBackedMetrics struct {
 }
 
 func newCcsmpMetrics(session *ccsmp.SolClientSession) *ccsmpBackedMetrics {
+	latencies := make([]*latencyHistogram, metrics.LatencyMetricCount)
+	for i := range latencies {
+		latencies[i] = newLatencyHistogram()
+	}
 	return &ccsmpBackedMetrics{
 		metrics:       make([]uint64, metricCount),
 		duplicateAcks: uint64(0), // used to track duplicate acks count (auto-acks)
+		latencies:     latencies,
 		session:       session,
 	}
 }
@@ -236,6 +248,12 @@ func (backedMetrics *ccsmpBackedMetrics) ResetStats() {
 	}
 	atomic.StoreUint64(&backedMetrics.duplicateAcks, 0) // reset this duplicate acks counter to zero
 	backedMetrics.resetNativeStats()
+	atomic.AddUint64(&backedMetrics.resetCount, 1)
+}
+
+// GetResetCount returns the number of times ResetStats was called
+func (backedMetrics *ccsmpBackedMetrics) GetResetCount() uint64 {
+	return atomic.LoadUint64(&backedMetrics.resetCount)
 }
 
 func (backedMetrics *ccsmpBackedMetrics) resetNativeStats() {
@@ -263,3 +281,21 @@ func (backedMetrics *ccsmpBackedMetrics) IncrementMetric(metric NextGenMetric, a
 func (backedMetrics *ccsmpBackedMetrics) incrementDuplicateAckCount() {
 	atomic.AddUint64(&backedMetrics.duplicateAcks, uint64(1))
 }
+
+func (backedMetrics *ccsmpBackedMetrics) RecordLatency(metric metrics.LatencyMetric, latency time.Duration) {
+	backedMetrics.latencies[metric].record(latency)
+}
+
+func (backedMetrics *ccsmpBackedMetrics) GetLatencySnapshot(metric metrics.LatencyMetric) metrics.LatencySnapshot {
+	if metric < 0 || int(metric) >= len(backedMetrics.latencies) {
+		logging.Default.Warning("Could not find mapping for latency metric with ID " + fmt.Sprint(metric))
+		return metrics.LatencySnapshot{}
+	}
+	return backedMetrics.latencies[metric].snapshot()
+}
+
+func (backedMetrics *ccsmpBackedMetrics) ResetLatencies() {
+	for _, histogram := range backedMetrics.latencies {
+		histogram.reset()
+	}
+}
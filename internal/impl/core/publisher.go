@@ -22,16 +22,21 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 )
 
 // Publisher interface
 type Publisher interface {
 	// Publish pulishes a message in the form of a SolClientPublishable. Returns any error info from underlying send.
 	Publish(message Publishable) ErrorInfo
+	// PublishMultiple publishes the messages in order. Returns the number of messages published
+	// and any error info from the underlying send for the first message that was not published.
+	PublishMultiple(messages []Publishable) (int, ErrorInfo)
 	// Events returns SolClientEvents
 	Events() Events
 	// AwaitWritable awaits a writable message. Throws an error if interrupted for termination
@@ -43,6 +48,10 @@ type Publisher interface {
 	IsRunning() bool
 	// Increments a core metric
 	IncrementMetric(metric NextGenMetric, amount uint64)
+	// Records a latency to a core latency metric
+	RecordLatency(metric metrics.LatencyMetric, latency time.Duration)
+	// Logger returns the logger of the messaging service the publisher belongs to
+	Logger() logging.LogLevelLogger
 	// Acknowledgements returns the acknowledgement handler
 	Acknowledgements() Acknowledgements
 	// Requestor returns the reply handler manager
@@ -96,6 +105,7 @@ type ccsmpBackedPublisher struct {
 	events  *ccsmpBackedEvents
 	metrics *ccsmpBackedMetrics
 	session *ccsmp.SolClientSession
+	logger  logging.LogLevelLogger
 
 	taskQueue           chan SendTask
 	termination         chan struct{}
@@ -112,6 +122,12 @@ type ccsmpBackedPublisher struct {
 	acknowledgementHandlerID uint64
 	acknowledgementMap       sync.Map
 
+	// windowed acknowledgements acknowledge a message and all messages published before it,
+	// the in flight messages are tracked in publish order so that the range can be expanded
+	windowedAcks bool
+	inFlightLock sync.Mutex
+	inFlight     []inFlightMessage
+
 	// requestor fields
 	rxLock              sync.RWMutex
 	replyToPrefix       string
@@ -124,6 +140,7 @@ func newCcsmpPublisher(session *ccsmp.SolClientSession, events *ccsmpBackedEvent
 	publisher.events = events
 	publisher.metrics = metrics
 	publisher.session = session
+	publisher.logger = logging.Default
 	publisher.taskQueue = make(chan SendTask)
 	publisher.termination = make(chan struct{})
 	publisher.terminationComplete = make(chan struct{})
@@ -135,10 +152,110 @@ func newCcsmpPublisher(session *ccsmp.SolClientSession, events *ccsmpBackedEvent
 	return publisher
 }
 
+// inFlightMessage identifies a published message awaiting a windowed acknowledgement
+type inFlightMessage struct {
+	pubID, msgID uint64
+}
+
 func (publisher *ccsmpBackedPublisher) Publish(message Publishable) ErrorInfo {
+	if publisher.windowedAcks {
+		// only messages with a correlation tag are acknowledged through the acknowledgement handlers
+		if correlationTag, errInfo := ccsmp.SolClientMessageGetCorrelationTag(ccsmp.SolClientMessagePt(message)); errInfo == nil {
+			if pubID, msgID, ok := fromCorrelationTag(correlationTag); ok {
+				return publisher.publishInFlight(message, inFlightMessage{pubID: pubID, msgID: msgID})
+			}
+		}
+	}
 	return publisher.session.SolClientSessionPublish(ccsmp.SolClientMessagePt(message))
 }
 
+// publishInFlight publishes the message while holding the in flight lock such that the in flight
+// messages are recorded in the same order as they are published on the session
+func (publisher *ccsmpBackedPublisher) publishInFlight(message Publishable, entry inFlightMessage) ErrorInfo {
+	publisher.inFlightLock.Lock()
+	defer publisher.inFlightLock.Unlock()
+	publisher.inFlight = append(publisher.inFlight, entry)
+	errInfo := publisher.session.SolClientSessionPublish(ccsmp.SolClientMessagePt(message))
+	if errInfo != nil {
+		// the message was not published, it is still the last entry as we hold the lock
+		publisher.inFlight = publisher.inFlight[:len(publisher.inFlight)-1]
+	}
+	return errInfo
+}
+
+func (publisher *ccsmpBackedPublisher) PublishMultiple(messages []Publishable) (int, ErrorInfo) {
+	published := 0
+	for published < len(messages) {
+		end := published + ccsmp.SolClientSessionSendMultipleLimit
+		if end > len(messages) {
+			end = len(messages)
+		}
+		written, errInfo := publisher.publishMultiple(messages[published:end])
+		published += written
+		if errInfo != nil {
+			return published, errInfo
+		}
+	}
+	return published, nil
+}
+
+// publishMultiple publishes at most ccsmp.SolClientSessionSendMultipleLimit messages in a single send
+func (publisher *ccsmpBackedPublisher) publishMultiple(messages []Publishable) (int, ErrorInfo) {
+	if !publisher.windowedAcks {
+		return publisher.session.SolClientSessionPublishMultiple(messages)
+	}
+	// record the in flight messages in publish order, see publishInFlight
+	entries := make([]*inFlightMessage, len(messages))
+	for i, message := range messages {
+		if correlationTag, errInfo := ccsmp.SolClientMessageGetCorrelationTag(ccsmp.SolClientMessagePt(message)); errInfo == nil {
+			if pubID, msgID, ok := fromCorrelationTag(correlationTag); ok {
+				entries[i] = &inFlightMessage{pubID: pubID, msgID: msgID}
+			}
+		}
+	}
+	publisher.inFlightLock.Lock()
+	defer publisher.inFlightLock.Unlock()
+	inFlightCount := len(publisher.inFlight)
+	for _, entry := range entries {
+		if entry != nil {
+			publisher.inFlight = append(publisher.inFlight, *entry)
+		}
+	}
+	written, errInfo := publisher.session.SolClientSessionPublishMultiple(messages)
+	if written < len(messages) {
+		// the messages that were not written are still the last entries as we hold the lock
+		for _, entry := range entries[:written] {
+			if entry != nil {
+				inFlightCount++
+			}
+		}
+		publisher.inFlight = publisher.inFlight[:inFlightCount]
+	}
+	return written, errInfo
+}
+
+// settleInFlight removes the given message from the in flight messages and returns the messages it settles.
+// When ranged, all messages published before the given message are settled along with it.
+func (publisher *ccsmpBackedPublisher) settleInFlight(entry inFlightMessage, ranged bool) []inFlightMessage {
+	publisher.inFlightLock.Lock()
+	defer publisher.inFlightLock.Unlock()
+	for i, inFlight := range publisher.inFlight {
+		if inFlight != entry {
+			continue
+		}
+		if !ranged {
+			publisher.inFlight = append(publisher.inFlight[:i], publisher.inFlight[i+1:]...)
+			return []inFlightMessage{entry}
+		}
+		settled := make([]inFlightMessage, i+1)
+		copy(settled, publisher.inFlight[:i+1])
+		publisher.inFlight = publisher.inFlight[i+1:]
+		return settled
+	}
+	// the message is not tracked, for example if it was published before the windowed acknowledgements were enabled
+	return []inFlightMessage{entry}
+}
+
 func (publisher *ccsmpBackedPublisher) Requestor() Requestor {
 	return publisher
 }
@@ -240,8 +357,8 @@ func (publisher *ccsmpBackedPublisher) onReplyMessage(msgP Repliable, userP unsa
 
 	entry, ok := publisher.publisherRxReplyMap[uintptr(userP)]
 	if !ok {
-		if logging.Default.IsDebugEnabled() {
-			logging.Default.Debug(fmt.Sprintf("reply callback called but no reply function is registered for user pointer %v", userP))
+		if publisher.logger.IsDebugEnabled() {
+			publisher.logger.Debug(fmt.Sprintf("reply callback called but no reply function is registered for user pointer %v", userP))
 		}
 		return false
 	}
@@ -321,6 +438,14 @@ func (publisher *ccsmpBackedPublisher) IncrementMetric(metric NextGenMetric, amo
 	publisher.metrics.IncrementMetric(metric, amount)
 }
 
+func (publisher *ccsmpBackedPublisher) Logger() logging.LogLevelLogger {
+	return publisher.logger
+}
+
+func (publisher *ccsmpBackedPublisher) RecordLatency(metric metrics.LatencyMetric, latency time.Duration) {
+	publisher.metrics.RecordLatency(metric, latency)
+}
+
 func (publisher *ccsmpBackedPublisher) AddAcknowledgementHandler(ackHandler AcknowledgementHandler) (uint64, func() (messageId uint64, correlationTag []byte)) {
 	pubID := atomic.AddUint64(&publisher.acknowledgementHandlerID, 1)
 	publisher.acknowledgementMap.Store(pubID, ackHandler)
@@ -374,18 +499,29 @@ func (publisher *ccsmpBackedPublisher) onAcknowledgement(correlationP unsafe.Poi
 		correlationTag := ccsmp.ToGoBytes(correlationP, correlationTagLength)
 		pubID, msgID, ok := fromCorrelationTag(correlationTag)
 		if ok {
-			callbackPtr, ok := publisher.acknowledgementMap.Load(pubID)
-			if ok {
-				callback := callbackPtr.(AcknowledgementHandler)
-				callback(msgID, persisted, err)
-			} else if logging.Default.IsDebugEnabled() {
-				// This is expected if we have terminated the publisher. We may still receive acks
-				logging.Default.Debug("Received acknowledgement missing publisher callback with ID " + fmt.Sprint(pubID))
+			if !publisher.windowedAcks {
+				publisher.dispatchAcknowledgement(pubID, msgID, persisted, err)
+				return
+			}
+			// rejections are always reported per message, only acknowledgements are ranged
+			for _, settled := range publisher.settleInFlight(inFlightMessage{pubID: pubID, msgID: msgID}, persisted) {
+				publisher.dispatchAcknowledgement(settled.pubID, settled.msgID, persisted, err)
 			}
 		}
 	}
 }
 
+func (publisher *ccsmpBackedPublisher) dispatchAcknowledgement(pubID, msgID uint64, persisted bool, err error) {
+	callbackPtr, ok := publisher.acknowledgementMap.Load(pubID)
+	if ok {
+		callback := callbackPtr.(AcknowledgementHandler)
+		callback(msgID, persisted, err)
+	} else if publisher.logger.IsDebugEnabled() {
+		// This is expected if we have terminated the publisher. We may still receive acks
+		publisher.logger.Debug("Received acknowledgement missing publisher callback with ID " + fmt.Sprint(pubID))
+	}
+}
+
 func (publisher *ccsmpBackedPublisher) onCanSend() {
 	select {
 	case publisher.canSend <- true:
@@ -19,6 +19,7 @@ package core
 import (
 	"testing"
 	"time"
+	"unsafe"
 )
 
 func TestSolClientPublisherAwaitWritable(t *testing.T) {
@@ -113,3 +114,51 @@ func TestCorrelationTagEncodeDecode(t *testing.T) {
 		t.Errorf("expected output msg id %d to equal %d", outputMsgID, inputMsgID)
 	}
 }
+
+func TestSolClientPublisherWindowedAcknowledgements(t *testing.T) {
+	type ack struct {
+		msgID     uint64
+		persisted bool
+	}
+	publisher := newCcsmpPublisher(nil, nil, nil)
+	publisher.windowedAcks = true
+	acks := make(map[uint64][]ack)
+	handlerFor := func(pubID *uint64) AcknowledgementHandler {
+		return func(msgID uint64, persisted bool, err error) {
+			acks[*pubID] = append(acks[*pubID], ack{msgID, persisted})
+		}
+	}
+	var firstID, secondID uint64
+	firstID, _ = publisher.AddAcknowledgementHandler(handlerFor(&firstID))
+	secondID, _ = publisher.AddAcknowledgementHandler(handlerFor(&secondID))
+	// messages in the order they were published on the session
+	publisher.inFlight = []inFlightMessage{
+		{firstID, 1}, {secondID, 1}, {firstID, 2}, {firstID, 3}, {secondID, 2},
+	}
+	onAcknowledgement := func(pubID, msgID uint64, persisted bool) {
+		correlationTag := toCorrelationTag(pubID, msgID)
+		publisher.onAcknowledgement(unsafe.Pointer(&correlationTag[0]), persisted, nil)
+	}
+	// rejections are reported per message
+	onAcknowledgement(firstID, 2, false)
+	// acknowledges all messages published before it
+	onAcknowledgement(firstID, 3, true)
+
+	expected := map[uint64][]ack{
+		firstID:  {{2, false}, {1, true}, {3, true}},
+		secondID: {{1, true}},
+	}
+	for pubID, expectedAcks := range expected {
+		if len(acks[pubID]) != len(expectedAcks) {
+			t.Fatalf("expected acks %v for publisher %d, got %v", expectedAcks, pubID, acks[pubID])
+		}
+		for i := range expectedAcks {
+			if acks[pubID][i] != expectedAcks[i] {
+				t.Errorf("expected acks %v for publisher %d, got %v", expectedAcks, pubID, acks[pubID])
+			}
+		}
+	}
+	if len(publisher.inFlight) != 1 || publisher.inFlight[0] != (inFlightMessage{secondID, 2}) {
+		t.Errorf("expected only the last message to remain in flight, got %v", publisher.inFlight)
+	}
+}
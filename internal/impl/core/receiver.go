@@ -20,12 +20,14 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/constants"
 	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 )
 
 // SubscriptionCorrelationID defined
@@ -63,16 +65,22 @@ type Receiver interface {
 	ClearSubscriptionCorrelation(id SubscriptionCorrelationID)
 	// ProvisionEndpoint will provision an endpoint
 	ProvisionEndpoint(queueName string, isExclusive bool) ErrorInfo
+	// ProvisionTopicEndpoint will provision a durable topic endpoint
+	ProvisionTopicEndpoint(topicEndpointName string) ErrorInfo
 	// EndpointUnsubscribe will call endpoint unsubscribe on the endpoint
 	EndpointUnsubscribe(queueName string, topic string) (SubscriptionCorrelationID, <-chan SubscriptionEvent, ErrorInfo)
 	// IncrementMetric - Increments receiver metrics
 	IncrementMetric(metric NextGenMetric, amount uint64)
+	// RecordLatency - Records a latency to a receiver latency metric
+	RecordLatency(metric metrics.LatencyMetric, latency time.Duration)
 	// IncrementDuplicateAckCount - Increments receiver duplicate acks (track duplicate accepted settlement outcome metrics from auto-acks)
 	IncrementDuplicateAckCount()
 	// Creates a new persistent receiver with the given callback
 	NewPersistentReceiver(properties []string, callback RxCallback, eventCallback PersistentEventCallback) (PersistentReceiver, ErrorInfo)
 	// CacheRequestor() returns the manager that can be used to run cache operations
 	CacheRequestor() CacheRequestor
+	// Logger returns the logger of the messaging service the receiver belongs to
+	Logger() logging.LogLevelLogger
 }
 
 // PersistentReceiver interface
@@ -98,6 +106,8 @@ type PersistentReceiver interface {
 	Settle(msgID MessageID, msgSettlementOutcome MessageSettlementOutcome) ErrorInfo
 	// Destionation returns the destination if retrievable, or an error if one occurred
 	Destination() (destination string, durable bool, errorInfo ErrorInfo)
+	// FlowID returns the client side identifier of the flow
+	FlowID() uint64
 }
 
 // Receivable type defined
@@ -128,6 +138,7 @@ type ccsmpBackedReceiver struct {
 	events  *ccsmpBackedEvents
 	metrics *ccsmpBackedMetrics
 	session *ccsmp.SolClientSession
+	logger  logging.LogLevelLogger
 	running int32
 	// TODO if performance becomes a concern, consider substituting maps and mutex for sync.Map
 	rxLock     sync.RWMutex
@@ -146,6 +157,7 @@ func newCcsmpReceiver(session *ccsmp.SolClientSession, events *ccsmpBackedEvents
 	receiver.events = events
 	receiver.metrics = metrics
 	receiver.session = session
+	receiver.logger = logging.Default
 	receiver.running = 0
 	receiver.rxMap = make(map[uintptr]RxCallback)
 	receiver.dispatchID = 0
@@ -212,8 +224,8 @@ func (receiver *ccsmpBackedReceiver) rxCallback(msg Receivable, userP unsafe.Poi
 	defer receiver.rxLock.RUnlock()
 	callback, ok := receiver.rxMap[uintptr(userP)]
 	if !ok {
-		if logging.Default.IsDebugEnabled() {
-			logging.Default.Debug(fmt.Sprintf("receive callback called but no receive function is registered for user pointer %v", userP))
+		if receiver.logger.IsDebugEnabled() {
+			receiver.logger.Debug(fmt.Sprintf("receive callback called but no receive function is registered for user pointer %v", userP))
 		}
 		return false
 	}
@@ -274,6 +286,14 @@ func (receiver *ccsmpBackedReceiver) ProvisionEndpoint(queueName string, isExclu
 	return receiver.session.SolClientEndpointProvision(properties)
 }
 
+func (receiver *ccsmpBackedReceiver) ProvisionTopicEndpoint(topicEndpointName string) ErrorInfo {
+	return receiver.session.SolClientEndpointProvision([]string{
+		ccsmp.SolClientEndpointPropID, ccsmp.SolClientEndpointPropTe,
+		ccsmp.SolClientEndpointPropDurable, ccsmp.SolClientPropEnableVal,
+		ccsmp.SolClientEndpointPropName, topicEndpointName,
+	})
+}
+
 // Unsubscribe will remove the subscription from the persistent receiver
 func (receiver *ccsmpBackedReceiver) EndpointUnsubscribe(queueName string, topic string) (SubscriptionCorrelationID, <-chan SubscriptionEvent, ErrorInfo) {
 	properties := getEndpointProperties(queueName)
@@ -298,6 +318,14 @@ func (receiver *ccsmpBackedReceiver) IncrementMetric(metric NextGenMetric, amoun
 	receiver.metrics.IncrementMetric(metric, amount)
 }
 
+func (receiver *ccsmpBackedReceiver) Logger() logging.LogLevelLogger {
+	return receiver.logger
+}
+
+func (receiver *ccsmpBackedReceiver) RecordLatency(metric metrics.LatencyMetric, latency time.Duration) {
+	receiver.metrics.RecordLatency(metric, latency)
+}
+
 func (receiver *ccsmpBackedReceiver) IncrementDuplicateAckCount() {
 	receiver.metrics.incrementDuplicateAckCount()
 }
@@ -359,9 +387,22 @@ type ccsmpBackedPersistentReceiver struct {
 
 func (receiver *ccsmpBackedReceiver) NewPersistentReceiver(properties []string, rxCallback RxCallback, eventCallback PersistentEventCallback) (PersistentReceiver, ErrorInfo) {
 	if rxCallback == nil || eventCallback == nil {
-		logging.Default.Debug("attempted to create a new receiver with nil callbacks")
+		receiver.logger.Debug("attempted to create a new receiver with nil callbacks")
 		return nil, nil
 	}
+	flowMsgCallback, flowEventCallback := toFlowCallbacks(rxCallback, eventCallback)
+	flow, err := receiver.session.SolClientSessionCreateFlow(properties, flowMsgCallback, flowEventCallback)
+	if err != nil {
+		return nil, err
+	}
+	return &ccsmpBackedPersistentReceiver{
+		flow:   flow,
+		parent: receiver,
+	}, nil
+}
+
+// toFlowCallbacks wraps the given callbacks into the callbacks registered with a ccsmp flow
+func toFlowCallbacks(rxCallback RxCallback, eventCallback PersistentEventCallback) (ccsmp.SolClientFlowMessageCallback, ccsmp.SolClientFlowEventCallback) {
 	flowMsgCallback := func(msgP ccsmp.SolClientMessagePt) bool {
 		return rxCallback(msgP)
 	}
@@ -373,14 +414,7 @@ func (receiver *ccsmpBackedReceiver) NewPersistentReceiver(properties []string,
 		}
 		eventCallback(flowEvent, &flowEventInfo{err: err, infoString: info})
 	}
-	flow, err := receiver.session.SolClientSessionCreateFlow(properties, flowMsgCallback, flowEventCallback)
-	if err != nil {
-		return nil, err
-	}
-	return &ccsmpBackedPersistentReceiver{
-		flow:   flow,
-		parent: receiver,
-	}, nil
+	return flowMsgCallback, flowEventCallback
 }
 
 // Destroy destroys the flow
@@ -438,6 +472,11 @@ func (receiver *ccsmpBackedPersistentReceiver) Destination() (destination string
 	return receiver.flow.SolClientFlowGetDestination()
 }
 
+// FlowID returns the client side identifier of the flow
+func (receiver *ccsmpBackedPersistentReceiver) FlowID() uint64 {
+	return receiver.flow.SolClientFlowGetID()
+}
+
 type flowEventInfo struct {
 	err        error
 	infoString string
@@ -0,0 +1,83 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/logging"
+)
+
+// TransactedSession interface
+type TransactedSession interface {
+	// Publish publishes a message within the current transaction. Returns any error info from underlying send.
+	Publish(message Publishable) ErrorInfo
+	// NewPersistentReceiver creates a new flow bound to the transacted session.
+	// Messages received on the flow are acknowledged when the transaction is committed.
+	NewPersistentReceiver(properties []string, callback RxCallback, eventCallback PersistentEventCallback) (PersistentReceiver, ErrorInfo)
+	// Commit commits the current transaction. If the transaction was rolled back by the broker,
+	// the error info has the rollback return code and the subcode of the rollback reason.
+	Commit() ErrorInfo
+	// Rollback rolls back the current transaction
+	Rollback() ErrorInfo
+	// Destroy destroys the transacted session along with all flows created on it
+	Destroy() ErrorInfo
+}
+
+// ccsmpBackedTransactedSession wraps a ccsmp transacted session
+type ccsmpBackedTransactedSession struct {
+	transactedSession *ccsmp.SolClientTransactedSession
+	receiver          *ccsmpBackedReceiver
+}
+
+func newCcsmpTransactedSession(transactedSession *ccsmp.SolClientTransactedSession, receiver *ccsmpBackedReceiver) *ccsmpBackedTransactedSession {
+	return &ccsmpBackedTransactedSession{
+		transactedSession: transactedSession,
+		receiver:          receiver,
+	}
+}
+
+func (session *ccsmpBackedTransactedSession) Publish(message Publishable) ErrorInfo {
+	return session.transactedSession.SolClientTransactedSessionPublish(ccsmp.SolClientMessagePt(message))
+}
+
+func (session *ccsmpBackedTransactedSession) NewPersistentReceiver(properties []string, rxCallback RxCallback, eventCallback PersistentEventCallback) (PersistentReceiver, ErrorInfo) {
+	if rxCallback == nil || eventCallback == nil {
+		logging.Default.Debug("attempted to create a new transacted receiver with nil callbacks")
+		return nil, nil
+	}
+	flowMsgCallback, flowEventCallback := toFlowCallbacks(rxCallback, eventCallback)
+	flow, err := session.transactedSession.SolClientTransactedSessionCreateFlow(properties, flowMsgCallback, flowEventCallback)
+	if err != nil {
+		return nil, err
+	}
+	return &ccsmpBackedPersistentReceiver{
+		flow:   flow,
+		parent: session.receiver,
+	}, nil
+}
+
+func (session *ccsmpBackedTransactedSession) Commit() ErrorInfo {
+	return session.transactedSession.SolClientTransactedSessionCommit()
+}
+
+func (session *ccsmpBackedTransactedSession) Rollback() ErrorInfo {
+	return session.transactedSession.SolClientTransactedSessionRollback()
+}
+
+func (session *ccsmpBackedTransactedSession) Destroy() ErrorInfo {
+	return session.transactedSession.SolClientTransactedSessionDestroy()
+}
@@ -21,13 +21,13 @@
 package core
 
 import (
-	"fmt"
 	"runtime"
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/constants"
 	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/subcode"
 )
 
 // Transport interface
@@ -42,16 +42,22 @@ type Transport interface {
 	EndpointProvisioner() EndpointProvisioner
 	ID() string
 	Host() string
+	// WebTransportProtocolInUse returns the web transport protocol of the session, or an empty
+	// string if the session is not connected over a web transport
+	WebTransportProtocolInUse() string
 	ModifySessionProperties([]string) error
+	NewTransactedSession(properties []string) (TransactedSession, ErrorInfo)
+	// Logger returns the logger of the transport, which carries the client name of the session
+	Logger() logging.LogLevelLogger
 }
 
 // NewTransport function
-func NewTransport(host string, properties []string) (Transport, error) {
-	return newCcsmpTransport(host, properties)
+func NewTransport(host string, properties []string, logger logging.LogLevelLogger) (Transport, error) {
+	return newCcsmpTransport(host, properties, logger)
 }
 
 // Implementation
-func newCcsmpTransport(host string, properties []string) (*ccsmpTransport, error) {
+func newCcsmpTransport(host string, properties []string, logger logging.LogLevelLogger) (*ccsmpTransport, error) {
 	ccsmpTransport := &ccsmpTransport{}
 	// We want to be able to clean up if we have an unstarted service.
 	// This finalizer will be removed on a call to Connect, and the context+session will
@@ -75,11 +81,16 @@ func newCcsmpTransport(host string, properties []string) (*ccsmpTransport, error
 	ccsmpTransport.id = id
 	ccsmpTransport.host = host
 	ccsmpTransport.session = session
+	ccsmpTransport.logger = logger.With(logging.Attribute{Key: logging.AttributeClientName, Value: id})
 	ccsmpTransport.events = newCcsmpEvents(ccsmpTransport.session)
 	ccsmpTransport.metrics = newCcsmpMetrics(ccsmpTransport.session)
 	ccsmpTransport.publisher = newCcsmpPublisher(ccsmpTransport.session, ccsmpTransport.events, ccsmpTransport.metrics)
+	ccsmpTransport.publisher.windowedAcks = isWindowedAckEventMode(properties)
+	ccsmpTransport.publisher.logger = ccsmpTransport.logger
 	ccsmpTransport.receiver = newCcsmpReceiver(ccsmpTransport.session, ccsmpTransport.events, ccsmpTransport.metrics)
+	ccsmpTransport.receiver.logger = ccsmpTransport.logger
 	ccsmpTransport.endpointProvisioner = newCcsmpEndpointProvisioner(ccsmpTransport.session, ccsmpTransport.events)
+	ccsmpTransport.endpointProvisioner.logger = ccsmpTransport.logger
 	return ccsmpTransport, nil
 }
 
@@ -98,9 +109,15 @@ type ccsmpTransport struct {
 
 	endpointProvisioner *ccsmpBackedEndpointProvisioner
 
+	logger logging.LogLevelLogger
+
 	host, id string
 }
 
+func (transport *ccsmpTransport) Logger() logging.LogLevelLogger {
+	return transport.logger
+}
+
 func (transport *ccsmpTransport) ModifySessionProperties(properties []string) error {
 	err := transport.session.SolClientModifySessionProperties(properties)
 	if err != nil {
@@ -112,6 +129,8 @@ func (transport *ccsmpTransport) ModifySessionProperties(properties []string) er
 func (transport *ccsmpTransport) Connect() error {
 	runtime.SetFinalizer(transport.context, nil)
 	runtime.SetFinalizer(transport.session, nil)
+	// attribute native logs to this transport from the first connection attempt until the transport is closed
+	registerNativeLogger(transport.id, transport.logger)
 	err := transport.session.SolClientSessionConnect()
 	if err != nil {
 		return ToNativeError(err, "an error occurred while connecting: ")
@@ -151,20 +170,21 @@ func (transport *ccsmpTransport) Close() error {
 	// this will invalidate metrics, but we can copy them out into golang memory if needed in the future.
 	destroySession(transport.session)
 	destroyContext(transport.context)
+	unregisterNativeLogger(transport.id)
 	return nil
 }
 
 func destroySession(session *ccsmp.SolClientSession) {
 	err := session.SolClientSessionDestroy()
 	if err != nil {
-		logging.Default.Error(fmt.Sprintf("an error occurred while cleaning up session: %s (subcode %d)", err.GetMessageAsString(), err.SubCode()))
+		logging.Default.With(logging.Attribute{Key: logging.AttributeSubcode, Value: subcode.Code(err.SubCode())}).Error("an error occurred while cleaning up session: " + err.GetMessageAsString())
 	}
 }
 
 func destroyContext(context *ccsmp.SolClientContext) {
 	err := context.SolClientContextDestroy()
 	if err != nil {
-		logging.Default.Error(fmt.Sprintf("an error occurred while cleaning up context: %s (subcode %d)", err.GetMessageAsString(), err.SubCode()))
+		logging.Default.With(logging.Attribute{Key: logging.AttributeSubcode, Value: subcode.Code(err.SubCode())}).Error("an error occurred while cleaning up context: " + err.GetMessageAsString())
 	}
 }
 
@@ -188,6 +208,14 @@ func (transport *ccsmpTransport) EndpointProvisioner() EndpointProvisioner {
 	return transport.endpointProvisioner
 }
 
+func (transport *ccsmpTransport) NewTransactedSession(properties []string) (TransactedSession, ErrorInfo) {
+	transactedSession, err := transport.session.SolClientSessionCreateTransactedSession(properties)
+	if err != nil {
+		return nil, err
+	}
+	return newCcsmpTransactedSession(transactedSession, transport.receiver), nil
+}
+
 func (transport *ccsmpTransport) ID() string {
 	return transport.id
 }
@@ -195,3 +223,24 @@ func (transport *ccsmpTransport) ID() string {
 func (transport *ccsmpTransport) Host() string {
 	return transport.host
 }
+
+func (transport *ccsmpTransport) WebTransportProtocolInUse() string {
+	protocol, err := transport.session.SolClientSessionGetWebTransportProtocolInUse()
+	if err != nil {
+		transport.logger.Debug("failed to retrieve the web transport protocol in use: " + err.GetMessageAsString())
+		return ""
+	}
+	return protocol
+}
+
+// isWindowedAckEventMode checks the session properties for the windowed acknowledgement event mode,
+// the last occurrence of the property takes effect
+func isWindowedAckEventMode(properties []string) bool {
+	windowed := false
+	for i := 0; i+1 < len(properties); i += 2 {
+		if properties[i] == ccsmp.SolClientSessionPropAckEventMode {
+			windowed = properties[i+1] == ccsmp.SolClientSessionPropAckEventModeWindowed
+		}
+	}
+	return windowed
+}
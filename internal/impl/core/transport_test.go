@@ -22,6 +22,8 @@ import (
 	"testing"
 	"time"
 	"unsafe"
+
+	"solace.dev/go/messaging/internal/impl/logging"
 )
 
 var correlation = 1
@@ -100,7 +102,7 @@ func TestSolClientTransportMultipleEventRegistration(t *testing.T) {
 func TestSolClientTransportGC(t *testing.T) {
 	notification := make(chan struct{})
 	{
-		transport, err := NewTransport("", []string{})
+		transport, err := NewTransport("", []string{}, logging.Default)
 		if err != nil {
 			t.Error(err)
 		}
@@ -23,6 +23,9 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
 )
 
 // LogLevel type
@@ -53,6 +56,37 @@ const (
 	defaultCalldepth = 2
 )
 
+// Attribute keys attached to structured log records
+const (
+	// AttributeComponent identifies the part of the API that logged the record
+	AttributeComponent = "component"
+	// AttributeClientName carries the client name of the messaging service
+	AttributeClientName = "client_name"
+	// AttributeFlowID carries the identifier of a guaranteed message flow
+	AttributeFlowID = "flow_id"
+	// AttributeDestination carries the queue or topic a publisher or receiver is bound to
+	AttributeDestination = "destination"
+	// AttributeSubcode carries the subcode of a native error
+	AttributeSubcode = "subcode"
+)
+
+// Attribute is a key value pair attached to a structured log record
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Record is a log record passed to a Handler
+type Record struct {
+	Time       time.Time
+	Level      LogLevel
+	Message    string
+	Attributes []Attribute
+}
+
+// Handler receives structured log records in place of the formatted output of a logger
+type Handler func(record Record)
+
 // Default defined
 var Default LogLevelLogger
 
@@ -70,6 +104,28 @@ func For(item interface{}) LogLevelLogger {
 	return Default.For(item)
 }
 
+// NewScoped creates a logger that inherits the level, output and handler of Default until
+// its level or handler are set. Setting the level or handler of the returned logger does not
+// affect Default. The formatted output is always shared with Default.
+func NewScoped() LogLevelLogger {
+	parent := Default.(*logLevelLoggerCore)
+	return &logLevelLoggerCore{
+		Logger:    parent.Logger,
+		logLevel:  parent.logLevel,
+		calldepth: parent.calldepth,
+		parent:    parent,
+	}
+}
+
+// levelListener is notified whenever the level of a logger is set
+var levelListener atomic.Value
+
+// OnLevelChange registers a listener notified whenever the level of Default or a scoped logger is set,
+// replacing any previously registered listener.
+func OnLevelChange(listener func()) {
+	levelListener.Store(listener)
+}
+
 // LogLevelNames defined
 var LogLevelNames = []string{
 	"CRITICAL",
@@ -97,119 +153,215 @@ type LogLevelLogger interface {
 	Debug(message string)
 
 	For(item interface{}) LogLevelLogger
+	With(attributes ...Attribute) LogLevelLogger
 
 	SetFlags(flag int)
 	SetOutput(writer io.Writer)
+	SetHandler(handler Handler)
 }
 
 type logLevelLoggerCore struct {
 	*log.Logger
 	logLevel  LogLevel
 	calldepth int
+	// handler holds a *Handler, when set records are passed to the handler instead of the log.Logger
+	handler atomic.Value
+	// parent is the logger the level and handler are inherited from until they are set, nil for Default
+	parent *logLevelLoggerCore
+	// hasLevel is set once the level of a scoped logger is set
+	hasLevel bool
 }
 
 func (logger *logLevelLoggerCore) IsCriticalEnabled() bool {
-	return logger.logLevel >= Critical
+	return logger.GetLevel() >= Critical
 }
 
 func (logger *logLevelLoggerCore) IsErrorEnabled() bool {
-	return logger.logLevel >= Error
+	return logger.GetLevel() >= Error
 }
 
 func (logger *logLevelLoggerCore) IsWarningEnabled() bool {
-	return logger.logLevel >= Warning
+	return logger.GetLevel() >= Warning
 }
 
 func (logger *logLevelLoggerCore) IsInfoEnabled() bool {
-	return logger.logLevel >= Info
+	return logger.GetLevel() >= Info
 }
 
 func (logger *logLevelLoggerCore) IsDebugEnabled() bool {
-	return logger.logLevel >= Debug
+	return logger.GetLevel() >= Debug
 }
 
 func (logger *logLevelLoggerCore) SetLevel(logLevel LogLevel) {
 	if logLevel < logLevelCount {
 		logger.logLevel = logLevel
+		logger.hasLevel = true
+		if listener, ok := levelListener.Load().(func()); ok {
+			listener()
+		}
 	}
 }
 
 func (logger *logLevelLoggerCore) GetLevel() LogLevel {
+	if logger.parent != nil && !logger.hasLevel {
+		return logger.parent.GetLevel()
+	}
 	return logger.logLevel
 }
 
 func (logger *logLevelLoggerCore) For(item interface{}) LogLevelLogger {
-	var prefix string
-	if item != nil {
-		if t := reflect.TypeOf(item); t.Kind() == reflect.Ptr {
-			prefix = fmt.Sprintf("%s$%p ", t.Elem().Name(), item)
-		}
-		// Do not generate prefix in case where item is not a pointer type
+	return newLogAdapter(logger, item, nil)
+}
+
+func (logger *logLevelLoggerCore) With(attributes ...Attribute) LogLevelLogger {
+	return newLogAdapter(logger, nil, attributes)
+}
+
+// SetHandler redirects all records logged by the logger and its children to the given handler.
+// Passing nil restores the output to the log.Logger.
+func (logger *logLevelLoggerCore) SetHandler(handler Handler) {
+	logger.handler.Store(&handler)
+}
+
+func (logger *logLevelLoggerCore) getHandler() Handler {
+	if handler, ok := logger.handler.Load().(*Handler); ok && *handler != nil {
+		return *handler
+	}
+	if logger.parent != nil {
+		return logger.parent.getHandler()
+	}
+	return nil
+}
+
+// output logs the message at the given level, calldepth is relative to the caller of output
+func (logger *logLevelLoggerCore) output(calldepth int, level LogLevel, prefix, message, suffix string, attributes []Attribute) {
+	if handler := logger.getHandler(); handler != nil {
+		handler(Record{Time: time.Now(), Level: level, Message: message, Attributes: attributes})
+		return
 	}
-	return &logAdapter{logger, prefix}
+	logger.Output(calldepth+1, LogLevelNames[level]+" "+prefix+message+suffix)
 }
 
 func (logger *logLevelLoggerCore) Critical(message string) {
 	if logger.IsCriticalEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Critical]+" "+message)
+		logger.output(logger.calldepth, Critical, "", message, "", nil)
 	}
 }
 
 func (logger *logLevelLoggerCore) Error(message string) {
 	if logger.IsErrorEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Error]+" "+message)
+		logger.output(logger.calldepth, Error, "", message, "", nil)
 	}
 }
 
 func (logger *logLevelLoggerCore) Warning(message string) {
 	if logger.IsWarningEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Warning]+" "+message)
+		logger.output(logger.calldepth, Warning, "", message, "", nil)
 	}
 }
 
 func (logger *logLevelLoggerCore) Info(message string) {
 	if logger.IsInfoEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Info]+" "+message)
+		logger.output(logger.calldepth, Info, "", message, "", nil)
 	}
 }
 
 func (logger *logLevelLoggerCore) Debug(message string) {
 	if logger.IsDebugEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Debug]+" "+message)
+		logger.output(logger.calldepth, Debug, "", message, "", nil)
 	}
 }
 
 type logAdapter struct {
 	*logLevelLoggerCore
+	// prefix identifies the item the logger was created for in formatted output
 	prefix string
+	// attributes are passed to the handler with every record
+	attributes []Attribute
+	// suffix holds the attributes added with With in formatted output
+	suffix string
+}
+
+// newLogAdapter creates a logger for the given item with the given attributes in addition to the
+// attributes of parent. The component attribute is set to the type name of item when it is a pointer.
+func newLogAdapter(core *logLevelLoggerCore, item interface{}, attributes []Attribute) *logAdapter {
+	adapter := &logAdapter{logLevelLoggerCore: core}
+	if item != nil {
+		if t := reflect.TypeOf(item); t.Kind() == reflect.Ptr {
+			adapter.prefix = fmt.Sprintf("%s$%p ", t.Elem().Name(), item)
+			adapter.attributes = append(adapter.attributes, Attribute{AttributeComponent, t.Elem().Name()})
+		}
+		// Do not generate prefix in case where item is not a pointer type
+	}
+	return adapter.with(attributes)
+}
+
+// with returns a copy of the adapter with the given attributes appended
+func (logger *logAdapter) with(attributes []Attribute) *logAdapter {
+	child := &logAdapter{
+		logLevelLoggerCore: logger.logLevelLoggerCore,
+		prefix:             logger.prefix,
+		attributes:         make([]Attribute, 0, len(logger.attributes)+len(attributes)),
+		suffix:             logger.suffix,
+	}
+	child.attributes = append(child.attributes, logger.attributes...)
+	builder := strings.Builder{}
+	for _, attribute := range attributes {
+		child.attributes = append(child.attributes, attribute)
+		// the component is already identified by the prefix in formatted output
+		if attribute.Key != AttributeComponent {
+			builder.WriteString(fmt.Sprintf(" %s=%v", attribute.Key, attribute.Value))
+		}
+	}
+	child.suffix += builder.String()
+	return child
+}
+
+// For creates a logger for the given item that keeps the attributes of this logger
+func (logger *logAdapter) For(item interface{}) LogLevelLogger {
+	child := newLogAdapter(logger.logLevelLoggerCore, item, nil)
+	inherited := make([]Attribute, 0, len(logger.attributes))
+	for _, attribute := range logger.attributes {
+		// the component is replaced by the component of the new item
+		if attribute.Key != AttributeComponent || child.prefix == "" {
+			inherited = append(inherited, attribute)
+		}
+	}
+	child.attributes = append(inherited, child.attributes...)
+	child.suffix = logger.suffix
+	return child
+}
+
+func (logger *logAdapter) With(attributes ...Attribute) LogLevelLogger {
+	return logger.with(attributes)
 }
 
 func (logger *logAdapter) Critical(message string) {
 	if logger.IsCriticalEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Critical]+" "+logger.prefix+message)
+		logger.output(logger.calldepth, Critical, logger.prefix, message, logger.suffix, logger.attributes)
 	}
 }
 
 func (logger *logAdapter) Error(message string) {
 	if logger.IsErrorEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Error]+" "+logger.prefix+message)
+		logger.output(logger.calldepth, Error, logger.prefix, message, logger.suffix, logger.attributes)
 	}
 }
 
 func (logger *logAdapter) Warning(message string) {
 	if logger.IsWarningEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Warning]+" "+logger.prefix+message)
+		logger.output(logger.calldepth, Warning, logger.prefix, message, logger.suffix, logger.attributes)
 	}
 }
 
 func (logger *logAdapter) Info(message string) {
 	if logger.IsInfoEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Info]+" "+logger.prefix+message)
+		logger.output(logger.calldepth, Info, logger.prefix, message, logger.suffix, logger.attributes)
 	}
 }
 
 func (logger *logAdapter) Debug(message string) {
 	if logger.IsDebugEnabled() {
-		logger.Output(logger.calldepth, LogLevelNames[Debug]+" "+logger.prefix+message)
+		logger.output(logger.calldepth, Debug, logger.prefix, message, logger.suffix, logger.attributes)
 	}
 }
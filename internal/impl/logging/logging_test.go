@@ -258,3 +258,89 @@ func TestParentLoggerUpdateOfOutput(t *testing.T) {
 		t.Errorf("expected logger1 to output string %s, got '%s'", logger2String, buffer2.String())
 	}
 }
+
+func TestLogAttributesOutput(t *testing.T) {
+	logger := logging.Default
+	buffer := &bytes.Buffer{}
+	logger.SetOutput(buffer)
+	logger.SetFlags(0)
+	logger.SetLevel(logging.Info)
+	myInstance := &myTestStruct{}
+	attributedLogger := logger.With(logging.Attribute{Key: logging.AttributeClientName, Value: "client"}).For(myInstance)
+	attributedLogger.Info("Hello World")
+	expected := fmt.Sprintf("INFO myTestStruct$%p Hello World client_name=client\n", myInstance)
+	if buffer.String() != expected {
+		t.Errorf("expected output '%s', got '%s'", expected, buffer.String())
+	}
+}
+
+func TestLogHandler(t *testing.T) {
+	logger := logging.Default
+	logger.SetLevel(logging.Info)
+	var records []logging.Record
+	logger.SetHandler(func(record logging.Record) {
+		records = append(records, record)
+	})
+	defer logger.SetHandler(nil)
+	buffer := &bytes.Buffer{}
+	logger.SetOutput(buffer)
+	myInstance := &myTestStruct{}
+	attributedLogger := logger.For(myInstance).With(logging.Attribute{Key: logging.AttributeSubcode, Value: 21})
+	attributedLogger.Debug("Filtered")
+	attributedLogger.Warning("Hello World")
+	if buffer.Len() != 0 {
+		t.Errorf("expected no formatted output while a handler is set, got '%s'", buffer.String())
+	}
+	if len(records) != 1 {
+		t.Fatalf("expected a single record, got %d", len(records))
+	}
+	record := records[0]
+	if record.Level != logging.Warning || record.Message != "Hello World" || record.Time.IsZero() {
+		t.Errorf("unexpected record %v", record)
+	}
+	expected := []logging.Attribute{
+		{Key: logging.AttributeComponent, Value: "myTestStruct"},
+		{Key: logging.AttributeSubcode, Value: 21},
+	}
+	if !reflect.DeepEqual(record.Attributes, expected) {
+		t.Errorf("expected attributes %v, got %v", expected, record.Attributes)
+	}
+	logger.SetHandler(nil)
+	attributedLogger.Warning("Hello World")
+	if !strings.Contains(buffer.String(), "Hello World subcode=21") {
+		t.Errorf("expected formatted output after the handler is removed, got '%s'", buffer.String())
+	}
+}
+
+func TestScopedLogger(t *testing.T) {
+	logging.Default.SetLevel(logging.Warning)
+	buffer := &bytes.Buffer{}
+	logging.Default.SetOutput(buffer)
+	scoped := logging.NewScoped()
+	if scoped.GetLevel() != logging.Warning {
+		t.Errorf("expected scoped logger to inherit level %d, got %d", logging.Warning, scoped.GetLevel())
+	}
+	scoped.SetLevel(logging.Debug)
+	if logging.Default.GetLevel() != logging.Warning {
+		t.Errorf("expected setting the scoped level to leave the default level unchanged, got %d", logging.Default.GetLevel())
+	}
+	myInstance := &myTestStruct{}
+	scoped.For(myInstance).Debug("Scoped")
+	logging.Default.For(myInstance).Debug("Filtered")
+	if !strings.Contains(buffer.String(), "Scoped") || strings.Contains(buffer.String(), "Filtered") {
+		t.Errorf("expected only the scoped debug log in the shared output, got '%s'", buffer.String())
+	}
+	var records []logging.Record
+	scoped.SetHandler(func(record logging.Record) {
+		records = append(records, record)
+	})
+	buffer.Reset()
+	scoped.For(myInstance).Info("Handled")
+	logging.Default.For(myInstance).Warning("Formatted")
+	if len(records) != 1 || records[0].Message != "Handled" {
+		t.Errorf("expected a single scoped record, got %v", records)
+	}
+	if strings.Contains(buffer.String(), "Handled") || !strings.Contains(buffer.String(), "Formatted") {
+		t.Errorf("expected only the default log in the formatted output, got '%s'", buffer.String())
+	}
+}
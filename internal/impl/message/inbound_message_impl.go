@@ -28,6 +28,7 @@ import (
 	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace/message"
 	"solace.dev/go/messaging/pkg/solace/message/rgmid"
+	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
 // InboundMessageImpl structure
@@ -87,6 +88,29 @@ func (inboundMessage *InboundMessageImpl) GetDestinationName() string {
 	return destName
 }
 
+// GetReplyToDestination retrieves the reply to destination of the message, if one is set.
+// Returns a *resource.Topic or *resource.Queue and true if present, otherwise nil and false.
+func (inboundMessage *InboundMessageImpl) GetReplyToDestination() (resource.Destination, bool) {
+	destName, destType, errorInfo := ccsmp.SolClientMessageGetReplyToDestination(inboundMessage.messagePointer)
+	if errorInfo != nil {
+		if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeFail {
+			logging.Default.Debug(fmt.Sprintf("Unable to retrieve the reply to destination of this message: %s, subcode: %d", errorInfo.GetMessageAsString(), errorInfo.SubCode()))
+		}
+		return nil, false
+	}
+	switch destType {
+	case ccsmp.SolClientDestinationTypeTopic, ccsmp.SolClientDestinationTypeTopicTemp:
+		return resource.TopicOf(destName), true
+	case ccsmp.SolClientDestinationTypeQueue:
+		return resource.QueueDurableExclusive(destName), true
+	case ccsmp.SolClientDestinationTypeQueueTemp:
+		return resource.QueueNonDurableExclusive(destName), true
+	default:
+		logging.Default.Debug(fmt.Sprintf("Unknown reply to destination type %d on message", int(destType)))
+		return nil, false
+	}
+}
+
 // GetTimeStamp will get the timestamp as time.Time.
 // This timestamp represents the time that the message was received by the API.
 // This may differ from the time that the message is received by the MessageReceiver.
@@ -227,6 +251,13 @@ func GetMessageID(inboundMessage *InboundMessageImpl) (MessageID, bool) {
 	return id, true
 }
 
+// GetInboundMessagePayloadSize function
+func GetInboundMessagePayloadSize(inboundMessage *InboundMessageImpl) uint64 {
+	size := ccsmp.SolClientMessageGetBinaryAttachmentSize(inboundMessage.messagePointer)
+	runtime.KeepAlive(inboundMessage)
+	return size
+}
+
 // GetReplyToDestinationName function
 func GetReplyToDestinationName(inboundMessage *InboundMessageImpl) (string, bool) {
 	destName, errorInfo := ccsmp.SolClientMessageGetReplyToDestinationName(inboundMessage.messagePointer)
@@ -17,9 +17,12 @@
 package message
 
 import (
+	"fmt"
 	"testing"
 
 	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
 func TestGetCacheRequestID(t *testing.T) {
@@ -51,3 +54,83 @@ func TestGetCacheRequestID(t *testing.T) {
 		t.Error("IsDisposed returned false, expected true")
 	}
 }
+
+func TestGetReplyToDestination(t *testing.T) {
+	replyToDestinations := []resource.Destination{
+		resource.TopicOf("reply/to/topic"),
+		resource.QueueDurableExclusive("reply-to-queue"),
+	}
+	for _, replyTo := range replyToDestinations {
+		outboundMsg, err := NewOutboundMessageBuilder().WithReplyTo(replyTo).Build()
+		if err != nil {
+			t.Fatalf("did not expect error building message with reply to %v, got %s", replyTo, err)
+		}
+		msgP, ccsmpErr := ccsmp.SolClientMessageDup(outboundMsg.(*OutboundMessageImpl).messagePointer)
+		if ccsmpErr != nil {
+			t.Fatal("did not expect error, got " + ccsmpErr.GetMessageAsString())
+		}
+		msg := NewInboundMessage(msgP, false)
+		destination, ok := msg.GetReplyToDestination()
+		if !ok {
+			t.Errorf("expected reply to destination %v to be present", replyTo)
+		} else if fmt.Sprintf("%T", destination) != fmt.Sprintf("%T", replyTo) {
+			t.Errorf("expected reply to destination of type %T, got %T", replyTo, destination)
+		} else if destination.GetName() != replyTo.GetName() {
+			t.Errorf("expected reply to destination name %s, got %s", replyTo.GetName(), destination.GetName())
+		}
+		msg.Dispose()
+		outboundMsg.Dispose()
+	}
+}
+
+func TestGetReplyToDestinationNotSet(t *testing.T) {
+	msgP, ccsmpErr := ccsmp.SolClientMessageAlloc()
+	if ccsmpErr != nil {
+		t.Error("did not expect error, got " + ccsmpErr.GetMessageAsString())
+	}
+	msg := NewInboundMessage(msgP, false)
+	destination, ok := msg.GetReplyToDestination()
+	if ok || destination != nil {
+		t.Errorf("expected no reply to destination, got %v", destination)
+	}
+	msg.Dispose()
+}
+
+func TestBuildWithInvalidReplyToDestination(t *testing.T) {
+	invalidReplyToDestinations := []resource.Destination{
+		resource.QueueNonDurableExclusiveAnonymous(),
+		resource.TopicSubscriptionOf("reply/to/>"),
+	}
+	for _, replyTo := range invalidReplyToDestinations {
+		_, err := NewOutboundMessageBuilder().WithReplyTo(replyTo).Build()
+		if _, ok := err.(*solace.IllegalArgumentError); !ok {
+			t.Errorf("expected illegal argument error for reply to %v, got %v", replyTo, err)
+		}
+	}
+}
+
+func TestBuildWithNilReplyToDestination(t *testing.T) {
+	nilReplyToDestinations := []resource.Destination{
+		(*resource.Topic)(nil),
+		(*resource.Queue)(nil),
+	}
+	for _, replyTo := range nilReplyToDestinations {
+		outboundMsg, err := NewOutboundMessageBuilder().WithReplyTo(replyTo).Build()
+		if err != nil {
+			t.Fatalf("did not expect error building message with nil reply to %T, got %s", replyTo, err)
+		}
+		msgP, ccsmpErr := ccsmp.SolClientMessageDup(outboundMsg.(*OutboundMessageImpl).messagePointer)
+		if ccsmpErr != nil {
+			t.Fatal("did not expect error, got " + ccsmpErr.GetMessageAsString())
+		}
+		msg := NewInboundMessage(msgP, false)
+		if destination, ok := msg.GetReplyToDestination(); ok {
+			t.Errorf("expected nil reply to %T to clear the reply to destination, got %v", replyTo, destination)
+		}
+		if err := SetReplyTo(outboundMsg.(*OutboundMessageImpl), replyTo); err == nil {
+			t.Errorf("expected error setting nil reply to %T", replyTo)
+		}
+		msg.Dispose()
+		outboundMsg.Dispose()
+	}
+}
@@ -22,6 +22,7 @@ import (
 
 	"solace.dev/go/messaging/internal/ccsmp"
 
+	"solace.dev/go/messaging/internal/impl/constants"
 	"solace.dev/go/messaging/internal/impl/core"
 	"solace.dev/go/messaging/internal/impl/validation"
 
@@ -35,11 +36,13 @@ import (
 // OutboundMessageBuilderImpl structure
 type OutboundMessageBuilderImpl struct {
 	properties config.MessagePropertyMap
+	replyTo    resource.Destination
+	codec      solace.Codec
 }
 
 // NewOutboundMessageBuilder function
 func NewOutboundMessageBuilder() solace.OutboundMessageBuilder {
-	return &OutboundMessageBuilderImpl{make(config.MessagePropertyMap)}
+	return &OutboundMessageBuilderImpl{properties: make(config.MessagePropertyMap)}
 }
 
 // Build method
@@ -66,11 +69,41 @@ func (builder *OutboundMessageBuilderImpl) build(additionalConfiguration ...conf
 
 	err = SetProperties(msg, properties)
 	if err != nil {
+		msg.Dispose()
 		return nil, err
 	}
+	if builder.replyTo != nil {
+		err = SetReplyTo(msg, builder.replyTo)
+		if err != nil {
+			msg.Dispose()
+			return nil, err
+		}
+	}
 	return msg, nil
 }
 
+// SetReplyTo sets the given topic or queue as the reply to destination of the message
+func SetReplyTo(message *OutboundMessageImpl, replyTo resource.Destination) error {
+	switch destination := replyTo.(type) {
+	case *resource.Topic:
+		if destination != nil {
+			return SetReplyToDestination(message, destination.GetName())
+		}
+	case *resource.Queue:
+		if destination != nil {
+			if destination.GetName() == "" {
+				return solace.NewError(&solace.IllegalArgumentError{}, constants.AnonymousQueueReplyToDestination, nil)
+			}
+			return SetQueueReplyToDestination(message, destination.GetName(), destination.IsDurable())
+		}
+	case nil:
+		// fall through to the missing destination error below
+	default:
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.UnsupportedReplyToDestinationType, replyTo), nil)
+	}
+	return solace.NewError(&solace.IllegalArgumentError{}, constants.MissingReplyToDestination, nil)
+}
+
 // SetProperties function
 func SetProperties(message *OutboundMessageImpl, properties config.MessagePropertyMap) error {
 	userProperties := sdt.Map{}
@@ -316,6 +349,17 @@ func (builder *OutboundMessageBuilderImpl) BuildWithStreamPayload(payload sdt.St
 	return msg, nil
 }
 
+// BuildWithValue builds a new message with the given value encoded by the registered codec.
+// The HTTP content type of the message is set by the codec.
+// Returns solace/solace.*IllegalStateError if no codec is registered.
+// Returns solace/solace.*InvalidConfigurationError if an invalid configuration is provided.
+func (builder *OutboundMessageBuilderImpl) BuildWithValue(value interface{}, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	if builder.codec == nil {
+		return nil, solace.NewError(&solace.IllegalStateError{}, constants.OutboundMessageBuilderMissingCodec, nil)
+	}
+	return builder.codec.Encode(builder, value, additionalConfiguration...)
+}
+
 // FromConfigurationProvider will set the given properties to the resulting message.
 func (builder *OutboundMessageBuilderImpl) FromConfigurationProvider(properties config.MessagePropertiesConfigurationProvider) solace.OutboundMessageBuilder {
 	mergeMessagePropertyMap(builder.properties, properties)
@@ -378,6 +422,29 @@ func (builder *OutboundMessageBuilderImpl) WithCorrelationID(correlationID strin
 	return builder
 }
 
+// WithReplyTo sets the reply to destination for the message.
+func (builder *OutboundMessageBuilderImpl) WithReplyTo(replyTo resource.Destination) solace.OutboundMessageBuilder {
+	// a nil topic or queue clears the reply to destination in the same way as a nil destination
+	switch destination := replyTo.(type) {
+	case *resource.Topic:
+		if destination == nil {
+			replyTo = nil
+		}
+	case *resource.Queue:
+		if destination == nil {
+			replyTo = nil
+		}
+	}
+	builder.replyTo = replyTo
+	return builder
+}
+
+// WithCodec sets the codec used to encode values passed to BuildWithValue.
+func (builder *OutboundMessageBuilderImpl) WithCodec(codec solace.Codec) solace.OutboundMessageBuilder {
+	builder.codec = codec
+	return builder
+}
+
 func (builder *OutboundMessageBuilderImpl) String() string {
 	return fmt.Sprintf("solace.OutboundMessageBuilder at %p", builder)
 }
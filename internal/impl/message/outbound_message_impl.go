@@ -24,6 +24,8 @@ import (
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/core"
 	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
 // DeliveryMode type
@@ -95,6 +97,17 @@ func DuplicateOutboundMessage(message *OutboundMessageImpl) (*OutboundMessageImp
 	return newOutboundMessage(msgP), nil
 }
 
+// ToInboundMessage duplicates the given message into an InboundMessage as if it was received from the broker.
+// The destination of the message must already be set.
+func ToInboundMessage(message *OutboundMessageImpl, discard bool) (*InboundMessageImpl, error) {
+	msgP, err := ccsmp.SolClientMessageDup(message.messagePointer)
+	runtime.KeepAlive(message)
+	if err != nil {
+		return nil, core.ToNativeError(err, "error duplicating message: ")
+	}
+	return NewInboundMessage(msgP, discard), nil
+}
+
 // AttachCorrelationTag function
 func AttachCorrelationTag(message *OutboundMessageImpl, bytes []byte) error {
 	err := ccsmp.SolClientMessageSetCorrelationTag(message.messagePointer, bytes)
@@ -115,6 +128,9 @@ func SetDeliveryMode(message *OutboundMessageImpl, deliveryMode DeliveryMode) er
 
 // SetDestination function
 func SetDestination(message *OutboundMessageImpl, destName string) error {
+	if err := resource.ValidateTopic(destName); err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+	}
 	err := ccsmp.SolClientMessageSetDestination(message.messagePointer, destName)
 	if err != nil {
 		return core.ToNativeError(err, "error setting destination: ")
@@ -122,6 +138,15 @@ func SetDestination(message *OutboundMessageImpl, destName string) error {
 	return nil
 }
 
+// SetQueueDestination function
+func SetQueueDestination(message *OutboundMessageImpl, queueName string, durable bool) error {
+	err := ccsmp.SolClientMessageSetDestinationWithType(message.messagePointer, queueName, queueDestinationType(durable))
+	if err != nil {
+		return core.ToNativeError(err, "error setting queue destination: ")
+	}
+	return nil
+}
+
 // SetReplyToDestination function
 func SetReplyToDestination(message *OutboundMessageImpl, destName string) error {
 	err := ccsmp.SolClientMessageSetReplyToDestination(message.messagePointer, destName)
@@ -131,6 +156,23 @@ func SetReplyToDestination(message *OutboundMessageImpl, destName string) error
 	return nil
 }
 
+// SetQueueReplyToDestination function
+func SetQueueReplyToDestination(message *OutboundMessageImpl, queueName string, durable bool) error {
+	err := ccsmp.SolClientMessageSetReplyToDestinationWithType(message.messagePointer, queueName, queueDestinationType(durable))
+	if err != nil {
+		return core.ToNativeError(err, "error setting queue replyTo destination: ")
+	}
+	return nil
+}
+
+// queueDestinationType returns the ccsmp destination type for a durable or non-durable queue
+func queueDestinationType(durable bool) ccsmp.SolClientDestinationType {
+	if durable {
+		return ccsmp.SolClientDestinationTypeQueue
+	}
+	return ccsmp.SolClientDestinationTypeQueueTemp
+}
+
 // SetCorrelationID function
 func SetCorrelationID(message *OutboundMessageImpl, correlationID string) error {
 	err := ccsmp.SolClientMessageSetCorrelationID(message.messagePointer, correlationID)
@@ -163,6 +205,13 @@ func SetAsReplyMessage(message *OutboundMessageImpl, replyToDestination string,
 	return nil
 }
 
+// GetOutboundMessagePayloadSize function
+func GetOutboundMessagePayloadSize(message *OutboundMessageImpl) uint64 {
+	size := ccsmp.SolClientMessageGetBinaryAttachmentSize(message.messagePointer)
+	runtime.KeepAlive(message)
+	return size
+}
+
 // GetOutboundMessagePointer function
 func GetOutboundMessagePointer(message *OutboundMessageImpl) ccsmp.SolClientMessagePt {
 	if message == nil {
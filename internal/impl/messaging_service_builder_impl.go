@@ -27,6 +27,7 @@ import (
 	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
+	apilogging "solace.dev/go/messaging/pkg/solace/logging"
 )
 
 // NewMessagingServiceBuilder creates a messaging service builder
@@ -40,6 +41,10 @@ func NewMessagingServiceBuilder() solace.MessagingServiceBuilder {
 type messagingServiceBuilderImpl struct {
 	configuration config.ServicePropertyMap
 	logger        logging.LogLevelLogger
+
+	// serviceLogger and serviceLogLevel are set when the messaging service logs independently of the global logger
+	serviceLogger   apilogging.Logger
+	serviceLogLevel *apilogging.LogLevel
 }
 
 func (builder *messagingServiceBuilderImpl) mergeProperties(properties config.ServicePropertyMap) {
@@ -73,15 +78,30 @@ func (builder *messagingServiceBuilderImpl) Build() (solace.MessagingService, er
 	propertyList := toPropertyList(configuration, logger)
 
 	// Build the messaging service
-	messagingService := newMessagingServiceImpl(logger)
-	transport, err := core.NewTransport(hostString, propertyList)
+	transport, err := core.NewTransport(hostString, propertyList, builder.newServiceLogger())
 	if err != nil {
 		return nil, err
 	}
+	messagingService := newMessagingServiceImpl(transport.Logger())
 	messagingService.transport = transport
 	return messagingService, nil
 }
 
+// newServiceLogger returns the logger shared by the messaging service and all of its publishers and receivers
+func (builder *messagingServiceBuilderImpl) newServiceLogger() logging.LogLevelLogger {
+	if builder.serviceLogger == nil && builder.serviceLogLevel == nil {
+		return logging.Default
+	}
+	serviceLogger := logging.NewScoped()
+	if builder.serviceLogLevel != nil {
+		serviceLogger.SetLevel(logging.LogLevel(*builder.serviceLogLevel))
+	}
+	if builder.serviceLogger != nil {
+		serviceLogger.SetHandler(apilogging.HandlerFor(builder.serviceLogger))
+	}
+	return serviceLogger
+}
+
 // Converts the properties configured in this builder to a string list parsable by ccsmp
 func toPropertyList(servicePropertyMap config.ServicePropertyMap, logger logging.LogLevelLogger) []string {
 	propertyList := []string{}
@@ -123,6 +143,18 @@ func validateRequiredProperties(servicePropertyMap config.ServicePropertyMap) er
 				fmt.Sprintf(constants.MissingServiceProperty, requiredProp), nil)
 		}
 	}
+	// ccsmp accepts either a single web transport protocol or a list of protocols
+	if _, ok := servicePropertyMap[config.TransportLayerPropertyWebTransportProtocol]; ok {
+		if _, ok := servicePropertyMap[config.TransportLayerPropertyWebTransportProtocolList]; ok {
+			return solace.NewError(&solace.InvalidConfigurationError{},
+				fmt.Sprintf(constants.ConflictingServiceProperties, config.TransportLayerPropertyWebTransportProtocol,
+					config.TransportLayerPropertyWebTransportProtocolList), nil)
+		}
+	}
+	if protocols, ok := servicePropertyMap[config.TransportLayerPropertyWebTransportProtocolList]; ok && protocols != nil && defaultConverter(protocols) == "" {
+		return solace.NewError(&solace.InvalidConfigurationError{},
+			fmt.Sprintf(constants.EmptyServiceProperty, config.TransportLayerPropertyWebTransportProtocolList), nil)
+	}
 	scheme, ok := servicePropertyMap[config.AuthenticationPropertyScheme]
 	if !ok {
 		scheme = constants.DefaultAuthenticationScheme
@@ -242,12 +274,39 @@ func (builder *messagingServiceBuilderImpl) WithTransportSecurityStrategy(transp
 	return builder
 }
 
+// WithWebTransportStrategy will configure the resulting messaging service
+// with the given web transport strategy
+func (builder *messagingServiceBuilderImpl) WithWebTransportStrategy(webTransportStrategy config.WebTransportStrategy) solace.MessagingServiceBuilder {
+	properties := webTransportStrategy.ToProperties()
+	// the protocols of the strategy replace any protocols configured before, for example from a configuration provider
+	if _, ok := properties[config.TransportLayerPropertyWebTransportProtocol]; ok {
+		delete(builder.configuration, config.TransportLayerPropertyWebTransportProtocolList)
+	}
+	if _, ok := properties[config.TransportLayerPropertyWebTransportProtocolList]; ok {
+		delete(builder.configuration, config.TransportLayerPropertyWebTransportProtocol)
+	}
+	builder.mergeProperties(properties)
+	return builder
+}
+
 // WithProvisionTimeoutMs configures the timeout for provision and deprovision operations, in milliseconds
 func (builder *messagingServiceBuilderImpl) WithProvisionTimeoutMs(timeout time.Duration) solace.MessagingServiceBuilder {
 	builder.configuration[config.ServicePropertyProvisionTimeoutMs] = timeout
 	return builder
 }
 
+// WithLogger configures the resulting messaging service to pass its logs to the given Logger.
+func (builder *messagingServiceBuilderImpl) WithLogger(logger apilogging.Logger) solace.MessagingServiceBuilder {
+	builder.serviceLogger = logger
+	return builder
+}
+
+// WithLogLevel configures the logging-level of the resulting messaging service.
+func (builder *messagingServiceBuilderImpl) WithLogLevel(level apilogging.LogLevel) solace.MessagingServiceBuilder {
+	builder.serviceLogLevel = &level
+	return builder
+}
+
 func (builder *messagingServiceBuilderImpl) String() string {
 	return fmt.Sprintf("solace.MessagingServiceBuilder at %p", builder)
 }
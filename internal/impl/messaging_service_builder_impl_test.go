@@ -24,9 +24,11 @@ import (
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
 
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
+	apilogging "solace.dev/go/messaging/pkg/solace/logging"
 )
 
 func TestMessagingServiceGC(t *testing.T) {
@@ -80,6 +82,15 @@ func TestMessagingServiceGC(t *testing.T) {
 
 }
 
+func TestAckEventModeConverter(t *testing.T) {
+	if converted := ackEventModeConverter(config.PersistentPublisherAckEventModePerMessage); converted != ccsmp.SolClientSessionPropAckEventModePerMsg {
+		t.Errorf("expected %s to equal %s", converted, ccsmp.SolClientSessionPropAckEventModePerMsg)
+	}
+	if converted := ackEventModeConverter(config.PersistentPublisherAckEventModeWindowed); converted != ccsmp.SolClientSessionPropAckEventModeWindowed {
+		t.Errorf("expected %s to equal %s", converted, ccsmp.SolClientSessionPropAckEventModeWindowed)
+	}
+}
+
 func TestBooleanConverter(t *testing.T) {
 	assertEquals := func(actual, expected string) {
 		if actual != expected {
@@ -119,3 +130,102 @@ func TestBooleanConverter(t *testing.T) {
 	}
 
 }
+
+func TestMessagingServiceBuilderServiceLogger(t *testing.T) {
+	builder := NewMessagingServiceBuilder().(*messagingServiceBuilderImpl)
+	if builder.newServiceLogger() != logging.Default {
+		t.Error("expected the global logger when no service logger is configured")
+	}
+	defaultLevel := logging.Default.GetLevel()
+	var records []apilogging.Record
+	builder.WithLogLevel(apilogging.LogLevelDebug).WithLogger(apilogging.LoggerFunc(func(record apilogging.Record) {
+		records = append(records, record)
+	}))
+	serviceLogger := builder.newServiceLogger()
+	if serviceLogger.GetLevel() != logging.Debug {
+		t.Errorf("expected service log level %d, got %d", logging.Debug, serviceLogger.GetLevel())
+	}
+	if logging.Default.GetLevel() != defaultLevel {
+		t.Errorf("expected global log level to remain %d, got %d", defaultLevel, logging.Default.GetLevel())
+	}
+	serviceLogger.With(logging.Attribute{Key: logging.AttributeClientName, Value: "client"}).Debug("hello")
+	if len(records) != 1 {
+		t.Fatalf("expected a single record, got %d", len(records))
+	}
+	if records[0].Level != apilogging.LogLevelDebug || records[0].Message != "hello" ||
+		len(records[0].Attributes) != 1 || records[0].Attributes[0].Key != apilogging.AttributeClientName {
+		t.Errorf("unexpected record %v", records[0])
+	}
+}
+
+func TestWebTransportStrategy(t *testing.T) {
+	strategy := config.NewWebTransportStrategy().
+		WithProtocol(config.WebTransportProtocolHTTPBinary).
+		WithProtocols(config.WebTransportProtocolWebSocketBinary, config.WebTransportProtocolHTTPBinaryStreaming).
+		WithDowngradeTimeout(5 * time.Second).
+		WithGuaranteedMessaging()
+	builder := NewMessagingServiceBuilder().WithWebTransportStrategy(strategy).(*messagingServiceBuilderImpl)
+	propertyList := toPropertyList(builder.configuration.GetConfiguration(), logging.Default)
+	properties := make(map[string]string)
+	for i := 0; i+1 < len(propertyList); i += 2 {
+		properties[propertyList[i]] = propertyList[i+1]
+	}
+	expected := map[string]string{
+		ccsmp.SolClientSessionPropWebTransportProtocolList:            "WS_BINARY,HTTP_BINARY_STREAMING",
+		ccsmp.SolClientSessionPropTransportProtocolDowngradeTimeoutMs: "5000",
+		ccsmp.SolClientSessionPropGuaranteedWithWebTransport:          ccsmp.SolClientPropEnableVal,
+	}
+	for key, value := range expected {
+		if properties[key] != value {
+			t.Errorf("expected %s to be %s, got %s", key, value, properties[key])
+		}
+	}
+	if _, ok := properties[ccsmp.SolClientSessionPropWebTransportProtocol]; ok {
+		t.Errorf("expected %s to be overridden by the protocol list", ccsmp.SolClientSessionPropWebTransportProtocol)
+	}
+}
+
+func TestConflictingWebTransportProtocols(t *testing.T) {
+	_, err := NewMessagingServiceBuilder().FromConfigurationProvider(config.ServicePropertyMap{
+		config.TransportLayerPropertyHost:                     "ws://localhost",
+		config.ServicePropertyVPNName:                         "default",
+		config.AuthenticationPropertySchemeBasicUserName:      "hello",
+		config.AuthenticationPropertySchemeBasicPassword:      "world",
+		config.TransportLayerPropertyWebTransportProtocol:     config.WebTransportProtocolWebSocketBinary,
+		config.TransportLayerPropertyWebTransportProtocolList: "WS_BINARY,HTTP_BINARY",
+	}).Build()
+	if _, ok := err.(*solace.InvalidConfigurationError); !ok {
+		t.Errorf("expected invalid configuration error, got %v", err)
+	}
+}
+
+func TestWebTransportStrategyReplacesConfiguredProtocols(t *testing.T) {
+	builder := NewMessagingServiceBuilder().FromConfigurationProvider(config.ServicePropertyMap{
+		config.TransportLayerPropertyWebTransportProtocolList: "WS_BINARY,HTTP_BINARY",
+	}).WithWebTransportStrategy(config.NewWebTransportStrategy().
+		WithProtocol(config.WebTransportProtocolHTTPBinary),
+	).(*messagingServiceBuilderImpl)
+	if _, ok := builder.configuration[config.TransportLayerPropertyWebTransportProtocolList]; ok {
+		t.Errorf("expected %s to be replaced by the strategy's protocol", config.TransportLayerPropertyWebTransportProtocolList)
+	}
+	builder = NewMessagingServiceBuilder().FromConfigurationProvider(config.ServicePropertyMap{
+		config.TransportLayerPropertyWebTransportProtocol: config.WebTransportProtocolWebSocketBinary,
+	}).WithWebTransportStrategy(config.NewWebTransportStrategy().
+		WithProtocols(config.WebTransportProtocolHTTPBinary),
+	).(*messagingServiceBuilderImpl)
+	if _, ok := builder.configuration[config.TransportLayerPropertyWebTransportProtocol]; ok {
+		t.Errorf("expected %s to be replaced by the strategy's protocol list", config.TransportLayerPropertyWebTransportProtocol)
+	}
+}
+
+func TestEmptyWebTransportProtocols(t *testing.T) {
+	_, err := NewMessagingServiceBuilder().FromConfigurationProvider(config.ServicePropertyMap{
+		config.TransportLayerPropertyHost:                "ws://localhost",
+		config.ServicePropertyVPNName:                    "default",
+		config.AuthenticationPropertySchemeBasicUserName: "hello",
+		config.AuthenticationPropertySchemeBasicPassword: "world",
+	}).WithWebTransportStrategy(config.NewWebTransportStrategy().WithProtocols()).Build()
+	if _, ok := err.(*solace.InvalidConfigurationError); !ok {
+		t.Errorf("expected invalid configuration error, got %v", err)
+	}
+}
@@ -17,6 +17,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -269,6 +270,30 @@ func (service *messagingServiceImpl) reconnectAttempEventHandler(sessionEventInf
 	}
 }
 
+// ConnectWithContext connects the messaging service, blocking until the connection attempt
+// is completed or the given context is done. If the context is done first, the connection attempt
+// is awaited and the service is disconnected if it succeeded before ctx.Err() is returned.
+func (service *messagingServiceImpl) ConnectWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	result := service.ConnectAsync()
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		// the connection attempt cannot be interrupted, so we wait for it to complete and disconnect
+		// such that the service is not left connected after reporting the cancellation
+		if err := <-result; err == nil {
+			service.logger.Debug("Connect cancelled, disconnecting the service")
+			if err = service.Disconnect(); err != nil && service.logger.IsDebugEnabled() {
+				service.logger.Debug("Failed to disconnect after connect was cancelled: " + err.Error())
+			}
+		}
+		return ctx.Err()
+	}
+}
+
 // ConnectAsync connects the messaging service asynchronously.
 // Returns a channel that will receive an event when completed.
 // Channel will receive nil if successful or an error containing failure details.
@@ -316,6 +341,20 @@ func (service *messagingServiceImpl) CreatePersistentMessageReceiverBuilder() so
 	return receiver.NewPersistentMessageReceiverBuilderImpl(service.transport.Receiver())
 }
 
+// CreateQueueBrowserBuilder creates a new queue browser builder
+// that can be used to configure queue browser instances.
+func (service *messagingServiceImpl) CreateQueueBrowserBuilder() solace.QueueBrowserBuilder {
+	return receiver.NewQueueBrowserBuilderImpl(service.transport.Receiver())
+}
+
+// CreateTransactionalMessagingServiceBuilder creates a new transactional messaging service builder
+// that can be used to configure transactional messaging service instances.
+func (service *messagingServiceImpl) CreateTransactionalMessagingServiceBuilder() solace.TransactionalMessagingServiceBuilder {
+	return &transactionalMessagingServiceBuilderImpl{
+		messagingService: service,
+	}
+}
+
 // MessageBuilder creates a new outbound message builder that can be
 // used to build messages to send via a message publisher.
 // Should this just be called MessageBuilder?
@@ -368,6 +407,21 @@ func (service *messagingServiceImpl) Disconnect() (ret error) {
 	return err
 }
 
+// DisconnectWithContext disconnects the messaging service, blocking until the disconnection attempt
+// is completed or the given context is done. If the context is done first, ctx.Err() is returned
+// and the disconnection continues in the background.
+func (service *messagingServiceImpl) DisconnectWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case err := <-service.DisconnectAsync():
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func (service *messagingServiceImpl) unregisterEventHandlers() {
 	// we also want to remove the event handler before calling disconnect so we don't try and call disconnect again
 	service.transport.Events().RemoveEventHandler(service.downEventHandlerRegisteredEventID)
@@ -481,11 +535,27 @@ func (service *messagingServiceImpl) GetApplicationID() string {
 	return service.transport.ID()
 }
 
+// GetClientName gets the client name of the session.
+func (service *messagingServiceImpl) GetClientName() string {
+	return service.transport.ID()
+}
+
+// GetWebTransportProtocolInUse retrieves the web transport protocol negotiated with the broker,
+// or an empty string if the MessagingService is not connected over a web transport.
+func (service *messagingServiceImpl) GetWebTransportProtocolInUse() config.WebTransportProtocol {
+	return config.WebTransportProtocol(service.transport.WebTransportProtocolInUse())
+}
+
 // Metrics will return the metrics for this MessagingService instance.
 func (service *messagingServiceImpl) Metrics() metrics.APIMetrics {
 	return &metricsImpl{metricsHandle: service.transport.Metrics()}
 }
 
+// LatencyMetrics will return the latency metrics for this MessagingService instance.
+func (service *messagingServiceImpl) LatencyMetrics() metrics.LatencyMetrics {
+	return &latencyMetricsImpl{metricsHandle: service.transport.Metrics()}
+}
+
 // Info will return the API Info for this MessagingService instance.
 func (service *messagingServiceImpl) Info() metrics.APIInfo {
 	version, buildDate, variant := core.GetVersion()
@@ -17,6 +17,7 @@
 package impl
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -216,6 +217,110 @@ func TestMessagingServiceConnectBlockingUntilComplete(t *testing.T) {
 	}
 }
 
+func TestMessagingServiceConnectWithContextCancelled(t *testing.T) {
+	service := newMessagingServiceImpl(logging.Default)
+	mockTransport := &solClientTransportMock{}
+	service.transport = mockTransport
+
+	connectCalled := make(chan struct{})
+	blockingChannel := make(chan struct{})
+	mockTransport.connect = func() error {
+		close(connectCalled)
+		<-blockingChannel
+		return nil
+	}
+	disconnectCalled := make(chan struct{})
+	mockTransport.disconnect = func() error {
+		close(disconnectCalled)
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() {
+		result <- service.ConnectWithContext(ctx)
+	}()
+	select {
+	case <-connectCalled:
+		// success
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timed out waiting for transport connect to be called")
+	}
+	cancel()
+
+	select {
+	case <-result:
+		t.Error("did not expect return until the connection attempt completed")
+	case <-time.After(100 * time.Millisecond):
+		// success
+	}
+
+	close(blockingChannel)
+	select {
+	case err := <-result:
+		if err != context.Canceled {
+			t.Errorf("expected error to be context.Canceled, got %v", err)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timed out waiting for result")
+	}
+	select {
+	case <-disconnectCalled:
+		// success
+	default:
+		t.Error("expected the service to be disconnected after the cancelled connect succeeded")
+	}
+	if service.IsConnected() {
+		t.Error("returned IsConnected true after the cancelled connect")
+	}
+}
+
+func TestMessagingServiceDisconnectWithContextCancelled(t *testing.T) {
+	service := newMessagingServiceImpl(logging.Default)
+	mockTransport := &solClientTransportMock{}
+	service.transport = mockTransport
+
+	disconnectCalled := make(chan struct{})
+	blockingChannel := make(chan struct{})
+	mockTransport.disconnect = func() error {
+		close(disconnectCalled)
+		<-blockingChannel
+		return nil
+	}
+	if err := service.Connect(); err != nil {
+		t.Fatalf("expected connect to succeed, got %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+	go func() {
+		result <- service.DisconnectWithContext(ctx)
+	}()
+	select {
+	case <-disconnectCalled:
+		// success
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timed out waiting for transport disconnect to be called")
+	}
+	cancel()
+	select {
+	case err := <-result:
+		if err != context.Canceled {
+			t.Errorf("expected error to be context.Canceled, got %v", err)
+		}
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timed out waiting for result")
+	}
+
+	close(blockingChannel)
+	if err := service.Disconnect(); err != nil {
+		t.Errorf("expected the background disconnect to succeed, got %s", err)
+	}
+	if service.IsConnected() {
+		t.Error("returned IsConnected true after disconnect")
+	}
+}
+
 func TestMessagingServiceDisconnectIdempotence(t *testing.T) {
 	service := newMessagingServiceImpl(logging.Default)
 	mockTransport := &solClientTransportMock{}
@@ -314,6 +419,7 @@ type solClientTransportMock struct {
 	metrics             func() core.Metrics
 	events              func() core.Events
 	endpointProvisioner func() core.EndpointProvisioner
+	transactedSession   func(properties []string) (core.TransactedSession, core.ErrorInfo)
 }
 
 func (transport *solClientTransportMock) Connect() error {
@@ -376,10 +482,18 @@ func (transport *solClientTransportMock) ID() string {
 	return ""
 }
 
+func (transport *solClientTransportMock) Logger() logging.LogLevelLogger {
+	return logging.Default
+}
+
 func (transport *solClientTransportMock) Host() string {
 	return ""
 }
 
+func (transport *solClientTransportMock) WebTransportProtocolInUse() string {
+	return ""
+}
+
 func (transport *solClientTransportMock) ModifySessionProperties(_ []string) error {
 	// FFC: This function is added to pass a check during building. Currently it
 	// is not used, so there is no implementation. The implementation will need
@@ -387,6 +501,13 @@ func (transport *solClientTransportMock) ModifySessionProperties(_ []string) err
 	return nil
 }
 
+func (transport *solClientTransportMock) NewTransactedSession(properties []string) (core.TransactedSession, core.ErrorInfo) {
+	if transport.transactedSession != nil {
+		return transport.transactedSession(properties)
+	}
+	return nil, nil
+}
+
 type solClientTransportEventsMock struct {
 }
 
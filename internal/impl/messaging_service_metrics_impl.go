@@ -37,6 +37,29 @@ func (metrics *metricsImpl) Reset() {
 	metrics.metricsHandle.ResetStats()
 }
 
+// GetResetCount will retrieve the number of times the metrics were reset.
+func (metrics *metricsImpl) GetResetCount() uint64 {
+	return metrics.metricsHandle.GetResetCount()
+}
+
 func (metrics *metricsImpl) String() string {
 	return fmt.Sprintf("metrics.APIMetrics at %p", metrics)
 }
+
+type latencyMetricsImpl struct {
+	metricsHandle core.Metrics
+}
+
+// GetSnapshot will retrieve a summary of the latencies recorded for the given LatencyMetric.
+func (latencyMetrics *latencyMetricsImpl) GetSnapshot(metric metrics.LatencyMetric) metrics.LatencySnapshot {
+	return latencyMetrics.metricsHandle.GetLatencySnapshot(metric)
+}
+
+// Reset will reset all latency metrics.
+func (latencyMetrics *latencyMetricsImpl) Reset() {
+	latencyMetrics.metricsHandle.ResetLatencies()
+}
+
+func (latencyMetrics *latencyMetricsImpl) String() string {
+	return fmt.Sprintf("metrics.LatencyMetrics at %p", latencyMetrics)
+}
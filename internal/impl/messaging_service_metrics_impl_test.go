@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"os"
 	"testing"
+	"time"
 
 	"solace.dev/go/messaging/internal/impl/core"
 	"solace.dev/go/messaging/internal/impl/logging"
@@ -53,6 +54,29 @@ func TestStatsReset(t *testing.T) {
 	}
 }
 
+func TestLatencyMetrics(t *testing.T) {
+	internalMetricsHandle := &dummyMetricsImpl{}
+	latencyMetrics := &latencyMetricsImpl{internalMetricsHandle}
+	expected := metrics.LatencySnapshot{Count: 1, Min: time.Millisecond, Max: time.Millisecond}
+	internalMetricsHandle.getLatencySnapshot = func(metric metrics.LatencyMetric) metrics.LatencySnapshot {
+		if metric != metrics.RequestReplyLatency {
+			t.Errorf("expected request reply latency to be retrieved, got %d", metric)
+		}
+		return expected
+	}
+	if actual := latencyMetrics.GetSnapshot(metrics.RequestReplyLatency); actual != expected {
+		t.Errorf("expected to get %v back, got %v", expected, actual)
+	}
+	called := false
+	internalMetricsHandle.resetLatencies = func() {
+		called = true
+	}
+	latencyMetrics.Reset()
+	if !called {
+		t.Error("metrics handle reset latencies was not called on call to reset")
+	}
+}
+
 func TestGetStatsNoError(t *testing.T) {
 	byteBuffer := &bytes.Buffer{}
 	logging.Default.SetOutput(byteBuffer)
@@ -71,6 +95,9 @@ type dummyMetricsImpl struct {
 	getStat         func(metric metrics.Metric) uint64
 	incrementMetric func(metric core.NextGenMetric, amount uint64)
 	resetStats      func()
+
+	getLatencySnapshot func(metric metrics.LatencyMetric) metrics.LatencySnapshot
+	resetLatencies     func()
 }
 
 func (dummy *dummyMetricsImpl) GetStat(metric metrics.Metric) uint64 {
@@ -91,3 +118,23 @@ func (dummy *dummyMetricsImpl) ResetStats() {
 		dummy.resetStats()
 	}
 }
+
+func (dummy *dummyMetricsImpl) GetResetCount() uint64 {
+	return 0
+}
+
+func (dummy *dummyMetricsImpl) RecordLatency(metric metrics.LatencyMetric, latency time.Duration) {
+}
+
+func (dummy *dummyMetricsImpl) GetLatencySnapshot(metric metrics.LatencyMetric) metrics.LatencySnapshot {
+	if dummy.getLatencySnapshot != nil {
+		return dummy.getLatencySnapshot(metric)
+	}
+	return metrics.LatencySnapshot{}
+}
+
+func (dummy *dummyMetricsImpl) ResetLatencies() {
+	if dummy.resetLatencies != nil {
+		dummy.resetLatencies()
+	}
+}
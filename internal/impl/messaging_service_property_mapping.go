@@ -69,6 +69,9 @@ var servicePropertyToCCSMPMap = map[config.ServiceProperty]property{
 	config.ServicePropertyReceiverDirectSubscriptionReapply: {ccsmp.SolClientSessionPropReapplySubscriptions, booleanConverter},
 	config.ServicePropertyProvisionTimeoutMs:                {ccsmp.SolClientSessionPropProvisionTimeoutMs, durationConverter},
 	config.ServicePropertyPayloadCompressionLevel:           {ccsmp.SolClientSessionPropPayloadCompressionLevel, defaultConverter},
+	config.ServicePropertyPublisherPersistentWindowSize:     {ccsmp.SolClientSessionPropPubWindowSize, defaultConverter},
+	config.ServicePropertyPublisherPersistentAckTimer:       {ccsmp.SolClientSessionPropPubAckTimer, durationConverter},
+	config.ServicePropertyPublisherPersistentAckEventMode:   {ccsmp.SolClientSessionPropAckEventMode, ackEventModeConverter},
 
 	/* Transport Layer Properties */
 	config.TransportLayerPropertyHost:                             {ccsmp.SolClientSessionPropHost, defaultConverter},
@@ -84,6 +87,12 @@ var servicePropertyToCCSMPMap = map[config.ServiceProperty]property{
 	config.TransportLayerPropertySocketTCPOptionNoDelay:           {ccsmp.SolClientSessionPropTCPNodelay, booleanConverter},
 	config.TransportLayerPropertyCompressionLevel:                 {ccsmp.SolClientSessionPropCompressionLevel, defaultConverter},
 
+	/* Web Transport Properties */
+	config.TransportLayerPropertyWebTransportProtocol:                 {ccsmp.SolClientSessionPropWebTransportProtocol, defaultConverter},
+	config.TransportLayerPropertyWebTransportProtocolList:             {ccsmp.SolClientSessionPropWebTransportProtocolList, defaultConverter},
+	config.TransportLayerPropertyWebTransportProtocolDowngradeTimeout: {ccsmp.SolClientSessionPropTransportProtocolDowngradeTimeoutMs, durationConverter},
+	config.TransportLayerPropertyGuaranteedWithWebTransport:           {ccsmp.SolClientSessionPropGuaranteedWithWebTransport, booleanConverter},
+
 	/* Transport Layer Security Property */
 	config.TransportLayerSecurityPropertyCertValidated:          {ccsmp.SolClientSessionPropSslValidateCertificate, booleanConverter},
 	config.TransportLayerSecurityPropertyCertRejectExpired:      {ccsmp.SolClientSessionPropSslValidateCertificateDate, booleanConverter},
@@ -143,3 +152,14 @@ func durationConverter(value interface{}) string {
 	}
 	return fmt.Sprintf("%v", value)
 }
+
+// ackEventModeConverter maps the ack event modes to their ccsmp values
+func ackEventModeConverter(value interface{}) string {
+	switch fmt.Sprintf("%v", value) {
+	case config.PersistentPublisherAckEventModePerMessage:
+		return ccsmp.SolClientSessionPropAckEventModePerMsg
+	case config.PersistentPublisherAckEventModeWindowed:
+		return ccsmp.SolClientSessionPropAckEventModeWindowed
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -18,6 +18,7 @@
 package provisioner
 
 import (
+	"context"
 	"fmt"
 
 	"solace.dev/go/messaging/internal/ccsmp"
@@ -43,11 +44,17 @@ func NewEndpointProvisionerImpl(internalEndpointProvisioner core.EndpointProvisi
 	return &endpointProvisionerImpl{
 		// default properties
 		properties:                  constants.DefaultEndpointProperties.GetConfiguration(),
-		logger:                      logging.For(endpointProvisionerImpl{}),
+		logger:                      internalEndpointProvisioner.Logger().For(endpointProvisionerImpl{}),
 		internalEndpointProvisioner: internalEndpointProvisioner,
 	}
 }
 
+// endpointKindNames maps the ccsmp endpoint types to names used in log messages
+var endpointKindNames = map[string]string{
+	ccsmp.SolClientEndpointPropQueue: "queue",
+	ccsmp.SolClientEndpointPropTe:    "topic endpoint",
+}
+
 func mapEndpointPermissionToCcsmpProp(propValue string) string {
 	// the ccsmp permissions map
 	ccsmpPermissionProperties := map[string]string{
@@ -62,10 +69,9 @@ func mapEndpointPermissionToCcsmpProp(propValue string) string {
 }
 
 // validateEndpointProperties function
-func validateEndpointProperties(properties config.EndpointPropertyMap) ([]string, error) {
+func validateEndpointProperties(endpointType string, properties config.EndpointPropertyMap) ([]string, error) {
 	propertiesList := []string{}
-	// We can only provision Queue Endpoints for now; should support Topic Endpoints in the future
-	propertiesList = append(propertiesList, ccsmp.SolClientEndpointPropID, ccsmp.SolClientEndpointPropQueue)
+	propertiesList = append(propertiesList, ccsmp.SolClientEndpointPropID, endpointType)
 
 	for property, value := range properties {
 		switch property {
@@ -89,6 +95,10 @@ func validateEndpointProperties(properties config.EndpointPropertyMap) ([]string
 				if err != nil {
 					return nil, err
 				}
+				// topic endpoints are always exclusive, the access type only applies to queues
+				if endpointType != ccsmp.SolClientEndpointPropQueue {
+					continue
+				}
 				// set access type for the endpoint (queues only)
 				propertiesList = append(propertiesList, ccsmp.SolClientEndpointPropAccesstype)
 				if isExclusive {
@@ -175,7 +185,35 @@ func validateEndpointProperties(properties config.EndpointPropertyMap) ([]string
 // that a queue with the same name and properties already exists.
 // Blocks until the operation is finished on the broker, returns the provision outcome.
 func (provisioner *endpointProvisionerImpl) Provision(queueName string, ignoreExists bool) solace.ProvisionOutcome {
-	// Implementation here
+	return provisioner.provision(context.Background(), ccsmp.SolClientEndpointPropQueue, queueName, ignoreExists)
+}
+
+// ProvisionWithContext provisions a queue with the specified name on the broker bearing
+// all the properties configured on the Provisioner.
+// Blocks until the operation is finished on the broker or the given context is done.
+// If the context is done first, the outcome holds ctx.Err() and the provision correlation is released.
+func (provisioner *endpointProvisionerImpl) ProvisionWithContext(ctx context.Context, queueName string, ignoreExists bool) solace.ProvisionOutcome {
+	return provisioner.provision(ctx, ccsmp.SolClientEndpointPropQueue, queueName, ignoreExists)
+}
+
+// ProvisionTopicEndpoint provisions a topic endpoint with the specified name on the broker bearing
+// all the properties configured on the Provisioner.
+// Blocks until the operation is finished on the broker, returns the provision outcome.
+func (provisioner *endpointProvisionerImpl) ProvisionTopicEndpoint(topicEndpointName string, ignoreExists bool) solace.ProvisionOutcome {
+	return provisioner.provision(context.Background(), ccsmp.SolClientEndpointPropTe, topicEndpointName, ignoreExists)
+}
+
+// ProvisionTopicEndpointWithContext provisions a topic endpoint with the specified name on the broker
+// bearing all the properties configured on the Provisioner.
+// Blocks until the operation is finished on the broker or the given context is done.
+// If the context is done first, the outcome holds ctx.Err() and the provision correlation is released.
+func (provisioner *endpointProvisionerImpl) ProvisionTopicEndpointWithContext(ctx context.Context, topicEndpointName string, ignoreExists bool) solace.ProvisionOutcome {
+	return provisioner.provision(ctx, ccsmp.SolClientEndpointPropTe, topicEndpointName, ignoreExists)
+}
+
+// provision an endpoint of the given ccsmp endpoint type with the specified name
+func (provisioner *endpointProvisionerImpl) provision(ctx context.Context, endpointType string, endpointName string, ignoreExists bool) solace.ProvisionOutcome {
+	endpointKind := endpointKindNames[endpointType]
 	provisionOutcome := provisionOutcome{
 		ok:                 false,
 		err:                nil,
@@ -190,17 +228,16 @@ func (provisioner *endpointProvisionerImpl) Provision(queueName string, ignoreEx
 
 	properties := provisioner.properties.GetConfiguration()
 
-	endpointProperties, err := validateEndpointProperties(properties)
+	endpointProperties, err := validateEndpointProperties(endpointType, properties)
 	if err != nil {
 		// return provision outcome with error
 		provisionOutcome.err = err
 		return &provisionOutcome
 	}
 
-	// We want to provision a queue
-	endpointProperties = append(endpointProperties, ccsmp.SolClientEndpointPropName, queueName)
+	endpointProperties = append(endpointProperties, ccsmp.SolClientEndpointPropName, endpointName)
 
-	// continue to provision the queue here
+	// continue to provision the endpoint here
 	correlationID, result, errInfo := provisioner.internalEndpointProvisioner.Provision(endpointProperties, ignoreExists)
 	if errInfo != nil {
 		provisionErr := core.ToNativeError(errInfo, constants.FailedToProvisionEndpoint)
@@ -210,23 +247,30 @@ func (provisioner *endpointProvisionerImpl) Provision(queueName string, ignoreEx
 
 	if provisioner.logger.IsDebugEnabled() {
 		provisioner.logger.
-			Debug(fmt.Sprintf("Provision awaiting confirm on provision outcome for queue: '%s' with CorrelationID: %v", queueName, correlationID))
+			Debug(fmt.Sprintf("Provision awaiting confirm on provision outcome for %s: '%s' with CorrelationID: %v", endpointKind, endpointName, correlationID))
 	}
 	// result channel should not be nil
 	if result == nil {
 		provisionOutcome.ok = false
-		provisionOutcome.err = solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf("%sinvalid provision outcome channel for queue '%s' with CorrelationID: %v", constants.FailedToProvisionEndpoint, queueName, correlationID), nil)
+		provisionOutcome.err = solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf("%sinvalid provision outcome channel for %s '%s' with CorrelationID: %v", constants.FailedToProvisionEndpoint, endpointKind, endpointName, correlationID), nil)
 		return &provisionOutcome
 	}
 
-	// block until we get provision outcome
+	// block until we get provision outcome or the context is done
 	// should timeout from ccsmp if waiting for timeout exceeds
-	event := <-result
+	var event core.ProvisionEvent
+	select {
+	case event = <-result:
+	case <-ctx.Done():
+		provisioner.internalEndpointProvisioner.ClearProvisionCorrelation(correlationID)
+		provisionOutcome.err = ctx.Err()
+		return &provisionOutcome
+	}
 	if provisioner.logger.IsDebugEnabled() {
 		if event.GetError() != nil {
-			provisioner.logger.Debug(fmt.Sprintf("Provision received error for queue: '%s' with CorrelationID: %v. Error: %s", queueName, correlationID, event.GetError().Error()))
+			provisioner.logger.Debug(fmt.Sprintf("Provision received error for %s: '%s' with CorrelationID: %v. Error: %s", endpointKind, endpointName, correlationID, event.GetError().Error()))
 		} else {
-			provisioner.logger.Debug(fmt.Sprintf("Provision received confirm for queue: '%s' with CorrelationID: %v", queueName, correlationID))
+			provisioner.logger.Debug(fmt.Sprintf("Provision received confirm for %s: '%s' with CorrelationID: %v", endpointKind, endpointName, correlationID))
 		}
 	}
 
@@ -289,7 +333,32 @@ func (provisioner *endpointProvisionerImpl) ProvisionAsyncWithCallback(queueName
 // turns the "no such queue" error into nil.
 // Blocks until the operation is finished on the broker, returns the nil or an error
 func (provisioner *endpointProvisionerImpl) Deprovision(queueName string, ignoreMissing bool) error {
-	// Implementation here
+	return provisioner.deprovision(context.Background(), ccsmp.SolClientEndpointPropQueue, queueName, ignoreMissing)
+}
+
+// DeprovisionWithContext (deletes) the queue with the given name from the broker.
+// Blocks until the operation is finished on the broker or the given context is done.
+// If the context is done first, ctx.Err() is returned and the deprovision correlation is released.
+func (provisioner *endpointProvisionerImpl) DeprovisionWithContext(ctx context.Context, queueName string, ignoreMissing bool) error {
+	return provisioner.deprovision(ctx, ccsmp.SolClientEndpointPropQueue, queueName, ignoreMissing)
+}
+
+// DeprovisionTopicEndpoint (deletes) the topic endpoint with the given name from the broker.
+// Blocks until the operation is finished on the broker, returns the nil or an error
+func (provisioner *endpointProvisionerImpl) DeprovisionTopicEndpoint(topicEndpointName string, ignoreMissing bool) error {
+	return provisioner.deprovision(context.Background(), ccsmp.SolClientEndpointPropTe, topicEndpointName, ignoreMissing)
+}
+
+// DeprovisionTopicEndpointWithContext (deletes) the topic endpoint with the given name from the broker.
+// Blocks until the operation is finished on the broker or the given context is done.
+// If the context is done first, ctx.Err() is returned and the deprovision correlation is released.
+func (provisioner *endpointProvisionerImpl) DeprovisionTopicEndpointWithContext(ctx context.Context, topicEndpointName string, ignoreMissing bool) error {
+	return provisioner.deprovision(ctx, ccsmp.SolClientEndpointPropTe, topicEndpointName, ignoreMissing)
+}
+
+// deprovision an endpoint of the given ccsmp endpoint type with the specified name
+func (provisioner *endpointProvisionerImpl) deprovision(ctx context.Context, endpointType string, endpointName string, ignoreMissing bool) error {
+	endpointKind := endpointKindNames[endpointType]
 	if !provisioner.internalEndpointProvisioner.IsRunning() {
 		// we error if the provisioner is not running
 		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToDeprovisionParentServiceNotStarted, nil)
@@ -298,41 +367,46 @@ func (provisioner *endpointProvisionerImpl) Deprovision(queueName string, ignore
 	// we don't need all these properties for deprov
 	properties := provisioner.properties.GetConfiguration()
 
-	endpointProperties, err := validateEndpointProperties(properties)
+	endpointProperties, err := validateEndpointProperties(endpointType, properties)
 	if err != nil {
 		// return deprovision error
 		return err
 	}
 
-	// We want to provision a queue
-	endpointProperties = append(endpointProperties, ccsmp.SolClientEndpointPropName, queueName)
+	endpointProperties = append(endpointProperties, ccsmp.SolClientEndpointPropName, endpointName)
 
-	// continue to provision the queue here
+	// continue to deprovision the endpoint here
 	correlationID, result, errInfo := provisioner.internalEndpointProvisioner.Deprovision(endpointProperties, ignoreMissing)
 	if errInfo != nil {
 		return core.ToNativeError(errInfo, constants.FailedToDeprovisionEndpoint)
 	}
 	if provisioner.logger.IsDebugEnabled() {
-		provisioner.logger.Debug(fmt.Sprintf("Deprovision awaiting confirm on deprovision outcome for queue: '%s' with CorrelationID: %v", queueName, correlationID))
+		provisioner.logger.Debug(fmt.Sprintf("Deprovision awaiting confirm on deprovision outcome for %s: '%s' with CorrelationID: %v", endpointKind, endpointName, correlationID))
 	}
 
 	// result channel should not be nil
 	if result == nil {
-		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf("%sinvalid deprovision result channel for queue '%s' with CorrelationID: %v", constants.FailedToDeprovisionEndpoint, queueName, correlationID), nil)
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf("%sinvalid deprovision result channel for %s '%s' with CorrelationID: %v", constants.FailedToDeprovisionEndpoint, endpointKind, endpointName, correlationID), nil)
 	}
 
-	// block until we get provision outcome
+	// block until we get deprovision outcome or the context is done
 	// should timeout from ccsmp if waiting for timeout exceeds
-	event := <-result
+	var event core.ProvisionEvent
+	select {
+	case event = <-result:
+	case <-ctx.Done():
+		provisioner.internalEndpointProvisioner.ClearProvisionCorrelation(correlationID)
+		return ctx.Err()
+	}
 	if provisioner.logger.IsDebugEnabled() {
 		if event.GetError() != nil {
-			provisioner.logger.Debug(fmt.Sprintf("Deprovision received error for queue: '%s' with CorrelationID: %v. Error: %s", queueName, correlationID, event.GetError().Error()))
+			provisioner.logger.Debug(fmt.Sprintf("Deprovision received error for %s: '%s' with CorrelationID: %v. Error: %s", endpointKind, endpointName, correlationID, event.GetError().Error()))
 		} else {
-			provisioner.logger.Debug(fmt.Sprintf("Deprovision received confirm for queue: '%s' with CorrelationID: %v", queueName, correlationID))
+			provisioner.logger.Debug(fmt.Sprintf("Deprovision received confirm for %s: '%s' with CorrelationID: %v", endpointKind, endpointName, correlationID))
 		}
 	}
 
-	// an error occurred while deprovisioning queue
+	// an error occurred while deprovisioning the endpoint
 	if event.GetError() != nil {
 		return event.GetError()
 	}
@@ -372,6 +446,48 @@ func (provisioner *endpointProvisionerImpl) DeprovisionAsyncWithCallback(queueNa
 	}()
 }
 
+// ProvisionTopicEndpointAsync will asynchronously provision a topic endpoint with the specified name on
+// the broker bearing all the properties configured on the Provisioner.
+// Returns a channel immediately that receives the endpoint provision outcome when completed.
+func (provisioner *endpointProvisionerImpl) ProvisionTopicEndpointAsync(topicEndpointName string, ignoreExists bool) <-chan solace.ProvisionOutcome {
+	result := make(chan solace.ProvisionOutcome, 1)
+	go func() {
+		result <- provisioner.ProvisionTopicEndpoint(topicEndpointName, ignoreExists)
+		close(result)
+	}()
+	return result
+}
+
+// ProvisionTopicEndpointAsyncWithCallback will asynchronously provision a topic endpoint with the specified
+// name on the broker bearing all the properties configured on the Provisioner.
+// Returns immediately and registers a callback that will receive an
+// outcome for the endpoint provision.
+func (provisioner *endpointProvisionerImpl) ProvisionTopicEndpointAsyncWithCallback(topicEndpointName string, ignoreExists bool, callback func(solace.ProvisionOutcome)) {
+	go func() {
+		callback(provisioner.ProvisionTopicEndpoint(topicEndpointName, ignoreExists))
+	}()
+}
+
+// DeprovisionTopicEndpointAsync will asynchronously deprovision (deletes) the topic endpoint with the given
+// name from the broker. Returns a channel immediately that receives nil or an error.
+func (provisioner *endpointProvisionerImpl) DeprovisionTopicEndpointAsync(topicEndpointName string, ignoreMissing bool) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- provisioner.DeprovisionTopicEndpoint(topicEndpointName, ignoreMissing)
+		close(result)
+	}()
+	return result
+}
+
+// DeprovisionTopicEndpointAsyncWithCallback will asynchronously deprovision (deletes) the topic endpoint
+// with the given name on the broker. Returns immediately and registers a callback that will receive an
+// error if deprovision on the broker fails.
+func (provisioner *endpointProvisionerImpl) DeprovisionTopicEndpointAsyncWithCallback(topicEndpointName string, ignoreMissing bool, callback func(err error)) {
+	go func() {
+		callback(provisioner.DeprovisionTopicEndpoint(topicEndpointName, ignoreMissing))
+	}()
+}
+
 // FromConfigurationProvider will set the given properties to the resulting message.
 func (provisioner *endpointProvisionerImpl) FromConfigurationProvider(properties config.EndpointPropertiesConfigurationProvider) solace.EndpointProvisioner {
 	mergeEndpointPropertyMap(provisioner.properties, properties)
@@ -17,9 +17,12 @@
 package provisioner
 
 import (
+	"context"
 	"testing"
 
+	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
 )
 
 func TestEndpointProvisionerBuilderWithInvalidDurabilityType(t *testing.T) {
@@ -76,6 +79,96 @@ func TestEndpointProvisionerBuilderWithValidZeroQuotaMB(t *testing.T) {
 	}
 }
 
+func TestEndpointProvisionerProvisionTopicEndpoint(t *testing.T) {
+	var provisionedProperties []string
+	internalProvisioner := &mockInternalEndpointProvisioner{}
+	internalProvisioner.provision = func(properties []string, ignoreExistErrors bool) (core.ProvisionCorrelationID, <-chan core.ProvisionEvent, core.ErrorInfo) {
+		provisionedProperties = properties
+		provisionOutcomeChannel := make(chan core.ProvisionEvent, 1)
+		provisionOutcomeChannel <- &provisionEvent{}
+		return 0, provisionOutcomeChannel, nil
+	}
+	provisioner := NewEndpointProvisionerImpl(internalProvisioner)
+	provisioner.WithExclusiveAccess(false) // access type only applies to queues
+	outcome := provisioner.ProvisionTopicEndpoint("hello", true)
+	if !outcome.GetStatus() || outcome.GetError() != nil {
+		t.Error("Did not expect error while provisioning topic endpoint. Error: ", outcome.GetError())
+	}
+	propertyMap := make(map[string]string)
+	for i := 0; i+1 < len(provisionedProperties); i += 2 {
+		propertyMap[provisionedProperties[i]] = provisionedProperties[i+1]
+	}
+	if propertyMap[ccsmp.SolClientEndpointPropID] != ccsmp.SolClientEndpointPropTe {
+		t.Errorf("expected endpoint ID to be topic endpoint, got %s", propertyMap[ccsmp.SolClientEndpointPropID])
+	}
+	if propertyMap[ccsmp.SolClientEndpointPropName] != "hello" {
+		t.Errorf("expected endpoint name to be 'hello', got %s", propertyMap[ccsmp.SolClientEndpointPropName])
+	}
+	if _, ok := propertyMap[ccsmp.SolClientEndpointPropAccesstype]; ok {
+		t.Error("did not expect access type to be set when provisioning a topic endpoint")
+	}
+}
+
+func TestEndpointProvisionerProvisionWithContextCancelled(t *testing.T) {
+	const correlationID = core.ProvisionCorrelationID(42)
+	var cleared []core.ProvisionCorrelationID
+	internalProvisioner := &mockInternalEndpointProvisioner{}
+	internalProvisioner.provision = func(properties []string, ignoreExistErrors bool) (core.ProvisionCorrelationID, <-chan core.ProvisionEvent, core.ErrorInfo) {
+		// the broker never responds
+		return correlationID, make(chan core.ProvisionEvent, 1), nil
+	}
+	internalProvisioner.deprovision = internalProvisioner.provision
+	internalProvisioner.clearProvisionCorrelation = func(id core.ProvisionCorrelationID) {
+		cleared = append(cleared, id)
+	}
+	provisioner := NewEndpointProvisionerImpl(internalProvisioner)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	outcome := provisioner.ProvisionWithContext(ctx, "hello", true)
+	if outcome.GetStatus() {
+		t.Error("expected provision outcome to fail when the context is cancelled")
+	}
+	if outcome.GetError() != context.Canceled {
+		t.Errorf("expected error to be context.Canceled, got %v", outcome.GetError())
+	}
+	if err := provisioner.DeprovisionWithContext(ctx, "hello", true); err != context.Canceled {
+		t.Errorf("expected error to be context.Canceled, got %v", err)
+	}
+	if len(cleared) != 2 || cleared[0] != correlationID || cleared[1] != correlationID {
+		t.Errorf("expected provision correlation %v to be cleared twice, got %v", correlationID, cleared)
+	}
+}
+
+func TestEndpointProvisionerProvisionTopicEndpointWithContextCancelled(t *testing.T) {
+	const correlationID = core.ProvisionCorrelationID(42)
+	var cleared []core.ProvisionCorrelationID
+	internalProvisioner := &mockInternalEndpointProvisioner{}
+	internalProvisioner.provision = func(properties []string, ignoreExistErrors bool) (core.ProvisionCorrelationID, <-chan core.ProvisionEvent, core.ErrorInfo) {
+		// the broker never responds
+		return correlationID, make(chan core.ProvisionEvent, 1), nil
+	}
+	internalProvisioner.deprovision = internalProvisioner.provision
+	internalProvisioner.clearProvisionCorrelation = func(id core.ProvisionCorrelationID) {
+		cleared = append(cleared, id)
+	}
+	provisioner := NewEndpointProvisionerImpl(internalProvisioner)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	outcome := provisioner.ProvisionTopicEndpointWithContext(ctx, "hello", true)
+	if outcome.GetStatus() {
+		t.Error("expected provision outcome to fail when the context is cancelled")
+	}
+	if outcome.GetError() != context.Canceled {
+		t.Errorf("expected error to be context.Canceled, got %v", outcome.GetError())
+	}
+	if err := provisioner.DeprovisionTopicEndpointWithContext(ctx, "hello", true); err != context.Canceled {
+		t.Errorf("expected error to be context.Canceled, got %v", err)
+	}
+	if len(cleared) != 2 || cleared[0] != correlationID || cleared[1] != correlationID {
+		t.Errorf("expected provision correlation %v to be cleared twice, got %v", correlationID, cleared)
+	}
+}
+
 type mockInternalEndpointProvisioner struct {
 	events                    func() core.Events
 	isRunning                 func() bool
@@ -91,6 +184,10 @@ func (mock *mockInternalEndpointProvisioner) Events() core.Events {
 	return &mockEvents{}
 }
 
+func (mock *mockInternalEndpointProvisioner) Logger() logging.LogLevelLogger {
+	return logging.Default
+}
+
 func (mock *mockInternalEndpointProvisioner) IsRunning() bool {
 	if mock.isRunning != nil {
 		return mock.isRunning()
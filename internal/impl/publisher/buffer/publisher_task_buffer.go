@@ -18,7 +18,7 @@
 package buffer
 
 import (
-	"time"
+	"context"
 
 	"solace.dev/go/messaging/internal/impl/core"
 	"solace.dev/go/messaging/internal/impl/logging"
@@ -33,8 +33,8 @@ type PublisherTaskBuffer interface {
 	Run()
 	// Call to submit into the buffer, will succeed unless we are terminating
 	Submit(task PublisherTask) bool
-	// Call to terminate that will attempt to shutdown gracefully
-	Terminate(timer *time.Timer) bool
+	// Call to terminate that will attempt to shutdown gracefully until the given context is done
+	Terminate(ctx context.Context) bool
 	// Call terminate without waiting for the event loop to complete
 	TerminateNow()
 }
@@ -86,17 +86,13 @@ func (buffer *channelBasedPublisherTaskBuffer) Submit(task PublisherTask) bool {
 	}
 }
 
-func (buffer *channelBasedPublisherTaskBuffer) Terminate(timer *time.Timer) bool {
+func (buffer *channelBasedPublisherTaskBuffer) Terminate(ctx context.Context) bool {
 	close(buffer.terminateGraceful)
-	if timer == nil {
-		<-buffer.terminateComplete
-		return true
-	}
 	select {
 	case <-buffer.terminateComplete:
 		// yay graceful termination
 		return true
-	case <-timer.C:
+	case <-ctx.Done():
 		// terminate the task if there is one
 		close(buffer.terminateTask)
 		// time for ungraceful termaintion
@@ -17,6 +17,7 @@
 package buffer
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -44,7 +45,7 @@ func TestTaskBufferSubmitTask(t *testing.T) {
 	default:
 		t.Error("function passed through task buffer did not execute")
 	}
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if !graceful {
 		t.Error("expected task buffer to be shut down gracefully")
 	}
@@ -54,7 +55,7 @@ func TestExecutorSubmitAfterTermination(t *testing.T) {
 	testSharedBuffer := make(chan core.SendTask)
 	taskBuffer := NewChannelBasedPublisherTaskBuffer(100, func() chan core.SendTask { return testSharedBuffer })
 	go taskBuffer.Run()
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if !graceful {
 		t.Error("expected task buffer to be shut down gracefully")
 	}
@@ -88,7 +89,7 @@ func TestExecutorSubmitTaskFullBuffer(t *testing.T) {
 	case <-time.After(100 * time.Millisecond):
 		// success
 	}
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if !graceful {
 		t.Error("expected task buffer to be shut down gracefully")
 	}
@@ -110,7 +111,7 @@ func TestExecutorTerminateUngracefullyWithInFlightTasks(t *testing.T) {
 	case <-time.After(100 * time.Millisecond):
 		t.Error("timed out waiting for function to be pushed to the shared buffer")
 	}
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if graceful {
 		t.Error("expected an ungraceful shutdown when task is blocking forever, but we shutdown gracefully")
 	}
@@ -128,7 +129,7 @@ func TestExecutorTerminationWhileWaitingForTask(t *testing.T) {
 	go taskBuffer.Run()
 	// Wait for the task buffer to start waiting for a task
 	<-time.After(100 * time.Millisecond)
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if !graceful {
 		t.Error("expected task buffer to be shut down gracefully")
 	}
@@ -154,7 +155,7 @@ func TestExecutorTerminationWhileWaitingForTaskSubmission(t *testing.T) {
 	}
 	// Wait for the task buffer to start waiting for a task
 	<-time.After(100 * time.Millisecond)
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if !graceful {
 		t.Error("expected task buffer to be shut down gracefully")
 	}
@@ -173,7 +174,7 @@ func TestExecutorTerminationUngraceful(t *testing.T) {
 	if !success {
 		t.Error("expected task to be submitted, but it was rejected")
 	}
-	graceful := taskBuffer.Terminate(time.NewTimer(100 * time.Millisecond))
+	graceful := taskBuffer.Terminate(gracePeriod(t, 100*time.Millisecond))
 	if graceful {
 		t.Error("expected task buffer to be shut down ungracefully")
 	}
@@ -197,7 +198,7 @@ func TestExecutorTerminateJoin(t *testing.T) {
 	unblocked := false
 	terminateSuccess := make(chan struct{})
 	go func() {
-		graceful := taskBuffer.Terminate(time.NewTimer(10 * time.Millisecond))
+		graceful := taskBuffer.Terminate(gracePeriod(t, 10*time.Millisecond))
 		if graceful {
 			t.Error("expected task buffer to be shut down ungracefully")
 		}
@@ -251,3 +252,10 @@ func TestExecutorTerminateNow(t *testing.T) {
 		t.Error("timed out waiting for task to complete")
 	}
 }
+
+// gracePeriod returns a context that is done once the given grace period has elapsed
+func gracePeriod(t *testing.T, duration time.Duration) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), duration)
+	t.Cleanup(cancel)
+	return ctx
+}
@@ -18,6 +18,7 @@
 package publisher
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -37,6 +38,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
@@ -69,7 +71,7 @@ func (publisher *directMessagePublisherImpl) construct(internalPublisher core.Pu
 		publisher.taskBuffer = buffer.NewChannelBasedPublisherTaskBuffer(bufferSize, publisher.internalPublisher.TaskQueue)
 	}
 	publisher.terminateWaitInterrupt = make(chan struct{})
-	publisher.logger = logging.For(publisher)
+	publisher.logger = publisher.internalPublisher.Logger().For(publisher)
 }
 
 func (publisher *directMessagePublisherImpl) onDownEvent(eventInfo core.SessionEventInfo) {
@@ -153,7 +155,17 @@ func (publisher *directMessagePublisherImpl) StartAsyncCallback(callback func(so
 // unfinished tasks or in-flight messages.
 // This function blocks until the service is terminated.
 // If gracePeriod is less than 0, the function will wait indefinitely.
-func (publisher *directMessagePublisherImpl) Terminate(gracePeriod time.Duration) (err error) {
+func (publisher *directMessagePublisherImpl) Terminate(gracePeriod time.Duration) error {
+	ctx, cancel := gracePeriodContext(gracePeriod)
+	defer cancel()
+	return publisher.TerminateWithContext(ctx)
+}
+
+// TerminateWithContext will terminate the service gracefully and synchronously.
+// A graceful shutdown will be attempted until the given context is done, at which point
+// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+// This function blocks until the service is terminated.
+func (publisher *directMessagePublisherImpl) TerminateWithContext(ctx context.Context) (err error) {
 	if proceed, err := publisher.terminate(); !proceed {
 		return err
 	}
@@ -184,12 +196,8 @@ func (publisher *directMessagePublisherImpl) Terminate(gracePeriod time.Duration
 		// First we interrupt all backpressure wait functions
 		close(publisher.terminateWaitInterrupt)
 
-		var timer *time.Timer
-		if gracePeriod >= 0 {
-			timer = time.NewTimer(gracePeriod)
-		}
 		// start by shutting down the task buffer
-		graceful := publisher.taskBuffer.Terminate(timer)
+		graceful := publisher.taskBuffer.Terminate(ctx)
 		// adjust the grace period to the remaining time (approximate)
 		if !graceful {
 			publisher.logger.Debug("Task buffer terminated ungracefully")
@@ -210,7 +218,7 @@ func (publisher *directMessagePublisherImpl) Terminate(gracePeriod time.Duration
 				}
 			}
 			// return an error if we have one
-			publisher.internalPublisher.IncrementMetric(core.MetricPublishMessagesTerminationDiscarded, uint64(undeliveredCount))
+			publisher.incrementMetric(core.MetricPublishMessagesTerminationDiscarded, uint64(undeliveredCount))
 			err := solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageDeliveryMessage, undeliveredCount), nil)
 			return err
 		}
@@ -245,7 +253,7 @@ func (publisher *directMessagePublisherImpl) unsolicitedTermination(errorInfo co
 			}
 			// return an error if we have one
 			err = solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageDeliveryMessage, undeliveredCount), nil)
-			publisher.internalPublisher.IncrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
+			publisher.incrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
 		}
 	} else {
 		publisher.internalPublisher.Events().RemoveEventHandler(publisher.canSendEventHandlerID)
@@ -438,6 +446,7 @@ func (publisher *directMessagePublisherImpl) publish(msg *message.OutboundMessag
 		defer msg.Dispose()
 		// publish directly with CCSMP
 		errorInfo := publisher.internalPublisher.Publish(message.GetOutboundMessagePointer(msg))
+		publisher.recordPublish(msg, errorInfo)
 		if errorInfo != nil {
 			if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
 				return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
@@ -475,6 +484,7 @@ func (publisher *directMessagePublisherImpl) publish(msg *message.OutboundMessag
 			case publisher.buffer <- pub:
 				channelWrite = true // we successfully wrote the message to the channel
 			default:
+				publisher.metrics.increment(metrics.PublisherBackpressureEvents, 1)
 				return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
 			}
 		} else {
@@ -509,6 +519,7 @@ func (publisher *directMessagePublisherImpl) sendTask(msg *message.OutboundMessa
 		for {
 			// attempt a publish
 			errorInfo = publisher.internalPublisher.Publish(message.GetOutboundMessagePointer(msg))
+			publisher.recordPublish(msg, errorInfo)
 			if errorInfo != nil {
 				// if we got a would block, wait for ready and retry
 				if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
@@ -527,42 +538,221 @@ func (publisher *directMessagePublisherImpl) sendTask(msg *message.OutboundMessa
 			// we will only continue on would_block + AwaitWritable
 			break
 		}
-		isFull := len(publisher.buffer) == cap(publisher.buffer)
-		// remove msg from buffer, should be guaranteed to be there, but we don't want to deadlock in case something went wonky.
-		// shutdown is contingent on all active tasks completing.
-		select {
-		case pub, ok := <-publisher.buffer:
-			if ok {
-				// Only if we were the ones to drain the message from the buffer should we call the publish failure listener
-				if errorInfo != nil && publisher.publishFailureListener != nil {
-					event := &failedPublishEvent{
-						message:   msg,
-						dest:      dest,
-						timestamp: time.Now(),
-						err:       core.ToNativeError(errorInfo, "encountered error while publishing message: "),
-					}
-					// if we call the publish failure listener, we should not dispose of the message
-					if !publisher.eventExecutor.Submit(func() { publisher.publishFailureListener(event) }) &&
-						publisher.logger.IsInfoEnabled() {
-						publisher.logger.Info(fmt.Sprintf("Failed to submit publish failure event %v. Is the publisher terminated?", event))
-					}
+		publisher.completeBufferedPublish(msg, dest, errorInfo)
+	}
+}
+
+// completeBufferedPublish removes a message from the buffer once its publish attempt completes, calling the
+// publish failure listener if the publish failed and notifying readiness if the buffer was full
+func (publisher *directMessagePublisherImpl) completeBufferedPublish(msg *message.OutboundMessageImpl, dest resource.Destination, errorInfo core.ErrorInfo) {
+	isFull := len(publisher.buffer) == cap(publisher.buffer)
+	// remove msg from buffer, should be guaranteed to be there, but we don't want to deadlock in case something went wonky.
+	// shutdown is contingent on all active tasks completing.
+	select {
+	case pub, ok := <-publisher.buffer:
+		if ok {
+			// Only if we were the ones to drain the message from the buffer should we call the publish failure listener
+			if errorInfo != nil && publisher.publishFailureListener != nil {
+				publisher.notifyPublishFailure(msg, dest, errorInfo)
+			} else {
+				// clean up the message, we are finished with it in the direct messaging case
+				// slightly more efficient to dispose of the message than let GC clean it up
+				pub.message.Dispose()
+			}
+			// check if we should signal that the buffer has space
+			// we only have to call the publisher notification of being ready when we
+			// have successfully popped a message off the buffer
+			if isFull && publisher.backpressureConfiguration == backpressureConfigurationReject {
+				publisher.notifyReady()
+			}
+		}
+		// We must have a closed buffer with no more messages. Since the buffer was closed, we can safely ignore the message.
+	default:
+		// should never happen as the message queue should always be drained after
+		publisher.logger.Error("published a message after publisher buffer was drained, this is unexpected")
+	}
+}
+
+// notifyPublishFailure submits a failed publish event for the given message to the publish failure listener
+func (publisher *directMessagePublisherImpl) notifyPublishFailure(msg *message.OutboundMessageImpl, dest resource.Destination, errorInfo core.ErrorInfo) {
+	listener := publisher.publishFailureListener
+	event := &failedPublishEvent{
+		message:   msg,
+		dest:      dest,
+		timestamp: time.Now(),
+		err:       core.ToNativeError(errorInfo, "encountered error while publishing message: "),
+	}
+	// if we call the publish failure listener, we should not dispose of the message
+	if !publisher.eventExecutor.Submit(func() { listener(event) }) &&
+		publisher.logger.IsInfoEnabled() {
+		publisher.logger.Info(fmt.Sprintf("Failed to submit publish failure event %v. Is the publisher terminated?", event))
+	}
+}
+
+// PublishBatch will publish the given messages of type OutboundMessage in order to either a single
+// destination or one destination per message. Messages that could not be published are reported
+// to the publish failure listener. Possible errors include:
+// - solace/solace.*IllegalArgumentError if the number of destinations does not match the messages.
+// - solace/solace.*PubSubPlusClientError if the messages could not be sent and all retry attempts failed.
+// - solace/solace.*PublisherOverflowError if publishing messages faster than publisher's I/O
+// capabilities allow. When publishing can be resumed, registered PublisherReadinessListeners
+// will be called.
+func (publisher *directMessagePublisherImpl) PublishBatch(msgs []apimessage.OutboundMessage, dests ...*resource.Topic) error {
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := checkBatchDestinations(len(msgs), len(dests)); err != nil {
+		return err
+	}
+	batch := make([]*publishable, 0, len(msgs))
+	disposeBatch := func() {
+		for _, pub := range batch {
+			pub.message.Dispose()
+		}
+	}
+	for i, msg := range msgs {
+		dest := dests[0]
+		if len(dests) > 1 {
+			dest = dests[i]
+		}
+		msgDup, err := duplicateMessageAndSetProperties(msg, nil)
+		if err != nil {
+			disposeBatch()
+			return err
+		}
+		if err := message.SetDestination(msgDup, dest.GetName()); err != nil {
+			msgDup.Dispose()
+			disposeBatch()
+			return err
+		}
+		batch = append(batch, &publishable{msgDup, dest})
+	}
+	return publisher.publishBatch(batch)
+}
+
+// publishBatch impl taking dup'd messages with their destination set, assuming state has been checked and we are running
+func (publisher *directMessagePublisherImpl) publishBatch(batch []*publishable) (ret error) {
+	// check the state once more before moving into the publish paths
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	if publisher.backpressureConfiguration == backpressureConfigurationDirect {
+		// publish directly with CCSMP
+		published, errorInfo := publisher.internalPublisher.PublishMultiple(publishablePointers(batch))
+		for _, pub := range batch[:published] {
+			publisher.recordPublish(pub.message, nil)
+			pub.message.Dispose()
+		}
+		if errorInfo == nil {
+			return nil
+		}
+		for _, pub := range batch[published:] {
+			publisher.recordPublish(pub.message, errorInfo)
+			if publisher.publishFailureListener != nil {
+				publisher.notifyPublishFailure(pub.message, pub.destination, errorInfo)
+			} else {
+				pub.message.Dispose()
+			}
+		}
+		if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
+			return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
+		}
+		return core.ToNativeError(errorInfo)
+	}
+	// buffered backpressure scenarios, see publish for the handling of a racing termination
+	channelWrite := false
+	defer func() {
+		if !channelWrite {
+			if r := recover(); r != nil {
+				if err, ok := r.(error); ok && err.Error() == "send on closed channel" {
+					publisher.logger.Debug("Caught a channel closed panic when trying to write to the message buffer, publisher must be terminated.")
+					ret = solace.NewError(&solace.IllegalStateError{}, constants.UnableToPublishAlreadyTerminated, nil)
 				} else {
-					// clean up the message, we are finished with it in the direct messaging case
-					// slightly more efficient to dispose of the message than let GC clean it up
-					pub.message.Dispose()
+					publisher.logger.Error(fmt.Sprintf("Experienced panic while attempting to publish a message batch: %s", err))
+					panic(r)
 				}
-				// check if we should signal that the buffer has space
-				// we only have to call the publisher notification of being ready when we
-				// have successfully popped a message off the buffer
-				if isFull && publisher.backpressureConfiguration == backpressureConfigurationReject {
-					publisher.notifyReady()
+			}
+		}
+	}()
+	publisher.bufferPublishLock.Lock()
+	defer publisher.bufferPublishLock.Unlock()
+	if publisher.backpressureConfiguration == backpressureConfigurationReject {
+		// the batch is rejected as a whole, the buffer cannot shrink while we hold the publish lock
+		if cap(publisher.buffer)-len(publisher.buffer) < len(batch) {
+			publisher.metrics.increment(metrics.PublisherBackpressureEvents, 1)
+			for _, pub := range batch {
+				pub.message.Dispose()
+			}
+			return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
+		}
+		for _, pub := range batch {
+			publisher.buffer <- pub
+		}
+		channelWrite = true
+		publisher.submitBatch(batch)
+		return nil
+	}
+	// wait for space in the buffer, submitting the batch in parts no larger than the buffer
+	for len(batch) > 0 {
+		size := cap(publisher.buffer)
+		if size > len(batch) {
+			size = len(batch)
+		}
+		for i, pub := range batch[:size] {
+			select {
+			case publisher.buffer <- pub:
+			case <-publisher.terminateWaitInterrupt:
+				// the messages already in the buffer are counted as undelivered on termination
+				publisher.submitBatch(batch[:i])
+				return solace.NewError(&solace.IllegalStateError{}, constants.UnableToPublishAlreadyTerminated, nil)
+			}
+		}
+		publisher.submitBatch(batch[:size])
+		batch = batch[size:]
+	}
+	channelWrite = true
+	return nil
+}
+
+// submitBatch submits the send task for a batch of messages that were written to the buffer
+func (publisher *directMessagePublisherImpl) submitBatch(batch []*publishable) {
+	if len(batch) == 0 {
+		return
+	}
+	if !publisher.taskBuffer.Submit(publisher.sendBatchTask(batch)) {
+		// the messages remain in the buffer and are counted as not delivered when terminate completes, see publish
+		publisher.logger.Debug("Attempted to submit the message batch for publishing, but the task buffer rejected the task! Has the service been terminated?")
+	}
+}
+
+// sendBatchTask represents the task publishing a batch of buffered messages, see sendTask
+func (publisher *directMessagePublisherImpl) sendBatchTask(batch []*publishable) buffer.PublisherTask {
+	return func(terminateChannel chan struct{}) {
+		pointers := publishablePointers(batch)
+		for len(batch) > 0 {
+			published, errorInfo := publisher.internalPublisher.PublishMultiple(pointers)
+			for _, pub := range batch[:published] {
+				publisher.recordPublish(pub.message, nil)
+				publisher.completeBufferedPublish(pub.message, pub.destination, nil)
+			}
+			batch, pointers = batch[published:], pointers[published:]
+			if errorInfo == nil {
+				continue
+			}
+			publisher.recordPublish(batch[0].message, errorInfo)
+			if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
+				// wait for ready and retry the remaining messages, the buffer is drained on termination
+				if err := publisher.internalPublisher.AwaitWritable(terminateChannel); err != nil {
+					return
 				}
+				continue
 			}
-			// We must have a closed buffer with no more messages. Since the buffer was closed, we can safely ignore the message.
-			// This is because the
-		default:
-			// should never happen as the message queue should always be drained after
-			publisher.logger.Error("published a message after publisher buffer was drained, this is unexpected")
+			// fail the message that could not be published and continue with the rest of the batch
+			publisher.completeBufferedPublish(batch[0].message, batch[0].destination, errorInfo)
+			batch, pointers = batch[1:], pointers[1:]
 		}
 	}
 }
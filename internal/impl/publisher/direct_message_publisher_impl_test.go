@@ -17,6 +17,7 @@
 package publisher
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -34,6 +35,8 @@ import (
 	"solace.dev/go/messaging/internal/impl/publisher/buffer"
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 	"solace.dev/go/messaging/pkg/solace/subcode"
 )
@@ -307,7 +310,7 @@ func TestDirectMessagePublisherImplLifecycle(t *testing.T) {
 			close(eventExecutorTerminated)
 		}
 		taskBufferTerminated := make(chan struct{})
-		taskBuffer.terminate = func(timer *time.Timer) bool {
+		taskBuffer.terminate = func(ctx context.Context) bool {
 			// this should be shutdown first
 			select {
 			case <-eventExecutorTerminated:
@@ -403,7 +406,7 @@ func TestDirectMessagePublisherImplLifecycleNoBuffer(t *testing.T) {
 		close(eventExecutorTerminated)
 	}
 	taskBufferTerminated := make(chan struct{})
-	taskBuffer.terminate = func(timer *time.Timer) bool {
+	taskBuffer.terminate = func(ctx context.Context) bool {
 		// this should be shutdown first
 		select {
 		case <-eventExecutorTerminated:
@@ -509,7 +512,7 @@ func TestDirectMessagePublisherLifecycleIdempotence(t *testing.T) {
 		eventExecutorTerminated <- nil
 	}
 	taskBufferTerminated := make(chan interface{}, 2)
-	taskBuffer.terminate = func(timer *time.Timer) bool {
+	taskBuffer.terminate = func(ctx context.Context) bool {
 		taskBufferTerminated <- nil
 		return true
 	}
@@ -589,6 +592,34 @@ func TestDirectMessagePublisherTerminateWithUnpublishedMessages(t *testing.T) {
 	}
 }
 
+func TestDirectMessagePublisherTerminationDiscardedMetric(t *testing.T) {
+	internalPublisher := &mockInternalPublisher{}
+	publisher := &directMessagePublisherImpl{}
+	publisher.construct(internalPublisher, backpressureConfigurationWait, 10)
+	publisher.eventExecutor = &mockEventExecutor{}
+	publisher.taskBuffer = &mockTaskBuffer{}
+
+	var sessionDiscarded uint64
+	internalPublisher.incrementMetric = func(metric core.NextGenMetric, amount uint64) {
+		if metric == core.MetricPublishMessagesTerminationDiscarded {
+			sessionDiscarded += amount
+		}
+	}
+
+	publisher.Start()
+	unpublishedCount := 3
+	for i := 0; i < unpublishedCount; i++ {
+		publisher.buffer <- nil
+	}
+	publisher.Terminate(10 * time.Second)
+	if sessionDiscarded != uint64(unpublishedCount) {
+		t.Errorf("expected session metric to be incremented by %d, got %d", unpublishedCount, sessionDiscarded)
+	}
+	if actual := publisher.Metrics().GetValue(metrics.PublisherMessagesTerminationDiscarded); actual != uint64(unpublishedCount) {
+		t.Errorf("expected publisher termination discarded metric to be %d, got %d", unpublishedCount, actual)
+	}
+}
+
 func TestDirectMessagePublisherUnsolicitedTerminationWithUnpublishedMessages(t *testing.T) {
 	internalPublisher := &mockInternalPublisher{}
 	publisher := &directMessagePublisherImpl{}
@@ -938,6 +969,22 @@ func TestDirectMessagePublisherPublishFunctionalityDirect(t *testing.T) {
 	} else {
 		t.Errorf("expected pubsubplus client error, got %s", err)
 	}
+
+	publisherMetrics := publisher.Metrics()
+	expectedMetrics := map[metrics.PublisherMetric]uint64{
+		metrics.PublisherMessagesSent:       1,
+		metrics.PublisherBackpressureEvents: 1,
+		metrics.PublisherMessagesFailed:     1,
+	}
+	for metric, expected := range expectedMetrics {
+		if actual := publisherMetrics.GetValue(metric); actual != expected {
+			t.Errorf("expected publisher metric %d to be %d, got %d", metric, expected, actual)
+		}
+	}
+	publisherMetrics.Reset()
+	if actual := publisherMetrics.GetValue(metrics.PublisherMessagesSent); actual != 0 {
+		t.Errorf("expected publisher metrics to be reset, got %d", actual)
+	}
 }
 
 func TestDirectMessagePublisherTask(t *testing.T) {
@@ -1201,7 +1248,7 @@ func TestDirectMessagePublisherReadinessListener(t *testing.T) {
 type mockTaskBuffer struct {
 	run          func()
 	submit       func(task buffer.PublisherTask) bool
-	terminate    func(timer *time.Timer) bool
+	terminate    func(ctx context.Context) bool
 	terminateNow func()
 }
 
@@ -1221,9 +1268,9 @@ func (buffer *mockTaskBuffer) Submit(task buffer.PublisherTask) bool {
 }
 
 // Call to terminate that will attempt to shutdown gracefully
-func (buffer *mockTaskBuffer) Terminate(timer *time.Timer) bool {
+func (buffer *mockTaskBuffer) Terminate(ctx context.Context) bool {
 	if buffer.terminate != nil {
-		return buffer.terminate(timer)
+		return buffer.terminate(ctx)
 	}
 	return true
 }
@@ -1292,3 +1339,161 @@ func (event mockEvent) GetCorrelationPointer() unsafe.Pointer {
 func (event mockEvent) GetUserPointer() unsafe.Pointer {
 	return nil
 }
+
+func TestDirectMessagePublisherPublishBatchDirect(t *testing.T) {
+	publisher := &directMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationDirect, 0)
+	eventExecutor := &mockEventExecutor{}
+	publisher.eventExecutor = eventExecutor
+	publisher.taskBuffer = &mockTaskBuffer{}
+
+	publisher.Start()
+
+	eventExecutor.submit = func(event executor.Task) bool {
+		event()
+		return true
+	}
+	internalPublisher.publishMultiple = func(messages []core.Publishable) (int, core.ErrorInfo) {
+		if len(messages) != 3 {
+			t.Errorf("expected 3 messages to be published in a single call, got %d", len(messages))
+		}
+		return 1, &ccsmp.SolClientErrorInfoWrapper{
+			ReturnCode: ccsmp.SolClientReturnCodeWouldBlock,
+		}
+	}
+	var failed []string
+	publisher.SetPublishFailureListener(func(event solace.FailedPublishEvent) {
+		if event.GetError() == nil {
+			t.Error("expected error to not be nil")
+		}
+		failed = append(failed, event.GetDestination().GetName())
+	})
+
+	testMessage, _ := message.NewOutboundMessage()
+	testTopics := []*resource.Topic{resource.TopicOf("a"), resource.TopicOf("b"), resource.TopicOf("c")}
+	err := publisher.PublishBatch([]apimessage.OutboundMessage{testMessage, testMessage, testMessage}, testTopics...)
+	if _, ok := err.(*solace.PublisherOverflowError); !ok {
+		t.Errorf("expected would block error, got %s", err)
+	}
+	if len(failed) != 2 || failed[0] != "b" || failed[1] != "c" {
+		t.Errorf("expected failure events for the unpublished messages, got %v", failed)
+	}
+	expectedMetrics := map[metrics.PublisherMetric]uint64{
+		metrics.PublisherMessagesSent:       1,
+		metrics.PublisherBackpressureEvents: 2,
+	}
+	for metric, expected := range expectedMetrics {
+		if actual := publisher.Metrics().GetValue(metric); actual != expected {
+			t.Errorf("expected publisher metric %d to be %d, got %d", metric, expected, actual)
+		}
+	}
+}
+
+func TestDirectMessagePublisherPublishBatchDestinationMismatch(t *testing.T) {
+	publisher := &directMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationDirect, 0)
+	publisher.eventExecutor = &mockEventExecutor{}
+	publisher.taskBuffer = &mockTaskBuffer{}
+
+	publisher.Start()
+
+	internalPublisher.publishMultiple = func(messages []core.Publishable) (int, core.ErrorInfo) {
+		t.Error("did not expect messages to be published")
+		return len(messages), nil
+	}
+	testMessage, _ := message.NewOutboundMessage()
+	err := publisher.PublishBatch([]apimessage.OutboundMessage{testMessage, testMessage, testMessage},
+		resource.TopicOf("a"), resource.TopicOf("b"))
+	if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected illegal argument error, got %s", err)
+	}
+}
+
+func TestDirectMessagePublisherPublishBatchBufferedReject(t *testing.T) {
+	publisher := &directMessagePublisherImpl{}
+	publisher.construct(&mockInternalPublisher{}, backpressureConfigurationReject, 2)
+	taskBuffer := &mockTaskBuffer{}
+	publisher.eventExecutor = &mockEventExecutor{}
+	publisher.taskBuffer = taskBuffer
+
+	publisher.Start()
+
+	submitted := 0
+	taskBuffer.submit = func(task buffer.PublisherTask) bool {
+		submitted++
+		return true
+	}
+
+	testMessage, _ := message.NewOutboundMessage()
+	testTopic := resource.TopicOf("hello/world")
+	err := publisher.PublishBatch([]apimessage.OutboundMessage{testMessage, testMessage, testMessage}, testTopic)
+	if _, ok := err.(*solace.PublisherOverflowError); !ok {
+		t.Errorf("expected would block error, got %s", err)
+	}
+	if len(publisher.buffer) != 0 {
+		t.Errorf("expected rejected batch to not be buffered, got %d buffered messages", len(publisher.buffer))
+	}
+	err = publisher.PublishBatch([]apimessage.OutboundMessage{testMessage, testMessage}, testTopic)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(publisher.buffer) != 2 {
+		t.Errorf("expected batch to be buffered, got %d buffered messages", len(publisher.buffer))
+	}
+	if submitted != 1 {
+		t.Errorf("expected a single task to be submitted for the batch, got %d", submitted)
+	}
+}
+
+func TestDirectMessagePublisherPublishBatchTaskWithWouldBlock(t *testing.T) {
+	publisher := &directMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationWait, 2)
+	taskBuffer := &mockTaskBuffer{}
+	publisher.eventExecutor = &mockEventExecutor{}
+	publisher.taskBuffer = taskBuffer
+
+	publisher.Start()
+
+	var published []int
+	internalPublisher.publishMultiple = func(messages []core.Publishable) (int, core.ErrorInfo) {
+		published = append(published, len(messages))
+		if len(published) == 1 {
+			return 1, &ccsmp.SolClientErrorInfoWrapper{
+				ReturnCode: ccsmp.SolClientReturnCodeWouldBlock,
+			}
+		}
+		return len(messages), nil
+	}
+	awaitWritableCalled := false
+	internalPublisher.awaitWritable = func(terminateSignal chan struct{}) error {
+		awaitWritableCalled = true
+		return nil
+	}
+	taskBuffer.submit = func(task buffer.PublisherTask) bool {
+		task(make(chan struct{}))
+		return true
+	}
+
+	testMessage, _ := message.NewOutboundMessage()
+	testTopic := resource.TopicOf("hello/world")
+	err := publisher.PublishBatch([]apimessage.OutboundMessage{testMessage, testMessage, testMessage}, testTopic)
+	if err != nil {
+		t.Error(err)
+	}
+	if !awaitWritableCalled {
+		t.Error("await writable not called despite being passed would block")
+	}
+	// the batch is split by the buffer capacity, and the remainder of the first part is retried
+	if len(published) != 3 || published[0] != 2 || published[1] != 1 || published[2] != 1 {
+		t.Errorf("unexpected publish calls %v", published)
+	}
+	if len(publisher.buffer) != 0 {
+		t.Errorf("expected buffer to be empty, got %d buffered messages", len(publisher.buffer))
+	}
+	if actual := publisher.Metrics().GetValue(metrics.PublisherMessagesSent); actual != 3 {
+		t.Errorf("expected 3 messages to be sent, got %d", actual)
+	}
+}
@@ -17,6 +17,7 @@
 package publisher
 
 import (
+	"context"
 	"fmt"
 	"sync/atomic"
 	"time"
@@ -77,6 +78,8 @@ type basicMessagePublisher struct {
 
 	messageBuilder solace.OutboundMessageBuilder
 	eventExecutor  executor.Executor
+
+	metrics publisherMetricsImpl
 }
 
 func (publisher *basicMessagePublisher) construct(internalPublisher core.Publisher) {
@@ -229,6 +232,32 @@ func (event *publisherTerminationEvent) GetCause() error {
 	return event.cause
 }
 
+// gracePeriodContext returns a context that is done once the given grace period has elapsed.
+// The context of a negative grace period is only done once cancelled.
+func gracePeriodContext(gracePeriod time.Duration) (context.Context, context.CancelFunc) {
+	if gracePeriod < 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeout(context.Background(), gracePeriod)
+}
+
+// checkBatchDestinations validates that a batch is published to a single destination or to one destination per message
+func checkBatchDestinations(messageCount, destinationCount int) error {
+	if destinationCount != 1 && destinationCount != messageCount {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PublishBatchDestinationMismatch, destinationCount, messageCount), nil)
+	}
+	return nil
+}
+
+// publishablePointers returns the pointers to publish for the messages of the given batch
+func publishablePointers(batch []*publishable) []core.Publishable {
+	pointers := make([]core.Publishable, len(batch))
+	for i, pub := range batch {
+		pointers[i] = message.GetOutboundMessagePointer(pub.message)
+	}
+	return pointers
+}
+
 // Common functionality to validate backpressure config, can be used by direct and persistent publishers
 func validateBackpressureConfig(properties config.PublisherPropertyMap) (backpressureConfig backpressureConfiguration, publisherBackpressureBufferSize int, err error) {
 	var publisherBackpressureStrategy string
@@ -22,6 +22,8 @@ import (
 	"time"
 
 	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 )
 
 func TestMessagePublisherStartStateChecks(t *testing.T) {
@@ -297,12 +299,14 @@ type result struct {
 
 type mockInternalPublisher struct {
 	publish                      func(message core.Publishable) core.ErrorInfo
+	publishMultiple              func(messages []core.Publishable) (int, core.ErrorInfo)
 	events                       func() core.Events
 	requestor                    func() core.Requestor
 	awaitWritable                func(terminateSignal chan struct{}) error
 	taskQueue                    func() chan core.SendTask
 	isRunning                    func() bool
 	incrementMetric              func(metric core.NextGenMetric, amount uint64)
+	recordLatency                func(metric metrics.LatencyMetric, latency time.Duration)
 	addAcknowledgementHandler    func(core.AcknowledgementHandler) (uint64, func() (messageId uint64, correlationTag []byte))
 	removeAcknowledgementHandler func(uint64)
 }
@@ -314,6 +318,22 @@ func (mock *mockInternalPublisher) Publish(message core.Publishable) core.ErrorI
 	return nil
 }
 
+func (mock *mockInternalPublisher) PublishMultiple(messages []core.Publishable) (int, core.ErrorInfo) {
+	if mock.publishMultiple != nil {
+		return mock.publishMultiple(messages)
+	}
+	for i, message := range messages {
+		if err := mock.Publish(message); err != nil {
+			return i, err
+		}
+	}
+	return len(messages), nil
+}
+
+func (mock *mockInternalPublisher) Logger() logging.LogLevelLogger {
+	return logging.Default
+}
+
 func (mock *mockInternalPublisher) Events() core.Events {
 	if mock.events != nil {
 		return mock.events()
@@ -355,6 +375,12 @@ func (mock *mockInternalPublisher) IncrementMetric(metric core.NextGenMetric, am
 	}
 }
 
+func (mock *mockInternalPublisher) RecordLatency(metric metrics.LatencyMetric, latency time.Duration) {
+	if mock.recordLatency != nil {
+		mock.recordLatency(metric, latency)
+	}
+}
+
 func (mock *mockInternalPublisher) Acknowledgements() core.Acknowledgements {
 	return mock
 }
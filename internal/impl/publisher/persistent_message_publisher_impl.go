@@ -17,6 +17,7 @@
 package publisher
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -38,6 +39,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
@@ -45,6 +47,7 @@ type persistentPublishable struct {
 	message     *message.OutboundMessageImpl
 	destination resource.Destination
 	corr        correlationContext
+	messageID   uint64
 }
 
 type persistentMessagePublisherImpl struct {
@@ -66,7 +69,7 @@ type persistentMessagePublisherImpl struct {
 	acknowledgementHandlerID uint64
 	generateCorrelationTag   func() (uint64, []byte)
 
-	correlationMap           map[uint64](correlationContext)
+	correlationMap           map[uint64](correlationEntry)
 	correlationLock          *sync.Mutex
 	correlationComplete      chan struct{}
 	requestCorrelateComplete chan struct{}
@@ -81,9 +84,9 @@ func (publisher *persistentMessagePublisherImpl) construct(internalPublisher cor
 		publisher.taskBuffer = buffer.NewChannelBasedPublisherTaskBuffer(bufferSize, publisher.internalPublisher.TaskQueue)
 	}
 	publisher.terminateWaitInterrupt = make(chan struct{})
-	publisher.logger = logging.For(publisher)
+	publisher.logger = publisher.internalPublisher.Logger().For(publisher)
 
-	publisher.correlationMap = make(map[uint64]correlationContext)
+	publisher.correlationMap = make(map[uint64]correlationEntry)
 	publisher.correlationLock = &sync.Mutex{}
 	publisher.correlationComplete = make(chan struct{})
 	publisher.requestCorrelateComplete = make(chan struct{})
@@ -101,12 +104,20 @@ func (publisher *persistentMessagePublisherImpl) onCanSend(eventInfo core.Sessio
 }
 
 func (publisher *persistentMessagePublisherImpl) ackHandler(messageID uint64, persisted bool, err error) {
+	if err == nil {
+		publisher.metrics.increment(metrics.PublisherMessagesAcknowledged, 1)
+	} else {
+		publisher.metrics.increment(metrics.PublisherMessagesFailed, 1)
+	}
 	publisher.correlationLock.Lock()
-	context, ok := publisher.correlationMap[messageID]
+	entry, ok := publisher.correlationMap[messageID]
 	publisher.correlationLock.Unlock()
 	if ok {
+		if err == nil {
+			publisher.internalPublisher.RecordLatency(metrics.PublishAcknowledgementLatency, time.Since(entry.published))
+		}
 		// resolve the context OUTSIDE of the correlation table lock as we do not want to block registration of new contexts
-		context.resolve(persisted, err)
+		entry.ctx.resolve(persisted, err)
 		// reacquire the lock
 		publisher.correlationLock.Lock()
 		delete(publisher.correlationMap, messageID)
@@ -198,7 +209,17 @@ func (publisher *persistentMessagePublisherImpl) StartAsyncCallback(callback fun
 // unfinished tasks or in-flight messages.
 // This function blocks until the service is terminated.
 // If gracePeriod is less than 0, the function will wait indefinitely.
-func (publisher *persistentMessagePublisherImpl) Terminate(gracePeriod time.Duration) (err error) {
+func (publisher *persistentMessagePublisherImpl) Terminate(gracePeriod time.Duration) error {
+	ctx, cancel := gracePeriodContext(gracePeriod)
+	defer cancel()
+	return publisher.TerminateWithContext(ctx)
+}
+
+// TerminateWithContext will terminate the service gracefully and synchronously.
+// A graceful shutdown will be attempted until the given context is done, at which point
+// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+// This function blocks until the service is terminated.
+func (publisher *persistentMessagePublisherImpl) TerminateWithContext(ctx context.Context) (err error) {
 	if proceed, err := publisher.terminate(); !proceed {
 		return err
 	}
@@ -222,12 +243,7 @@ func (publisher *persistentMessagePublisherImpl) Terminate(gracePeriod time.Dura
 		publisher.eventExecutor.AwaitTermination()
 	}()
 
-	// We first want to gracefully shut down the task buffer, and keep track of how long that takes
-	// signal that we should try and terminate gracefully
-	var timer *time.Timer
-	if gracePeriod >= 0 {
-		timer = time.NewTimer(gracePeriod)
-	}
+	// We first want to gracefully shut down the task buffer until the context is done
 	// start by shutting down the task buffer
 	var graceful = true
 
@@ -235,7 +251,7 @@ func (publisher *persistentMessagePublisherImpl) Terminate(gracePeriod time.Dura
 	if publisher.backpressureConfiguration != backpressureConfigurationDirect {
 		// First we interrupt all backpressure wait functions
 		close(publisher.terminateWaitInterrupt)
-		graceful = publisher.taskBuffer.Terminate(timer)
+		graceful = publisher.taskBuffer.Terminate(ctx)
 	} else {
 		publisher.internalPublisher.Events().RemoveEventHandler(publisher.canSendEventHandlerID)
 	}
@@ -251,16 +267,11 @@ func (publisher *persistentMessagePublisherImpl) Terminate(gracePeriod time.Dura
 		close(publisher.requestCorrelateComplete)
 		publisher.correlationLock.Unlock()
 		if remainingAcks > 0 {
-			if timer != nil {
-				select {
-				case <-publisher.correlationComplete:
-					// success
-				case <-timer.C:
-					graceful = false
-				}
-			} else {
-				// Block forever as our grace period is negative
-				<-publisher.correlationComplete
+			select {
+			case <-publisher.correlationComplete:
+				// success
+			case <-ctx.Done():
+				graceful = false
 			}
 		}
 	}
@@ -290,7 +301,7 @@ func (publisher *persistentMessagePublisherImpl) Terminate(gracePeriod time.Dura
 			}
 		}
 		// return an error if we have one
-		publisher.internalPublisher.IncrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
+		publisher.incrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
 		err := solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageDeliveryMessageWithUnacked, undeliveredCount, unackedCount), nil)
 		return err
 	}
@@ -333,7 +344,7 @@ func (publisher *persistentMessagePublisherImpl) unsolicitedTermination(errorInf
 		}
 		// return an error if we have one
 		err = solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageDeliveryMessageWithUnacked, undeliveredCount, unackedCount), nil)
-		publisher.internalPublisher.IncrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
+		publisher.incrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
 	}
 	// Stop processing events
 	publisher.eventExecutor.Terminate()
@@ -389,6 +400,16 @@ func (publisher *persistentMessagePublisherImpl) SetMessagePublishReceiptListene
 	}
 }
 
+// GetMessagePublishReceiptListener returns the listener set with SetMessagePublishReceiptListener,
+// or nil if no listener is set.
+func (publisher *persistentMessagePublisherImpl) GetMessagePublishReceiptListener() solace.MessagePublishReceiptListener {
+	listener := (*solace.MessagePublishReceiptListener)(atomic.LoadPointer(&publisher.publishReceiptListener))
+	if listener == nil {
+		return nil
+	}
+	return *listener
+}
+
 // IsReady checks if the publisher can publish messages. Returns true if the
 // publisher can publish messages, false if the publisher is presvented from
 // sending messages (e.g., full buffer or I/O problems)
@@ -433,11 +454,11 @@ func (publisher *persistentMessagePublisherImpl) drainAckTable(err error) uint64
 	publisher.correlationLock.Lock()
 	defer publisher.correlationLock.Unlock()
 	unackedCount := uint64(0)
-	for key, value := range publisher.correlationMap {
+	for key, entry := range publisher.correlationMap {
 		unackedCount++
-		if value != nil {
+		if entry.ctx != nil {
 			// the resolve function should dispose of the message OR pass it back to the application
-			value.resolve(false, err)
+			entry.ctx.resolve(false, err)
 		}
 		delete(publisher.correlationMap, key)
 	}
@@ -451,7 +472,7 @@ func (publisher *persistentMessagePublisherImpl) drainAckTable(err error) uint64
 // - solace/solace.*PublisherOverflowError if publishing messages faster than publisher's I/O
 // capabilities allow. When publishing can be resumed, registered PublisherReadinessListeners
 // will be called.
-func (publisher *persistentMessagePublisherImpl) PublishBytes(bytes []byte, dest *resource.Topic) error {
+func (publisher *persistentMessagePublisherImpl) PublishBytes(bytes []byte, dest resource.Destination) error {
 	if err := publisher.checkStartedStateForPublish(); err != nil {
 		return err
 	}
@@ -469,7 +490,7 @@ func (publisher *persistentMessagePublisherImpl) PublishBytes(bytes []byte, dest
 // - solace/solace.*PublisherOverflowError if publishing messages faster than publisher's I/O
 // capabilities allow. When publishing can be resumed, registered PublisherReadinessListeners
 // will be called.
-func (publisher *persistentMessagePublisherImpl) PublishString(str string, dest *resource.Topic) error {
+func (publisher *persistentMessagePublisherImpl) PublishString(str string, dest resource.Destination) error {
 	if err := publisher.checkStartedStateForPublish(); err != nil {
 		return err
 	}
@@ -488,7 +509,7 @@ func (publisher *persistentMessagePublisherImpl) PublishString(str string, dest
 // - solace/solace.*PublisherOverflowError if publishing messages faster than publisher's I/O
 // capabilities allow. When publishing can be resumed, registered PublisherReadinessListeners
 // will be called.
-func (publisher *persistentMessagePublisherImpl) Publish(msg apimessage.OutboundMessage, dest *resource.Topic, properties config.MessagePropertiesConfigurationProvider, userContext interface{}) error {
+func (publisher *persistentMessagePublisherImpl) Publish(msg apimessage.OutboundMessage, dest resource.Destination, properties config.MessagePropertiesConfigurationProvider, userContext interface{}) error {
 	if err := publisher.checkStartedStateForPublish(); err != nil {
 		return err
 	}
@@ -510,7 +531,17 @@ func (publisher *persistentMessagePublisherImpl) Publish(msg apimessage.Outbound
 // - solace/errors.*PublisherOverflowError if publishing messages faster than publisher's I/O
 // capabilities allow. When publishing can be resumed, registered PublisherReadinessListeners
 // will be called.
-func (publisher *persistentMessagePublisherImpl) PublishAwaitAcknowledgement(msg apimessage.OutboundMessage, dest *resource.Topic, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error {
+func (publisher *persistentMessagePublisherImpl) PublishAwaitAcknowledgement(msg apimessage.OutboundMessage, dest resource.Destination, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error {
+	return publisher.publishAwaitAcknowledgement(context.Background(), msg, dest, timeout, properties)
+}
+
+// PublishAwaitAcknowledgementWithContext will publish the given message of type OutboundMessage
+// and await a publish acknowledgement until the given context is done.
+func (publisher *persistentMessagePublisherImpl) PublishAwaitAcknowledgementWithContext(ctx context.Context, msg apimessage.OutboundMessage, dest resource.Destination, properties config.MessagePropertiesConfigurationProvider) error {
+	return publisher.publishAwaitAcknowledgement(ctx, msg, dest, -1, properties)
+}
+
+func (publisher *persistentMessagePublisherImpl) publishAwaitAcknowledgement(ctx context.Context, msg apimessage.OutboundMessage, dest resource.Destination, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error {
 	if err := publisher.checkStartedStateForPublish(); err != nil {
 		return err
 	}
@@ -522,22 +553,25 @@ func (publisher *persistentMessagePublisherImpl) PublishAwaitAcknowledgement(msg
 		return err
 	}
 	acknowledgementChannel := make(chan error, 1)
-	err = publisher.publishWithBlockingContext(msgDup, dest, acknowledgementChannel)
+	correlation, err := publisher.publishWithBlockingContext(msgDup, dest, acknowledgementChannel)
 	if err != nil {
 		return err
 	}
+	var timeoutChan <-chan time.Time
 	if timeout >= 0 {
 		timer := time.NewTimer(timeout)
-		select {
-		case err := <-acknowledgementChannel:
-			timer.Stop()
-			return err
-		case <-timer.C:
-			return solace.NewError(&solace.TimeoutError{}, "timed out waiting for message to be acknowledged", nil)
-		}
-	} else {
-		// block forever
-		return <-acknowledgementChannel
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+	select {
+	case err := <-acknowledgementChannel:
+		return err
+	case <-timeoutChan:
+		publisher.abandonBlockingCorrelationContext(correlation)
+		return solace.NewError(&solace.TimeoutError{}, "timed out waiting for message to be acknowledged", nil)
+	case <-ctx.Done():
+		publisher.abandonBlockingCorrelationContext(correlation)
+		return ctx.Err()
 	}
 }
 
@@ -560,7 +594,7 @@ func duplicateMessageAndSetProperties(msg apimessage.OutboundMessage, properties
 	return msgDup, nil
 }
 
-func (publisher *persistentMessagePublisherImpl) publishWithCallbackContext(msg *message.OutboundMessageImpl, dest *resource.Topic, userContext interface{}) (ret error) {
+func (publisher *persistentMessagePublisherImpl) publishWithCallbackContext(msg *message.OutboundMessageImpl, dest resource.Destination, userContext interface{}) (ret error) {
 	ctx := &callbackCorrelationContext{
 		callbackPtr:   &publisher.publishReceiptListener,
 		eventExecutor: publisher.eventExecutor,
@@ -571,41 +605,42 @@ func (publisher *persistentMessagePublisherImpl) publishWithCallbackContext(msg
 	return publisher.publish(msg, dest, ctx, userContext)
 }
 
-func (publisher *persistentMessagePublisherImpl) publishWithBlockingContext(msg *message.OutboundMessageImpl, dest *resource.Topic, blocker chan error) (ret error) {
+func (publisher *persistentMessagePublisherImpl) publishWithBlockingContext(msg *message.OutboundMessageImpl, dest resource.Destination, blocker chan error) (*blockingCorrelationContext, error) {
 	ctx := &blockingCorrelationContext{
 		message: msg,
 		blocker: blocker,
 	}
-	return publisher.publish(msg, dest, ctx, nil)
+	return ctx, publisher.publish(msg, dest, ctx, nil)
 }
 
-// publish impl taking a dup'd message, assuming state has been checked and we are running
-func (publisher *persistentMessagePublisherImpl) publish(msg *message.OutboundMessageImpl, dest *resource.Topic, ctx correlationContext, userContext interface{}) (ret error) {
+// abandonBlockingCorrelationContext marks a blocking correlation context as no longer awaited. The message is
+// left to whoever owns it last: a buffered message that has not been sent yet is dropped by its send task, and
+// otherwise the context is resolved, disposing of the message, when the acknowledgement is received or the
+// publisher terminates.
+func (publisher *persistentMessagePublisherImpl) abandonBlockingCorrelationContext(ctx *blockingCorrelationContext) {
+	publisher.correlationLock.Lock()
+	ctx.abandoned = true
+	publisher.correlationLock.Unlock()
+}
 
-	// Set the destination for the message which is assumed to be a dup'd message.
-	err := message.SetDestination(msg, dest.GetName())
-	if err != nil {
-		msg.Dispose()
-		return err
+// isAbandoned returns true if the given correlation context is a blocking context that is no longer awaited
+func (publisher *persistentMessagePublisherImpl) isAbandoned(ctx correlationContext) bool {
+	blocking, ok := ctx.(*blockingCorrelationContext)
+	if !ok {
+		return false
 	}
-	err = message.SetDeliveryMode(msg, message.DeliveryModePersistent)
+	publisher.correlationLock.Lock()
+	defer publisher.correlationLock.Unlock()
+	return blocking.abandoned
+}
+
+// publish impl taking a dup'd message, assuming state has been checked and we are running
+func (publisher *persistentMessagePublisherImpl) publish(msg *message.OutboundMessageImpl, dest resource.Destination, ctx correlationContext, userContext interface{}) (ret error) {
+	messageID, err := publisher.prepare(msg, dest, ctx)
 	if err != nil {
-		msg.Dispose()
 		return err
 	}
 
-	var messageID uint64
-	if ctx != nil {
-		var correlationTag []byte
-		messageID, correlationTag = publisher.generateCorrelationTag()
-		err = message.AttachCorrelationTag(msg, correlationTag)
-		if err != nil {
-			// message will always be duplicated, we should free it before returning the error
-			msg.Dispose()
-			return err
-		}
-	}
-
 	// check the state once more before moving into the publish paths, this closes the race condition windows a little more
 	if err = publisher.checkStartedStateForPublish(); err != nil {
 		return err
@@ -617,6 +652,7 @@ func (publisher *persistentMessagePublisherImpl) publish(msg *message.OutboundMe
 			defer msg.Dispose()
 		}
 		errorInfo := publisher.internalPublisher.Publish(message.GetOutboundMessagePointer(msg))
+		publisher.recordPublish(msg, errorInfo)
 		if errorInfo != nil {
 			// if we encountered an error publishing, we should not attach the correlation
 			publisher.removeCorrelationContext(messageID)
@@ -650,12 +686,13 @@ func (publisher *persistentMessagePublisherImpl) publish(msg *message.OutboundMe
 		}()
 		publisher.bufferPublishLock.Lock()
 		defer publisher.bufferPublishLock.Unlock()
-		pub := &persistentPublishable{msg, dest, ctx}
+		pub := &persistentPublishable{msg, dest, ctx, messageID}
 		if publisher.backpressureConfiguration == backpressureConfigurationReject {
 			select {
 			case publisher.buffer <- pub:
 				channelWrite = true // we successfully wrote the message to the channel
 			default:
+				publisher.metrics.increment(metrics.PublisherBackpressureEvents, 1)
 				return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
 			}
 		} else {
@@ -681,11 +718,65 @@ func (publisher *persistentMessagePublisherImpl) publish(msg *message.OutboundMe
 	return nil
 }
 
+// prepare sets the destination, the delivery mode and, if given a correlation context, the correlation tag on
+// the given dup'd message, returning the message id of the correlation tag. The message is disposed on error.
+func (publisher *persistentMessagePublisherImpl) prepare(msg *message.OutboundMessageImpl, dest resource.Destination, ctx correlationContext) (uint64, error) {
+	// Set the destination for the message which is assumed to be a dup'd message.
+	err := setPersistentDestination(msg, dest)
+	if err != nil {
+		msg.Dispose()
+		return 0, err
+	}
+	err = message.SetDeliveryMode(msg, message.DeliveryModePersistent)
+	if err != nil {
+		msg.Dispose()
+		return 0, err
+	}
+
+	var messageID uint64
+	if ctx != nil {
+		var correlationTag []byte
+		messageID, correlationTag = publisher.generateCorrelationTag()
+		if blocking, ok := ctx.(*blockingCorrelationContext); ok {
+			blocking.messageID = messageID
+		}
+		err = message.AttachCorrelationTag(msg, correlationTag)
+		if err != nil {
+			// message will always be duplicated, we should free it before returning the error
+			msg.Dispose()
+			return 0, err
+		}
+	}
+	return messageID, nil
+}
+
+// setPersistentDestination sets the given topic or queue as the destination of the given message
+func setPersistentDestination(msg *message.OutboundMessageImpl, dest resource.Destination) error {
+	switch destination := dest.(type) {
+	case *resource.Topic:
+		if destination != nil {
+			return message.SetDestination(msg, destination.GetName())
+		}
+	case *resource.Queue:
+		if destination != nil {
+			if destination.GetName() == "" {
+				return solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentPublisherAnonymousQueueDestination, nil)
+			}
+			return message.SetQueueDestination(msg, destination.GetName(), destination.IsDurable())
+		}
+	case nil:
+		// fall through to the missing destination error below
+	default:
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PersistentPublisherUnsupportedDestinationType, dest), nil)
+	}
+	return solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentPublisherMissingDestination, nil)
+}
+
 // if given a correlation context, acquire the correlation lock and add a new entry to the map returning true if the context was successfully added
 func (publisher *persistentMessagePublisherImpl) addCorrelationContext(messageID uint64, ctx correlationContext) bool {
 	if ctx != nil {
 		publisher.correlationLock.Lock()
-		publisher.correlationMap[messageID] = ctx
+		publisher.correlationMap[messageID] = correlationEntry{ctx: ctx, published: time.Now()}
 		publisher.correlationLock.Unlock()
 		return true
 	}
@@ -710,6 +801,7 @@ func (publisher *persistentMessagePublisherImpl) sendTask(msg *message.OutboundM
 		for {
 			// attempt a publish
 			errorInfo = publisher.internalPublisher.Publish(message.GetOutboundMessagePointer(msg))
+			publisher.recordPublish(msg, errorInfo)
 			if errorInfo != nil {
 				// if we got a would block, wait for ready and retry
 				if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
@@ -732,40 +824,242 @@ func (publisher *persistentMessagePublisherImpl) sendTask(msg *message.OutboundM
 			// we will only continue on would_block + AwaitWritable
 			break
 		}
-		isFull := len(publisher.buffer) == cap(publisher.buffer)
-		// remove msg from buffer, should be guaranteed to be there, but we don't want to deadlock in case something went wonky.
-		// shutdown is contingent on all active tasks completing.
-		select {
-		case pub, ok := <-publisher.buffer:
-			if ok {
-				// if we got an error, first we must remove the context correlation if present
-				if errorInfo != nil && ctx != nil {
-					// make sure we clean up the correlation entry since it will never be dealt with
-					publisher.correlationLock.Lock()
-					delete(publisher.correlationMap, messageID)
-					publisher.correlationLock.Unlock()
-					// then we must resolve the context
-					ctx.resolve(false, core.ToNativeError(errorInfo, "encountered error while publishing message: "))
-				} else if ctx == nil {
-					// if we got no error and there is no context associated with this message, dispose of the message
-					pub.message.Dispose()
+		publisher.completeBufferedPublish(messageID, ctx, toPublishError(errorInfo))
+	}
+}
+
+// toPublishError converts the error info of a failed publish to an error, returning nil if the publish succeeded
+func toPublishError(errorInfo core.ErrorInfo) error {
+	if errorInfo == nil {
+		return nil
+	}
+	return core.ToNativeError(errorInfo, "encountered error while publishing message: ")
+}
+
+// completeBufferedPublish removes a message from the buffer once its publish attempt completes, resolving
+// the correlation context if the publish failed and notifying readiness if the buffer was full
+func (publisher *persistentMessagePublisherImpl) completeBufferedPublish(messageID uint64, ctx correlationContext, err error) {
+	isFull := len(publisher.buffer) == cap(publisher.buffer)
+	// remove msg from buffer, should be guaranteed to be there, but we don't want to deadlock in case something went wonky.
+	// shutdown is contingent on all active tasks completing.
+	select {
+	case pub, ok := <-publisher.buffer:
+		if ok {
+			// if we got an error, first we must remove the context correlation if present
+			if err != nil && ctx != nil {
+				// make sure we clean up the correlation entry since it will never be dealt with
+				publisher.removeCorrelationContext(messageID)
+				// then we must resolve the context
+				ctx.resolve(false, err)
+			} else if ctx == nil {
+				// if we got no error and there is no context associated with this message, dispose of the message
+				pub.message.Dispose()
+			}
+			// check if we should signal that the buffer has space
+			// we only have to call the publisher notification of being ready when we
+			// have successfully popped a message off the buffer
+			if isFull && publisher.backpressureConfiguration == backpressureConfigurationReject {
+				publisher.notifyReady()
+			}
+		}
+		// We must have a closed buffer with no more messages. Since the buffer was closed, we can safely ignore the message.
+	default:
+		// should never happen as the message queue should always be drained after
+		publisher.logger.Error("published a message after publisher buffer was drained, this is unexpected")
+	}
+}
+
+// PublishBatch will publish the given messages of type OutboundMessage in order to either a single
+// destination or one destination per message. A publish receipt is raised for every message in the
+// batch, carrying the error for the messages that could not be published. Possible errors include:
+// - solace/solace.*IllegalArgumentError if the number of destinations does not match the messages.
+// - solace/solace.*PubSubPlusClientError if the messages could not be sent and all retry attempts failed.
+// - solace/solace.*PublisherOverflowError if publishing messages faster than publisher's I/O
+// capabilities allow. When publishing can be resumed, registered PublisherReadinessListeners
+// will be called.
+func (publisher *persistentMessagePublisherImpl) PublishBatch(msgs []apimessage.OutboundMessage, dests ...resource.Destination) error {
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	if err := checkBatchDestinations(len(msgs), len(dests)); err != nil {
+		return err
+	}
+	batch := make([]*persistentPublishable, 0, len(msgs))
+	disposeBatch := func() {
+		for _, pub := range batch {
+			pub.message.Dispose()
+		}
+	}
+	for i, msg := range msgs {
+		dest := dests[0]
+		if len(dests) > 1 {
+			dest = dests[i]
+		}
+		msgDup, err := duplicateMessageAndSetProperties(msg, nil)
+		if err != nil {
+			disposeBatch()
+			return err
+		}
+		ctx := &callbackCorrelationContext{
+			callbackPtr:   &publisher.publishReceiptListener,
+			eventExecutor: publisher.eventExecutor,
+			message:       msgDup,
+			logger:        publisher.logger,
+		}
+		messageID, err := publisher.prepare(msgDup, dest, ctx)
+		if err != nil {
+			disposeBatch()
+			return err
+		}
+		batch = append(batch, &persistentPublishable{msgDup, dest, ctx, messageID})
+	}
+	return publisher.publishBatch(batch)
+}
+
+// publishBatch impl taking prepared dup'd messages, assuming state has been checked and we are running
+func (publisher *persistentMessagePublisherImpl) publishBatch(batch []*persistentPublishable) (ret error) {
+	// check the state once more before moving into the publish paths
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	if publisher.backpressureConfiguration == backpressureConfigurationDirect {
+		for _, pub := range batch {
+			publisher.addCorrelationContext(pub.messageID, pub.corr)
+		}
+		// publish persistently with CCSMP
+		published, errorInfo := publisher.internalPublisher.PublishMultiple(persistentMessagePointers(batch))
+		for _, pub := range batch[:published] {
+			publisher.recordPublish(pub.message, nil)
+		}
+		if errorInfo == nil {
+			return nil
+		}
+		for _, pub := range batch[published:] {
+			publisher.recordPublish(pub.message, errorInfo)
+			// the message was not published, raise its receipt with the error
+			publisher.removeCorrelationContext(pub.messageID)
+			pub.corr.resolve(false, core.ToNativeError(errorInfo, "encountered error while publishing message: "))
+		}
+		if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
+			return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
+		}
+		return core.ToNativeError(errorInfo)
+	}
+	// buffered backpressure scenarios, see publish for the handling of a racing termination
+	channelWrite := false
+	defer func() {
+		if !channelWrite {
+			if r := recover(); r != nil {
+				if err, ok := r.(error); ok && err.Error() == "send on closed channel" {
+					publisher.logger.Debug("Caught a channel closed panic when trying to write to the message buffer, publisher must be terminated.")
+					ret = solace.NewError(&solace.IllegalStateError{}, constants.UnableToPublishAlreadyTerminated, nil)
+				} else {
+					publisher.logger.Error(fmt.Sprintf("Experienced panic while attempting to publish a message batch: %s", err))
+					panic(r)
 				}
-				// check if we should signal that the buffer has space
-				// we only have to call the publisher notification of being ready when we
-				// have successfully popped a message off the buffer
-				if isFull && publisher.backpressureConfiguration == backpressureConfigurationReject {
-					publisher.notifyReady()
+			}
+		}
+	}()
+	publisher.bufferPublishLock.Lock()
+	defer publisher.bufferPublishLock.Unlock()
+	if publisher.backpressureConfiguration == backpressureConfigurationReject {
+		// the batch is rejected as a whole, the buffer cannot shrink while we hold the publish lock
+		if cap(publisher.buffer)-len(publisher.buffer) < len(batch) {
+			publisher.metrics.increment(metrics.PublisherBackpressureEvents, 1)
+			for _, pub := range batch {
+				pub.message.Dispose()
+			}
+			return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
+		}
+		for _, pub := range batch {
+			publisher.buffer <- pub
+		}
+		channelWrite = true
+		publisher.submitBatch(batch)
+		return nil
+	}
+	// wait for space in the buffer, submitting the batch in parts no larger than the buffer
+	for len(batch) > 0 {
+		size := cap(publisher.buffer)
+		if size > len(batch) {
+			size = len(batch)
+		}
+		for i, pub := range batch[:size] {
+			select {
+			case publisher.buffer <- pub:
+			case <-publisher.terminateWaitInterrupt:
+				// the messages already in the buffer are resolved when the buffer is drained on termination
+				publisher.submitBatch(batch[:i])
+				return solace.NewError(&solace.IllegalStateError{}, constants.UnableToPublishAlreadyTerminated, nil)
+			}
+		}
+		publisher.submitBatch(batch[:size])
+		batch = batch[size:]
+	}
+	channelWrite = true
+	return nil
+}
+
+// submitBatch submits the send task for a batch of messages that were written to the buffer
+func (publisher *persistentMessagePublisherImpl) submitBatch(batch []*persistentPublishable) {
+	if len(batch) == 0 {
+		return
+	}
+	if !publisher.taskBuffer.Submit(publisher.sendBatchTask(batch)) {
+		// the messages remain in the buffer and are counted as not delivered when terminate completes, see publish
+		publisher.logger.Debug("Attempted to submit the message batch for publishing, but the task buffer rejected the task! Has the service been terminated?")
+	}
+}
+
+// sendBatchTask represents the task publishing a batch of buffered messages, see sendTask
+func (publisher *persistentMessagePublisherImpl) sendBatchTask(batch []*persistentPublishable) buffer.PublisherTask {
+	return func(terminateChannel chan struct{}) {
+		// save the contexts to the correlation map
+		for _, pub := range batch {
+			publisher.addCorrelationContext(pub.messageID, pub.corr)
+		}
+		pointers := persistentMessagePointers(batch)
+		for len(batch) > 0 {
+			published, errorInfo := publisher.internalPublisher.PublishMultiple(pointers)
+			for _, pub := range batch[:published] {
+				publisher.recordPublish(pub.message, nil)
+				publisher.completeBufferedPublish(pub.messageID, pub.corr, nil)
+			}
+			batch, pointers = batch[published:], pointers[published:]
+			if errorInfo == nil {
+				continue
+			}
+			publisher.recordPublish(batch[0].message, errorInfo)
+			if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
+				if err := publisher.internalPublisher.AwaitWritable(terminateChannel); err != nil {
+					// the remaining messages are resolved when the buffer is drained on termination,
+					// clear their correlation contexts so they are not resolved twice
+					for _, pub := range batch {
+						publisher.removeCorrelationContext(pub.messageID)
+					}
+					return
 				}
+				continue
 			}
-			// We must have a closed buffer with no more messages. Since the buffer was closed, we can safely ignore the message.
-			// This is because the
-		default:
-			// should never happen as the message queue should always be drained after
-			publisher.logger.Error("published a message after publisher buffer was drained, this is unexpected")
+			// fail the message that could not be published and continue with the rest of the batch
+			publisher.completeBufferedPublish(batch[0].messageID, batch[0].corr, toPublishError(errorInfo))
+			batch, pointers = batch[1:], pointers[1:]
 		}
 	}
 }
 
+// persistentMessagePointers returns the pointers to publish for the messages of the given batch
+func persistentMessagePointers(batch []*persistentPublishable) []core.Publishable {
+	pointers := make([]core.Publishable, len(batch))
+	for i, pub := range batch {
+		pointers[i] = message.GetOutboundMessagePointer(pub.message)
+	}
+	return pointers
+}
+
 func (publisher *persistentMessagePublisherImpl) String() string {
 	return fmt.Sprintf("solace.PersistentMessagePublisher at %p", publisher)
 }
@@ -879,6 +1173,13 @@ type correlationContext interface {
 	resolve(bool, error)
 }
 
+// correlationEntry is an entry of the correlation map awaiting acknowledgement
+type correlationEntry struct {
+	ctx correlationContext
+	// published is the time the message was submitted for sending, used to measure the acknowledgement latency
+	published time.Time
+}
+
 type callbackCorrelationContext struct {
 	callbackPtr   *unsafe.Pointer
 	message       apimessage.OutboundMessage
@@ -912,8 +1213,11 @@ func (context *callbackCorrelationContext) createReceipt(persisted bool, err err
 }
 
 type blockingCorrelationContext struct {
-	blocker chan error
-	message apimessage.OutboundMessage
+	blocker   chan error
+	message   apimessage.OutboundMessage
+	messageID uint64
+	// abandoned is set under the publisher's correlation lock once the caller stops waiting on blocker
+	abandoned bool
 }
 
 func (context *blockingCorrelationContext) resolve(persisted bool, err error) {
@@ -17,6 +17,7 @@
 package publisher
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -33,6 +34,7 @@ import (
 	"solace.dev/go/messaging/internal/impl/publisher/buffer"
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
 	"solace.dev/go/messaging/pkg/solace/resource"
 	"solace.dev/go/messaging/pkg/solace/subcode"
 )
@@ -306,7 +308,7 @@ func TestPersistentMessagePublisherImplLifecycle(t *testing.T) {
 			close(eventExecutorTerminated)
 		}
 		taskBufferTerminated := make(chan struct{})
-		taskBuffer.terminate = func(timer *time.Timer) bool {
+		taskBuffer.terminate = func(ctx context.Context) bool {
 			// this should be shutdown first
 			select {
 			case <-eventExecutorTerminated:
@@ -402,7 +404,7 @@ func TestPersistentMessagePublisherImplLifecycleNoBuffer(t *testing.T) {
 		close(eventExecutorTerminated)
 	}
 	taskBufferTerminated := make(chan struct{})
-	taskBuffer.terminate = func(timer *time.Timer) bool {
+	taskBuffer.terminate = func(ctx context.Context) bool {
 		// this should be shutdown first
 		select {
 		case <-eventExecutorTerminated:
@@ -508,7 +510,7 @@ func TestPersistentMessagePublisherLifecycleIdempotence(t *testing.T) {
 		eventExecutorTerminated <- nil
 	}
 	taskBufferTerminated := make(chan interface{}, 2)
-	taskBuffer.terminate = func(timer *time.Timer) bool {
+	taskBuffer.terminate = func(ctx context.Context) bool {
 		taskBufferTerminated <- nil
 		return true
 	}
@@ -670,6 +672,60 @@ func TestPersistentMessagePublisherTerminateWithUnackedMessages(t *testing.T) {
 	}
 }
 
+func TestPersistentMessagePublisherTerminateWithContextCancelled(t *testing.T) {
+	internalPublisher := &mockInternalPublisher{}
+	publisher := &persistentMessagePublisherImpl{}
+	publisher.construct(internalPublisher, backpressureConfigurationWait, 10)
+	eventExecutor := &mockEventExecutor{}
+	eventExecutor.submit = func(event executor.Task) bool {
+		event()
+		return true
+	}
+	taskBuffer := &mockTaskBuffer{}
+	taskBuffer.submit = func(task buffer.PublisherTask) bool {
+		task(make(chan struct{}))
+		return true
+	}
+	publisher.eventExecutor = eventExecutor
+	publisher.taskBuffer = taskBuffer
+	internalPublisher.addAcknowledgementHandler = func(ah core.AcknowledgementHandler) (uint64, func() (messageId uint64, correlationTag []byte)) {
+		msgIndex := uint64(0)
+		return 0, func() (messageId uint64, correlationTag []byte) {
+			msgIndex++
+			return msgIndex, make([]byte, 16)
+		}
+	}
+	internalPublisher.incrementMetric = func(metric core.NextGenMetric, amount uint64) {}
+
+	publisher.Start()
+	msg, err := message.NewOutboundMessage()
+	if err != nil {
+		t.Error(err)
+	}
+	unacknowledgedCount := 2
+	for i := 0; i < unacknowledgedCount; i++ {
+		publisher.Publish(msg, resource.TopicOf("hello/world"), nil, nil)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	done := make(chan error)
+	go func() {
+		done <- publisher.TerminateWithContext(ctx)
+	}()
+	select {
+	case err = <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("timed out waiting for TerminateWithContext to return after the context was cancelled")
+	}
+	expected := fmt.Sprintf(constants.IncompleteMessageDeliveryMessageWithUnacked, 0, unacknowledgedCount)
+	if err == nil || err.Error() != expected {
+		t.Errorf("did not get expected error. Expected '%s', got '%s'", expected, err)
+	}
+	if !publisher.IsTerminated() {
+		t.Error("expected publisher to be terminated")
+	}
+}
+
 func TestPersistentMessagePublisherUnsolicitedTerminationWithUnpublishedMessages(t *testing.T) {
 	internalPublisher := &mockInternalPublisher{}
 	publisher := &persistentMessagePublisherImpl{}
@@ -1021,6 +1077,69 @@ func TestPersistentMessagePublisherPublishFunctionalityPersistent(t *testing.T)
 	}
 }
 
+func TestPersistentMessagePublisherPublishToQueue(t *testing.T) {
+	publisher := &persistentMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationDirect, 1)
+	eventExecutor := &mockEventExecutor{}
+	taskBuffer := &mockTaskBuffer{}
+	publisher.eventExecutor = eventExecutor
+	publisher.taskBuffer = taskBuffer
+
+	publisher.Start()
+
+	publishCalled := false
+	var publishedDestination string
+	internalPublisher.publish = func(msg ccsmp.SolClientMessagePt) core.ErrorInfo {
+		publishCalled = true
+		publishedDestination, _ = ccsmp.SolClientMessageGetDestinationName(msg)
+		return nil
+	}
+
+	testMessage, _ := message.NewOutboundMessage()
+	testQueue := resource.QueueDurableExclusive("hello-queue")
+	err := publisher.Publish(testMessage, testQueue, nil, nil)
+	if err != nil {
+		t.Error(err)
+	}
+	if !publishCalled {
+		t.Error("expected internal publisher's publish function to be called persistently")
+	}
+	if publishedDestination != testQueue.GetName() {
+		t.Errorf("expected published destination to be %s, got %s", testQueue.GetName(), publishedDestination)
+	}
+}
+
+func TestPersistentMessagePublisherPublishToInvalidDestination(t *testing.T) {
+	publisher := &persistentMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationDirect, 1)
+	eventExecutor := &mockEventExecutor{}
+	taskBuffer := &mockTaskBuffer{}
+	publisher.eventExecutor = eventExecutor
+	publisher.taskBuffer = taskBuffer
+
+	publisher.Start()
+
+	internalPublisher.publish = func(msg ccsmp.SolClientMessagePt) core.ErrorInfo {
+		t.Error("expected internal publisher's publish function to not be called")
+		return nil
+	}
+
+	testMessage, _ := message.NewOutboundMessage()
+	invalidDestinations := []resource.Destination{
+		nil,
+		resource.QueueNonDurableExclusiveAnonymous(),
+		resource.TopicSubscriptionOf("hello/world"),
+	}
+	for _, destination := range invalidDestinations {
+		err := publisher.Publish(testMessage, destination, nil, nil)
+		if _, ok := err.(*solace.IllegalArgumentError); !ok {
+			t.Errorf("expected illegal argument error when publishing to %v, got %v", destination, err)
+		}
+	}
+}
+
 func TestPersistentMessagePublisherTask(t *testing.T) {
 	publisher := &persistentMessagePublisherImpl{}
 	internalPublisher := &mockInternalPublisher{}
@@ -1644,6 +1763,115 @@ func TestPersistentMessagePublisherAwaitAckTimeout(t *testing.T) {
 	}
 }
 
+func TestPersistentMessagePublisherAwaitAckWithContextCancelled(t *testing.T) {
+	publisher := &persistentMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationDirect, 0)
+	eventExecutor := &mockEventExecutor{}
+	publisher.eventExecutor = eventExecutor
+
+	const messageID = uint64(7)
+	internalPublisher.addAcknowledgementHandler = func(ah core.AcknowledgementHandler) (uint64, func() (messageId uint64, correlationTag []byte)) {
+		return 0, func() (messageId uint64, correlationTag []byte) {
+			return messageID, make([]byte, 16)
+		}
+	}
+
+	publisher.Start()
+
+	testMessage, _ := message.NewOutboundMessage()
+	testTopic := resource.TopicOf("hello/world")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := publisher.PublishAwaitAcknowledgementWithContext(ctx, testMessage, testTopic, nil)
+	if err != context.DeadlineExceeded {
+		t.Errorf("expected error to be context.DeadlineExceeded, got %v", err)
+	}
+	publisher.correlationLock.Lock()
+	entry, ok := publisher.correlationMap[messageID]
+	publisher.correlationLock.Unlock()
+	if !ok {
+		t.Fatal("expected correlation entry to be kept until the message is acknowledged")
+	}
+	if blocking, ok := entry.ctx.(*blockingCorrelationContext); !ok || !blocking.abandoned {
+		t.Error("expected correlation context to be marked as abandoned when the context is done")
+	}
+	// the acknowledgement releases the abandoned entry without blocking
+	publisher.ackHandler(messageID, true, nil)
+	publisher.correlationLock.Lock()
+	_, ok = publisher.correlationMap[messageID]
+	publisher.correlationLock.Unlock()
+	if ok {
+		t.Error("expected correlation entry to be released when the message is acknowledged")
+	}
+}
+
+func TestPersistentMessagePublisherAwaitAckWithContextCancelledWhileWouldBlock(t *testing.T) {
+	publisher := &persistentMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationWait, 1)
+	eventExecutor := &mockEventExecutor{}
+	taskBuffer := &mockTaskBuffer{}
+	publisher.eventExecutor = eventExecutor
+	publisher.taskBuffer = taskBuffer
+
+	publisher.Start()
+
+	publishCount := 0
+	internalPublisher.publish = func(message ccsmp.SolClientMessagePt) core.ErrorInfo {
+		publishCount++
+		return &ccsmp.SolClientErrorInfoWrapper{
+			ReturnCode: ccsmp.SolClientReturnCodeWouldBlock,
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	writable := make(chan struct{})
+	internalPublisher.awaitWritable = func(terminateSignal chan struct{}) error {
+		// the caller gives up while the send is blocked
+		cancel()
+		<-writable
+		return nil
+	}
+
+	taskComplete := make(chan struct{})
+	taskBuffer.submit = func(task buffer.PublisherTask) bool {
+		go func() {
+			task(make(chan struct{}))
+			close(taskComplete)
+		}()
+		return true
+	}
+
+	testMessage, _ := message.NewOutboundMessage()
+	testTopic := resource.TopicOf("hello/world")
+
+	err := publisher.PublishAwaitAcknowledgementWithContext(ctx, testMessage, testTopic, nil)
+	if err != context.Canceled {
+		t.Errorf("expected error to be context.Canceled, got %v", err)
+	}
+	close(writable)
+	select {
+	case <-taskComplete:
+		// success
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("expected send task to complete once the publisher is writable")
+	}
+	if publishCount != 1 {
+		t.Errorf("expected abandoned message to not be republished, got %d publish attempts", publishCount)
+	}
+	if len(publisher.buffer) != 0 {
+		t.Error("expected abandoned message to be removed from the buffer")
+	}
+	publisher.correlationLock.Lock()
+	defer publisher.correlationLock.Unlock()
+	if len(publisher.correlationMap) != 0 {
+		t.Error("expected abandoned message to be removed from the correlation map")
+	}
+}
+
 func TestPersistentMessagePublisherAwaitAckNoTimeout(t *testing.T) {
 	publisher := &persistentMessagePublisherImpl{}
 	internalPublisher := &mockInternalPublisher{}
@@ -1705,3 +1933,67 @@ func TestPersistentMessagePublisherAwaitAckNoTimeout(t *testing.T) {
 		t.Error("expected publish to resolve when acknowledgement is processed")
 	}
 }
+
+func TestPersistentMessagePublisherPublishBatch(t *testing.T) {
+	publisher := &persistentMessagePublisherImpl{}
+	internalPublisher := &mockInternalPublisher{}
+	publisher.construct(internalPublisher, backpressureConfigurationDirect, 0)
+	eventExecutor := &mockEventExecutor{}
+	publisher.eventExecutor = eventExecutor
+	publisher.taskBuffer = &mockTaskBuffer{}
+
+	var ackHandlerToCall core.AcknowledgementHandler
+	internalPublisher.addAcknowledgementHandler = func(ah core.AcknowledgementHandler) (uint64, func() (messageId uint64, correlationTag []byte)) {
+		ackHandlerToCall = ah
+		var messageID uint64
+		return 0, func() (uint64, []byte) {
+			messageID++
+			return messageID, make([]byte, 16)
+		}
+	}
+
+	publisher.Start()
+
+	eventExecutor.submit = func(event executor.Task) bool {
+		event()
+		return true
+	}
+	subCode := 23
+	internalPublisher.publishMultiple = func(messages []core.Publishable) (int, core.ErrorInfo) {
+		return 2, ccsmp.NewInternalSolClientErrorInfoWrapper(ccsmp.SolClientReturnCodeFail,
+			ccsmp.SolClientSubCode(subCode),
+			ccsmp.SolClientResponseCode(0),
+			"This is a generated error info")
+	}
+	var persisted, failed int
+	publisher.SetMessagePublishReceiptListener(func(pr solace.PublishReceipt) {
+		if pr.GetMessage() == nil {
+			t.Error("expected message to not be nil")
+		}
+		if pr.IsPersisted() {
+			persisted++
+		} else if casted, ok := pr.GetError().(*solace.NativeError); !ok || casted.SubCode() != subcode.Code(subCode) {
+			t.Errorf("expected native error with sub code %d, got %v", subCode, pr.GetError())
+		} else {
+			failed++
+		}
+	})
+
+	testMessage, _ := message.NewOutboundMessage()
+	testDestinations := []resource.Destination{resource.TopicOf("hello/world"), resource.QueueDurableExclusive("q"), resource.TopicOf("hello/world")}
+	err := publisher.PublishBatch([]apimessage.OutboundMessage{testMessage, testMessage, testMessage}, testDestinations...)
+	if _, ok := err.(*solace.NativeError); !ok {
+		t.Errorf("expected native error, got %s", err)
+	}
+	if failed != 1 {
+		t.Errorf("expected a failed receipt for the unpublished message, got %d", failed)
+	}
+	if len(publisher.correlationMap) != 2 {
+		t.Errorf("expected the published messages to await acknowledgement, got %d", len(publisher.correlationMap))
+	}
+	ackHandlerToCall(1, true, nil)
+	ackHandlerToCall(2, true, nil)
+	if persisted != 2 {
+		t.Errorf("expected receipts for the published messages, got %d", persisted)
+	}
+}
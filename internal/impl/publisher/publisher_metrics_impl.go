@@ -0,0 +1,85 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package publisher
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+// publisherMetricsByNextGenMetric maps the session wide metrics incremented by publishers
+// to the scoped publisher metrics maintained alongside them
+var publisherMetricsByNextGenMetric = map[core.NextGenMetric]metrics.PublisherMetric{
+	core.MetricPublishMessagesTerminationDiscarded: metrics.PublisherMessagesTerminationDiscarded,
+}
+
+// publisherMetricsImpl holds the metrics of a single publisher
+type publisherMetricsImpl struct {
+	values [metrics.PublisherMetricCount]uint64
+}
+
+// GetValue will retrieve the value/count of the given PublisherMetric.
+func (publisherMetrics *publisherMetricsImpl) GetValue(metric metrics.PublisherMetric) uint64 {
+	if metric < 0 || int(metric) >= metrics.PublisherMetricCount {
+		return 0
+	}
+	return atomic.LoadUint64(&publisherMetrics.values[metric])
+}
+
+// Reset will reset all publisher metrics.
+func (publisherMetrics *publisherMetricsImpl) Reset() {
+	for i := range publisherMetrics.values {
+		atomic.StoreUint64(&publisherMetrics.values[i], 0)
+	}
+}
+
+func (publisherMetrics *publisherMetricsImpl) increment(metric metrics.PublisherMetric, amount uint64) {
+	atomic.AddUint64(&publisherMetrics.values[metric], amount)
+}
+
+func (publisherMetrics *publisherMetricsImpl) String() string {
+	return fmt.Sprintf("metrics.PublisherMetrics at %p", publisherMetrics)
+}
+
+// Metrics returns the metrics of this publisher.
+func (publisher *basicMessagePublisher) Metrics() metrics.PublisherMetrics {
+	return &publisher.metrics
+}
+
+// incrementMetric increments the given session wide metric and the publisher metric maintained alongside it
+func (publisher *basicMessagePublisher) incrementMetric(metric core.NextGenMetric, amount uint64) {
+	publisher.internalPublisher.IncrementMetric(metric, amount)
+	if publisherMetric, ok := publisherMetricsByNextGenMetric[metric]; ok {
+		publisher.metrics.increment(publisherMetric, amount)
+	}
+}
+
+// recordPublish updates the publisher metrics with the result of handing the given message to the transport
+func (publisher *basicMessagePublisher) recordPublish(msg *message.OutboundMessageImpl, errorInfo core.ErrorInfo) {
+	if errorInfo == nil {
+		publisher.metrics.increment(metrics.PublisherMessagesSent, 1)
+		publisher.metrics.increment(metrics.PublisherBytesSent, message.GetOutboundMessagePayloadSize(msg))
+	} else if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
+		publisher.metrics.increment(metrics.PublisherBackpressureEvents, 1)
+	} else {
+		publisher.metrics.increment(metrics.PublisherMessagesFailed, 1)
+	}
+}
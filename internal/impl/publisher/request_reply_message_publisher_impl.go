@@ -18,6 +18,7 @@
 package publisher
 
 import (
+	"context"
 	"fmt"
 	"runtime/debug"
 	"sync"
@@ -38,6 +39,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
@@ -48,11 +50,13 @@ type correlationEntryImpl struct {
 	received    bool
 	result      chan core.Repliable
 	sentChan    chan error
+	// sent is the time the request was sent, used to measure the reply latency
+	sent time.Time
 }
 
 type CorrelationEntry = *correlationEntryImpl
 
-type ReplyOutcome = func() (apimessage.InboundMessage, error)
+type ReplyOutcome = func(ctx context.Context) (apimessage.InboundMessage, error)
 
 type requestReplyMessagePublisherImpl struct {
 	basicMessagePublisher
@@ -94,7 +98,7 @@ func (publisher *requestReplyMessagePublisherImpl) construct(internalPublisher c
 	publisher.terminateWaitInterrupt = make(chan struct{})
 	publisher.correlationComplete = make(chan struct{})
 	publisher.requestCorrelateComplete = make(chan struct{})
-	publisher.logger = logging.For(publisher)
+	publisher.logger = publisher.internalPublisher.Logger().For(publisher)
 }
 
 func (publisher *requestReplyMessagePublisherImpl) onDownEvent(eventInfo core.SessionEventInfo) {
@@ -185,7 +189,17 @@ func (publisher *requestReplyMessagePublisherImpl) StartAsyncCallback(callback f
 // unfinished tasks or in-flight messages.
 // This function blocks until the service is terminated.
 // If gracePeriod is less than 0, the function will wait indefinitely.
-func (publisher *requestReplyMessagePublisherImpl) Terminate(gracePeriod time.Duration) (err error) {
+func (publisher *requestReplyMessagePublisherImpl) Terminate(gracePeriod time.Duration) error {
+	ctx, cancel := gracePeriodContext(gracePeriod)
+	defer cancel()
+	return publisher.TerminateWithContext(ctx)
+}
+
+// TerminateWithContext will terminate the service gracefully and synchronously.
+// A graceful shutdown will be attempted until the given context is done, at which point
+// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+// This function blocks until the service is terminated.
+func (publisher *requestReplyMessagePublisherImpl) TerminateWithContext(ctx context.Context) (err error) {
 	if proceed, err := publisher.terminate(); !proceed {
 		return err
 	}
@@ -209,12 +223,6 @@ func (publisher *requestReplyMessagePublisherImpl) Terminate(gracePeriod time.Du
 	// We're terminating, we do not care about the down event handler anymore
 	publisher.internalPublisher.Events().RemoveEventHandler(publisher.downEventHandlerID)
 
-	// create timer if needed
-	var timer *time.Timer
-	if gracePeriod >= 0 {
-		timer = time.NewTimer(gracePeriod)
-	}
-
 	// handle graceful shutdown
 	graceful := true
 
@@ -223,7 +231,7 @@ func (publisher *requestReplyMessagePublisherImpl) Terminate(gracePeriod time.Du
 	if publisher.backpressureConfiguration != backpressureConfigurationDirect {
 		// First we interrupt all backpressure wait functions
 		close(publisher.terminateWaitInterrupt)
-		graceful = publisher.taskBuffer.Terminate(timer)
+		graceful = publisher.taskBuffer.Terminate(ctx)
 	} else {
 		publisher.internalPublisher.Events().RemoveEventHandler(publisher.canSendEventHandlerID)
 	}
@@ -239,16 +247,11 @@ func (publisher *requestReplyMessagePublisherImpl) Terminate(gracePeriod time.Du
 		close(publisher.requestCorrelateComplete)
 		publisher.rxLock.Unlock()
 		if outstandingReplies > 0 {
-			if timer != nil {
-				select {
-				case <-publisher.correlationComplete:
-					// success
-				case <-timer.C:
-					graceful = false
-				}
-			} else {
-				// Block forever as our grace period is negative
-				<-publisher.correlationComplete
+			select {
+			case <-publisher.correlationComplete:
+				// success
+			case <-ctx.Done():
+				graceful = false
 			}
 		}
 	}
@@ -294,7 +297,7 @@ func (publisher *requestReplyMessagePublisherImpl) Terminate(gracePeriod time.Du
 
 	if undeliveredCount > 0 {
 		// return an error if we have one
-		publisher.internalPublisher.IncrementMetric(core.MetricPublishMessagesTerminationDiscarded, uint64(undeliveredCount))
+		publisher.incrementMetric(core.MetricPublishMessagesTerminationDiscarded, uint64(undeliveredCount))
 		err := solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageDeliveryMessage, undeliveredCount), nil)
 		return err
 	}
@@ -327,7 +330,7 @@ func (publisher *requestReplyMessagePublisherImpl) unsolicitedTermination(errorI
 			}
 			// return an error if we have one
 			err = solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageDeliveryMessage, undeliveredCount), nil)
-			publisher.internalPublisher.IncrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
+			publisher.incrementMetric(core.MetricPublishMessagesTerminationDiscarded, undeliveredCount)
 		}
 	} else {
 		publisher.internalPublisher.Events().RemoveEventHandler(publisher.canSendEventHandlerID)
@@ -432,7 +435,7 @@ func (publisher *requestReplyMessagePublisherImpl) PublishBytes(bytes []byte, re
 	if err != nil {
 		return err
 	}
-	go outcomeHandler()
+	go outcomeHandler(context.Background())
 	return nil
 }
 
@@ -452,7 +455,7 @@ func (publisher *requestReplyMessagePublisherImpl) PublishString(str string, rep
 	if err != nil {
 		return err
 	}
-	go outcomeHandler()
+	go outcomeHandler(context.Background())
 	return nil
 }
 
@@ -486,13 +489,21 @@ func (publisher *requestReplyMessagePublisherImpl) Publish(msg apimessage.Outbou
 	if err != nil {
 		return err
 	}
-	go outcomeHandler()
+	go outcomeHandler(context.Background())
 	return nil
 }
 
 func (publisher *requestReplyMessagePublisherImpl) PublishAwaitResponse(msg apimessage.OutboundMessage, dest *resource.Topic, replyTimeout time.Duration, properties config.MessagePropertiesConfigurationProvider) (apimessage.InboundMessage, error) {
-	// stub for now
-	// need to work out usage with call to publisher.publish
+	return publisher.publishAwaitResponse(context.Background(), msg, dest, replyTimeout, properties)
+}
+
+// PublishAwaitResponseWithContext publishes a request message and blocks until a reply is received
+// or the given context is done. The correlation entry for the request is released on cancellation.
+func (publisher *requestReplyMessagePublisherImpl) PublishAwaitResponseWithContext(ctx context.Context, msg apimessage.OutboundMessage, dest *resource.Topic, properties config.MessagePropertiesConfigurationProvider) (apimessage.InboundMessage, error) {
+	return publisher.publishAwaitResponse(ctx, msg, dest, -1, properties)
+}
+
+func (publisher *requestReplyMessagePublisherImpl) publishAwaitResponse(ctx context.Context, msg apimessage.OutboundMessage, dest *resource.Topic, replyTimeout time.Duration, properties config.MessagePropertiesConfigurationProvider) (apimessage.InboundMessage, error) {
 	if err := publisher.checkStartedStateForPublish(); err != nil {
 		return nil, err
 	}
@@ -515,7 +526,7 @@ func (publisher *requestReplyMessagePublisherImpl) PublishAwaitResponse(msg apim
 	if err != nil {
 		return nil, err
 	}
-	return outcomeHandler()
+	return outcomeHandler(ctx)
 }
 
 func (publisher *requestReplyMessagePublisherImpl) publishAsync(msg *message.OutboundMessageImpl, replyMessageHandler solace.ReplyMessageHandler, dest *resource.Topic, replyTimeout time.Duration, userContext interface{}) (retOutcome ReplyOutcome, ret error) {
@@ -573,6 +584,7 @@ func (publisher *requestReplyMessagePublisherImpl) publish(msg *message.Outbound
 		defer msg.Dispose()
 		// publish directly with CCSMP
 		errorInfo := publisher.internalPublisher.Publish(message.GetOutboundMessagePointer(msg))
+		publisher.recordPublish(msg, errorInfo)
 		if errorInfo != nil {
 			publisher.signalRequestCorrelationSent(correlationID, core.ToNativeError(errorInfo, "encountered error while publishing message: "))
 			if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
@@ -614,6 +626,7 @@ func (publisher *requestReplyMessagePublisherImpl) publish(msg *message.Outbound
 			case publisher.buffer <- pub:
 				channelWrite = true // we successfully wrote the message to the channel
 			default:
+				publisher.metrics.increment(metrics.PublisherBackpressureEvents, 1)
 				return nil, solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
 			}
 		} else {
@@ -648,6 +661,7 @@ func (publisher *requestReplyMessagePublisherImpl) sendTask(msg *message.Outboun
 		for {
 			// attempt a publish
 			errorInfo = publisher.internalPublisher.Publish(message.GetOutboundMessagePointer(msg))
+			publisher.recordPublish(msg, errorInfo)
 			if errorInfo != nil {
 				// if we got a would block, wait for ready and retry
 				if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
@@ -767,10 +781,13 @@ func (publisher *requestReplyMessagePublisherImpl) signalRequestCorrelationSent(
 	if !ok {
 		return
 	}
+	if sentErr == nil {
+		entry.sent = time.Now()
+	}
 	entry.sentChan <- sentErr
 }
 
-func (publisher *requestReplyMessagePublisherImpl) createReplyCorrelation(userContext interface{}, timeout time.Duration, handler solace.ReplyMessageHandler) (string, func() (apimessage.InboundMessage, error)) {
+func (publisher *requestReplyMessagePublisherImpl) createReplyCorrelation(userContext interface{}, timeout time.Duration, handler solace.ReplyMessageHandler) (string, ReplyOutcome) {
 	publisher.rxLock.Lock()
 	defer publisher.rxLock.Unlock()
 	// create correlation id
@@ -784,10 +801,11 @@ func (publisher *requestReplyMessagePublisherImpl) createReplyCorrelation(userCo
 
 	// return closure function that blocks until result or timeout or pulbisher invalid state
 
-	return correlationID, func() (retMsg apimessage.InboundMessage, retErr error) {
+	return correlationID, func(ctx context.Context) (retMsg apimessage.InboundMessage, retErr error) {
 		retErr = nil
 		var ok bool = true
 		var sentErr error = nil
+		var timeoutChan <-chan time.Time
 		// wait for request send
 		select {
 		case sentErr, ok = <-entry.sentChan:
@@ -796,6 +814,8 @@ func (publisher *requestReplyMessagePublisherImpl) createReplyCorrelation(userCo
 			}
 		case <-publisher.correlationComplete:
 			sentErr = solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil)
+		case <-ctx.Done():
+			sentErr = ctx.Err()
 		}
 
 		// if not sent dispatch outcome of reply
@@ -806,33 +826,23 @@ func (publisher *requestReplyMessagePublisherImpl) createReplyCorrelation(userCo
 
 		if entry.timeout >= 0 {
 			timer := time.NewTimer(timeout)
-			select {
-			case msgP, ok := <-entry.result:
-				timer.Stop()
-				if ok {
-					retMsg = message.NewInboundMessage(msgP, false)
-				} else {
-					retErr = solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil)
-				}
-			case <-timer.C:
-				retErr = solace.NewError(&solace.TimeoutError{}, constants.RequestReplyPublisherTimedOutWaitingForReply, nil)
-			case <-publisher.correlationComplete:
-				timer.Stop()
-				retErr = solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil)
-			}
-		} else {
-			// timeout < 0 blocks forever
-			select {
-			case msgP, ok := <-entry.result:
-				//success
-				if ok {
-					retMsg = message.NewInboundMessage(msgP, false)
-				} else {
-					retErr = solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil)
-				}
-			case <-publisher.correlationComplete:
+			defer timer.Stop()
+			timeoutChan = timer.C
+		}
+		// timeout < 0 blocks until the context is done
+		select {
+		case msgP, ok := <-entry.result:
+			if ok {
+				retMsg = message.NewInboundMessage(msgP, false)
+			} else {
 				retErr = solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil)
 			}
+		case <-timeoutChan:
+			retErr = solace.NewError(&solace.TimeoutError{}, constants.RequestReplyPublisherTimedOutWaitingForReply, nil)
+		case <-publisher.correlationComplete:
+			retErr = solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil)
+		case <-ctx.Done():
+			retErr = ctx.Err()
 		}
 		// only check correlation if there is no error
 		if retErr == nil {
@@ -886,6 +896,9 @@ func (publisher *requestReplyMessagePublisherImpl) handleReplyMessage(msgP core.
 		return false
 	}
 	corEntry.received = true
+	if !corEntry.sent.IsZero() {
+		publisher.internalPublisher.RecordLatency(metrics.RequestReplyLatency, time.Since(corEntry.sent))
+	}
 	corEntry.result <- msgP
 	return true
 }
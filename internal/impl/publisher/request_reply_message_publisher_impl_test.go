@@ -17,6 +17,7 @@
 package publisher
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"testing"
@@ -308,7 +309,7 @@ func TestRequestReplyMessagePublisherImplLifecycle(t *testing.T) {
 			close(eventExecutorTerminated)
 		}
 		taskBufferTerminated := make(chan struct{})
-		taskBuffer.terminate = func(timer *time.Timer) bool {
+		taskBuffer.terminate = func(ctx context.Context) bool {
 			// this should be shutdown first
 			select {
 			case <-eventExecutorTerminated:
@@ -416,7 +417,7 @@ func TestRequestReplyMessagePublisherImplLifecycleNoBuffer(t *testing.T) {
 		close(eventExecutorTerminated)
 	}
 	taskBufferTerminated := make(chan struct{})
-	taskBuffer.terminate = func(timer *time.Timer) bool {
+	taskBuffer.terminate = func(ctx context.Context) bool {
 		// this should be shutdown first
 		select {
 		case <-eventExecutorTerminated:
@@ -534,7 +535,7 @@ func TestRequestReplyMessagePublisherLifecycleIdempotence(t *testing.T) {
 		eventExecutorTerminated <- nil
 	}
 	taskBufferTerminated := make(chan interface{}, 2)
-	taskBuffer.terminate = func(timer *time.Timer) bool {
+	taskBuffer.terminate = func(ctx context.Context) bool {
 		taskBufferTerminated <- nil
 		return true
 	}
@@ -0,0 +1,264 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publisher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/internal/impl/message"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+type transactionalMessagePublisherImpl struct {
+	basicMessagePublisher
+	logger logging.LogLevelLogger
+
+	transactedSession core.TransactedSession
+
+	downEventHandlerID uint
+}
+
+func (publisher *transactionalMessagePublisherImpl) construct(internalPublisher core.Publisher, transactedSession core.TransactedSession) {
+	publisher.basicMessagePublisher.construct(internalPublisher)
+	publisher.transactedSession = transactedSession
+	publisher.logger = publisher.internalPublisher.Logger().For(publisher)
+}
+
+func (publisher *transactionalMessagePublisherImpl) onDownEvent(eventInfo core.SessionEventInfo) {
+	go publisher.unsolicitedTermination(eventInfo)
+}
+
+// Start will start the service synchronously.
+// Before this function is called, the service is considered
+// off-duty. To operate normally, this function must be called on
+// a receiver or publisher instance. This function is idempotent.
+// Returns an error if one occurred or nil if successful.
+func (publisher *transactionalMessagePublisherImpl) Start() (err error) {
+	// this will block until we are started if we are not first
+	if proceed, err := publisher.starting(); !proceed {
+		return err
+	}
+	publisher.logger.Debug("Start transactional publisher start")
+	publisher.downEventHandlerID = publisher.internalPublisher.Events().AddEventHandler(core.SolClientEventDown, publisher.onDownEvent)
+	go publisher.eventExecutor.Run()
+	publisher.started(nil)
+	publisher.logger.Debug("Start transactional publisher complete")
+	return nil
+}
+
+// StartAsync will start the service asynchronously.
+// Before this function is called, the service is considered
+// off-duty. To operate normally, this function must be called on
+// a receiver or publisher instance. This function is idempotent.
+// Returns a channel that will receive an error if one occurred or
+// nil if successful. Subsequent calls will return additional
+// channels that can await an error, or nil if already started.
+func (publisher *transactionalMessagePublisherImpl) StartAsync() <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- publisher.Start()
+		close(result)
+	}()
+	return result
+}
+
+// StartAsyncCallback will start the TransactionalMessagePublisher asynchronously.
+// Calls the callback when started with an error if one occurred or nil
+// if successful.
+func (publisher *transactionalMessagePublisherImpl) StartAsyncCallback(callback func(solace.TransactionalMessagePublisher, error)) {
+	go func() {
+		callback(publisher, publisher.Start())
+	}()
+}
+
+// Terminate will terminate the service synchronously.
+// This function is idempotent. The only way to resume operation
+// after this function is called is to create a new instance.
+// Messages published by a transactional publisher are sent immediately
+// and are settled by the transaction rather than the publisher, so
+// there is nothing to wait for within the grace period.
+func (publisher *transactionalMessagePublisherImpl) Terminate(gracePeriod time.Duration) (err error) {
+	if proceed, err := publisher.terminate(); !proceed {
+		return err
+	}
+	publisher.logger.Debug("Terminate transactional publisher start")
+	publisher.internalPublisher.Events().RemoveEventHandler(publisher.downEventHandlerID)
+	publisher.eventExecutor.Terminate()
+	publisher.terminated(nil)
+	publisher.logger.Debug("Terminate transactional publisher complete")
+	return nil
+}
+
+// TerminateWithContext will terminate the service synchronously.
+// As there is nothing to wait for, the context is not used. For more information,
+// see Terminate.
+func (publisher *transactionalMessagePublisherImpl) TerminateWithContext(ctx context.Context) error {
+	return publisher.Terminate(0)
+}
+
+func (publisher *transactionalMessagePublisherImpl) unsolicitedTermination(eventInfo core.SessionEventInfo) {
+	if proceed, _ := publisher.terminate(); !proceed {
+		return
+	}
+	if publisher.logger.IsDebugEnabled() {
+		publisher.logger.Debug("Received unsolicited termination with event info " + eventInfo.GetInfoString())
+		defer publisher.logger.Debug("Unsolicited termination complete")
+	}
+	timestamp := time.Now()
+	publisher.internalPublisher.Events().RemoveEventHandler(publisher.downEventHandlerID)
+	publisher.eventExecutor.Terminate()
+	publisher.terminated(nil)
+	if publisher.terminationListener != nil {
+		publisher.terminationListener(&publisherTerminationEvent{
+			timestamp,
+			eventInfo.GetError(),
+		})
+	}
+}
+
+// TerminateAsync will terminate the service asynchronously.
+// This function is idempotent. The only way to resume operation
+// after this function is called is to create a new instance.
+// Returns a channel that will receive an error if one occurred or
+// nil if successfully terminated.
+func (publisher *transactionalMessagePublisherImpl) TerminateAsync(gracePeriod time.Duration) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- publisher.Terminate(gracePeriod)
+		close(result)
+	}()
+	return result
+}
+
+// TerminateAsyncCallback will terminate the TransactionalMessagePublisher asynchronously.
+// Calls the callback when terminated with nil if successful or an error if
+// one occurred.
+func (publisher *transactionalMessagePublisherImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(publisher.Terminate(gracePeriod))
+	}()
+}
+
+// PublishBytes will publish a message of type byte array to the given destination
+// within the current transaction. Returns an error if one occurred.
+func (publisher *transactionalMessagePublisherImpl) PublishBytes(bytes []byte, dest resource.Destination) error {
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	msg, err := publisher.messageBuilder.BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	// we built the message so it is safe to cast
+	return publisher.publish(msg.(*message.OutboundMessageImpl), dest)
+}
+
+// PublishString will publish a message of type string to the given destination
+// within the current transaction. Returns an error if one occurred.
+func (publisher *transactionalMessagePublisherImpl) PublishString(str string, dest resource.Destination) error {
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	msg, err := publisher.messageBuilder.BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	// we built the message so it is safe to cast
+	return publisher.publish(msg.(*message.OutboundMessageImpl), dest)
+}
+
+// Publish will publish the given message of type OutboundMessage with the given
+// properties within the current transaction. These properties will override the
+// properties on the OutboundMessage instance if present.
+func (publisher *transactionalMessagePublisherImpl) Publish(msg apimessage.OutboundMessage, dest resource.Destination, properties config.MessagePropertiesConfigurationProvider) error {
+	if err := publisher.checkStartedStateForPublish(); err != nil {
+		return err
+	}
+	msgDup, err := duplicateMessageAndSetProperties(msg, properties)
+	if err != nil {
+		return err
+	}
+	return publisher.publish(msgDup, dest)
+}
+
+// publish sends the given duplicated message on the transacted session and disposes of it
+func (publisher *transactionalMessagePublisherImpl) publish(msg *message.OutboundMessageImpl, dest resource.Destination) error {
+	defer msg.Dispose()
+	if err := setPersistentDestination(msg, dest); err != nil {
+		return err
+	}
+	if err := message.SetDeliveryMode(msg, message.DeliveryModePersistent); err != nil {
+		return err
+	}
+	errorInfo := publisher.transactedSession.Publish(message.GetOutboundMessagePointer(msg))
+	publisher.recordPublish(msg, errorInfo)
+	if errorInfo != nil {
+		if errorInfo.ReturnCode == ccsmp.SolClientReturnCodeWouldBlock {
+			return solace.NewError(&solace.PublisherOverflowError{}, constants.WouldBlock, nil)
+		}
+		return core.ToNativeError(errorInfo)
+	}
+	return nil
+}
+
+func (publisher *transactionalMessagePublisherImpl) String() string {
+	return fmt.Sprintf("solace.TransactionalMessagePublisher at %p", publisher)
+}
+
+type transactionalMessagePublisherBuilderImpl struct {
+	internalPublisher core.Publisher
+	transactedSession core.TransactedSession
+	onBuild           func(solace.LifecycleControl)
+}
+
+// NewTransactionalMessagePublisherBuilderImpl function. The transacted session is nil when the
+// transactional messaging service is not connected, and onBuild is called with each built publisher.
+func NewTransactionalMessagePublisherBuilderImpl(internalPublisher core.Publisher, transactedSession core.TransactedSession, onBuild func(solace.LifecycleControl)) solace.TransactionalMessagePublisherBuilder {
+	return &transactionalMessagePublisherBuilderImpl{
+		internalPublisher: internalPublisher,
+		transactedSession: transactedSession,
+		onBuild:           onBuild,
+	}
+}
+
+// Build will build a new TransactionalMessagePublisher instance.
+// Returns solace/errors.*IllegalStateError if the transactional messaging service is not connected.
+func (builder *transactionalMessagePublisherBuilderImpl) Build() (messagePublisher solace.TransactionalMessagePublisher, err error) {
+	if builder.transactedSession == nil {
+		return nil, solace.NewError(&solace.IllegalStateError{}, constants.TransactionalServiceNotConnected, nil)
+	}
+	publisher := &transactionalMessagePublisherImpl{}
+	publisher.construct(builder.internalPublisher, builder.transactedSession)
+	if builder.onBuild != nil {
+		builder.onBuild(publisher)
+	}
+	return publisher, nil
+}
+
+func (builder *transactionalMessagePublisherBuilderImpl) String() string {
+	return fmt.Sprintf("solace.TransactionalMessagePublisherBuilder at %p", builder)
+}
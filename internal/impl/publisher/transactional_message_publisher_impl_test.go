@@ -0,0 +1,112 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package publisher
+
+import (
+	"testing"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+func TestTransactionalMessagePublisherBuildRequiresConnectedService(t *testing.T) {
+	builder := NewTransactionalMessagePublisherBuilderImpl(&mockInternalPublisher{}, nil, nil)
+	_, err := builder.Build()
+	if _, ok := err.(*solace.IllegalStateError); !ok {
+		t.Errorf("expected illegal state error building without a transacted session, got %T", err)
+	}
+}
+
+func TestTransactionalMessagePublisherPublish(t *testing.T) {
+	transactedSession := &mockTransactedSession{}
+	published := 0
+	transactedSession.publish = func(message core.Publishable) core.ErrorInfo {
+		published++
+		return nil
+	}
+	var built solace.LifecycleControl
+	publisher, err := NewTransactionalMessagePublisherBuilderImpl(&mockInternalPublisher{}, transactedSession, func(child solace.LifecycleControl) {
+		built = child
+	}).Build()
+	if err != nil {
+		t.Fatalf("expected build to succeed, got %s", err)
+	}
+	if built != publisher {
+		t.Error("expected the built publisher to be passed to the build callback")
+	}
+	testTopic := resource.TopicOf("hello/world")
+	if err = publisher.PublishString("hello", testTopic); err == nil {
+		t.Error("expected publish to fail before the publisher is started")
+	}
+	if err = publisher.Start(); err != nil {
+		t.Fatalf("expected start to succeed, got %s", err)
+	}
+	if err = publisher.PublishString("hello", testTopic); err != nil {
+		t.Errorf("expected publish to succeed, got %s", err)
+	}
+	if published != 1 {
+		t.Errorf("expected message to be published on the transacted session, got %d publishes", published)
+	}
+	if err = publisher.PublishBytes([]byte("hello"), nil); err == nil {
+		t.Error("expected publish to fail without a destination")
+	}
+
+	transactedSession.publish = func(message core.Publishable) core.ErrorInfo {
+		return &ccsmp.SolClientErrorInfoWrapper{
+			ReturnCode: ccsmp.SolClientReturnCodeWouldBlock,
+		}
+	}
+	err = publisher.PublishString("hello", testTopic)
+	if _, ok := err.(*solace.PublisherOverflowError); !ok {
+		t.Errorf("expected would block error, got %s", err)
+	}
+	if err = publisher.Terminate(0); err != nil {
+		t.Errorf("expected terminate to succeed, got %s", err)
+	}
+	if !publisher.IsTerminated() {
+		t.Error("expected publisher to be terminated")
+	}
+}
+
+type mockTransactedSession struct {
+	publish func(message core.Publishable) core.ErrorInfo
+}
+
+func (session *mockTransactedSession) Publish(message core.Publishable) core.ErrorInfo {
+	if session.publish != nil {
+		return session.publish(message)
+	}
+	return nil
+}
+
+func (session *mockTransactedSession) NewPersistentReceiver(properties []string, callback core.RxCallback, eventCallback core.PersistentEventCallback) (core.PersistentReceiver, core.ErrorInfo) {
+	return nil, nil
+}
+
+func (session *mockTransactedSession) Commit() core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) Rollback() core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) Destroy() core.ErrorInfo {
+	return nil
+}
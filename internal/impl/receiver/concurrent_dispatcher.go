@@ -0,0 +1,290 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"sync"
+	"sync/atomic"
+
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/internal/impl/validation"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+)
+
+// concurrentDispatchLaneCapacity is the number of messages that may be queued for each worker
+// before dispatching blocks, in turn applying back pressure to the receiver's buffer
+const concurrentDispatchLaneCapacity = 16
+
+// dispatchTask is a single message handed to a concurrentDispatcher
+type dispatchTask struct {
+	// handle delivers the message to the message handler
+	handle func()
+	// discard releases the message when the dispatcher is interrupted before the message is handled
+	discard func()
+}
+
+// concurrentDispatcher calls message handlers from a pool of workers. Messages with the same ordering
+// key are always queued on the same lane, and each lane is served by a single worker such that they
+// are handled in the order they are dispatched. When unordered, all workers serve a single shared lane.
+// While paused, the workers hold the queued messages until resumed or interrupted.
+type concurrentDispatcher struct {
+	ordering   config.OrderingPolicy
+	workers    int
+	lanes      []chan dispatchTask
+	interrupt  <-chan struct{}
+	onComplete func()
+	// keyFunction replaces the partition key as the ordering key when set
+	keyFunction func(msg apimessage.InboundMessage) string
+
+	// inFlight is the number of dispatched messages that are not yet handled or discarded
+	inFlight int64
+	// discarded is the number of dispatched messages discarded when interrupted
+	discarded uint64
+
+	pauseLock sync.Mutex
+	// resumed is closed while the dispatcher is not paused
+	resumed chan struct{}
+
+	startOnce    sync.Once
+	shutdownOnce sync.Once
+	workersDone  sync.WaitGroup
+}
+
+// newConcurrentDispatcher creates a dispatcher with the given number of workers and ordering policy.
+// Queued messages are discarded rather than handled once interrupt is closed. The optional onComplete
+// function is called by the worker after each message is handled or discarded.
+func newConcurrentDispatcher(workers int, ordering config.OrderingPolicy, interrupt <-chan struct{}, onComplete func()) *concurrentDispatcher {
+	dispatcher := &concurrentDispatcher{
+		ordering:   ordering,
+		workers:    workers,
+		interrupt:  interrupt,
+		onComplete: onComplete,
+		resumed:    make(chan struct{}),
+	}
+	close(dispatcher.resumed)
+	if ordering == config.OrderingPolicyUnordered {
+		dispatcher.lanes = []chan dispatchTask{make(chan dispatchTask, workers*concurrentDispatchLaneCapacity)}
+	} else {
+		dispatcher.lanes = make([]chan dispatchTask, workers)
+		for i := range dispatcher.lanes {
+			dispatcher.lanes[i] = make(chan dispatchTask, concurrentDispatchLaneCapacity)
+		}
+	}
+	return dispatcher
+}
+
+// start starts the workers. This function is idempotent.
+func (dispatcher *concurrentDispatcher) start() {
+	dispatcher.startOnce.Do(func() {
+		dispatcher.workersDone.Add(dispatcher.workers)
+		for i := 0; i < dispatcher.workers; i++ {
+			go dispatcher.work(dispatcher.lanes[i%len(dispatcher.lanes)])
+		}
+	})
+}
+
+// dispatch queues the given task on the lane of the given message, blocking while the lane is full.
+// Returns false if the dispatcher is interrupted before the task could be queued, in which case
+// the task is discarded.
+func (dispatcher *concurrentDispatcher) dispatch(msg apimessage.InboundMessage, task dispatchTask) bool {
+	lane := dispatcher.lanes[0]
+	if len(dispatcher.lanes) > 1 {
+		hash := fnv.New32a()
+		hash.Write([]byte(dispatcher.orderingKey(msg)))
+		lane = dispatcher.lanes[hash.Sum32()%uint32(len(dispatcher.lanes))]
+	}
+	atomic.AddInt64(&dispatcher.inFlight, 1)
+	select {
+	case lane <- task:
+		return true
+	case <-dispatcher.interrupt:
+		atomic.AddInt64(&dispatcher.inFlight, -1)
+		dispatcher.discard(task)
+		return false
+	}
+}
+
+// pause stops the workers from handling queued messages until resumed. Handlers that are
+// already running when paused are not interrupted. May be called on a nil dispatcher.
+func (dispatcher *concurrentDispatcher) pause() {
+	if dispatcher == nil {
+		return
+	}
+	dispatcher.pauseLock.Lock()
+	defer dispatcher.pauseLock.Unlock()
+	select {
+	case <-dispatcher.resumed:
+		dispatcher.resumed = make(chan struct{})
+	default:
+		// already paused
+	}
+}
+
+// resume lets the workers handle queued messages again. May be called on a nil dispatcher.
+func (dispatcher *concurrentDispatcher) resume() {
+	if dispatcher == nil {
+		return
+	}
+	dispatcher.pauseLock.Lock()
+	defer dispatcher.pauseLock.Unlock()
+	select {
+	case <-dispatcher.resumed:
+		// not paused
+	default:
+		close(dispatcher.resumed)
+	}
+}
+
+// awaitResumed blocks while the dispatcher is paused, returning false if interrupted
+func (dispatcher *concurrentDispatcher) awaitResumed() bool {
+	// check for interrupts first as select picks arbitrarily between ready cases
+	select {
+	case <-dispatcher.interrupt:
+		return false
+	default:
+	}
+	dispatcher.pauseLock.Lock()
+	resumed := dispatcher.resumed
+	dispatcher.pauseLock.Unlock()
+	select {
+	case <-resumed:
+		return true
+	case <-dispatcher.interrupt:
+		return false
+	}
+}
+
+// discard counts and discards a task that will not be handled
+func (dispatcher *concurrentDispatcher) discard(task dispatchTask) {
+	atomic.AddUint64(&dispatcher.discarded, 1)
+	if task.discard != nil {
+		task.discard()
+	}
+}
+
+// orderingKey returns the key of the given message under the dispatcher's ordering policy
+func (dispatcher *concurrentDispatcher) orderingKey(msg apimessage.InboundMessage) string {
+	switch dispatcher.ordering {
+	case config.OrderingPolicyPerTopic:
+		return msg.GetDestinationName()
+	case config.OrderingPolicyPerPartitionKey:
+		if dispatcher.keyFunction != nil {
+			return dispatcher.keyFunction(msg)
+		}
+		if partitionKey, ok := msg.GetProperty(config.QueuePartitionKey); ok && partitionKey != nil {
+			return fmt.Sprint(partitionKey)
+		}
+	}
+	return ""
+}
+
+// setKeyFunction orders messages by the key returned by the given key function rather than by their
+// partition key. Messages for which the key function panics are ordered with messages without a key.
+// Only has effect when ordering by partition key, and must be called before the dispatcher is started.
+func (dispatcher *concurrentDispatcher) setKeyFunction(keyFunction func(msg apimessage.InboundMessage) string, logger logging.LogLevelLogger) {
+	dispatcher.keyFunction = func(msg apimessage.InboundMessage) (key string) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Warning("Message key function paniced: " + fmt.Sprint(r))
+				key = ""
+			}
+		}()
+		return keyFunction(msg)
+	}
+}
+
+func (dispatcher *concurrentDispatcher) work(lane <-chan dispatchTask) {
+	defer dispatcher.workersDone.Done()
+	for task := range lane {
+		if dispatcher.awaitResumed() {
+			task.handle()
+		} else {
+			dispatcher.discard(task)
+		}
+		atomic.AddInt64(&dispatcher.inFlight, -1)
+		if dispatcher.onComplete != nil {
+			dispatcher.onComplete()
+		}
+	}
+}
+
+// shutdown stops accepting messages and blocks until the workers have handled, or discarded
+// if interrupted, all queued messages. It must not be called concurrently with dispatch.
+// This function is idempotent and may be called on a nil dispatcher.
+func (dispatcher *concurrentDispatcher) shutdown() {
+	if dispatcher == nil {
+		return
+	}
+	dispatcher.shutdownOnce.Do(func() {
+		for _, lane := range dispatcher.lanes {
+			close(lane)
+		}
+	})
+	dispatcher.workersDone.Wait()
+}
+
+// inFlightCount returns the number of dispatched messages not yet handled.
+// Returns 0 on a nil dispatcher.
+func (dispatcher *concurrentDispatcher) inFlightCount() int {
+	if dispatcher == nil {
+		return 0
+	}
+	return int(atomic.LoadInt64(&dispatcher.inFlight))
+}
+
+// discardedCount returns the number of dispatched messages that were discarded when interrupted.
+// Returns 0 on a nil dispatcher.
+func (dispatcher *concurrentDispatcher) discardedCount() uint64 {
+	if dispatcher == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&dispatcher.discarded)
+}
+
+// concurrentDispatchProperties validates the concurrent dispatch receiver properties, returning
+// the configured number of workers and ordering policy. A single worker disables concurrent dispatch.
+func concurrentDispatchProperties(properties map[config.ReceiverProperty]interface{}) (workers int, ordering config.OrderingPolicy, err error) {
+	workers = 1
+	ordering = config.OrderingPolicyUnordered
+	if workersInterface, ok := properties[config.ReceiverPropertyConcurrentDispatchWorkers]; ok {
+		prop, present, err := validation.IntegerPropertyValidationWithRange(string(config.ReceiverPropertyConcurrentDispatchWorkers), workersInterface, 1, math.MaxInt32)
+		if present {
+			if err != nil {
+				return 0, "", err
+			}
+			workers = prop
+		}
+	}
+	if orderingInterface, ok := properties[config.ReceiverPropertyConcurrentDispatchOrdering]; ok {
+		if orderingAsOrderingPolicy, ok := orderingInterface.(config.OrderingPolicy); ok {
+			orderingInterface = string(orderingAsOrderingPolicy)
+		}
+		prop, present, err := validation.StringPropertyValidation(string(config.ReceiverPropertyConcurrentDispatchOrdering), orderingInterface,
+			string(config.OrderingPolicyUnordered), string(config.OrderingPolicyPerTopic), string(config.OrderingPolicyPerPartitionKey))
+		if present {
+			if err != nil {
+				return 0, "", err
+			}
+			ordering = config.OrderingPolicy(prop)
+		}
+	}
+	return workers, ordering, nil
+}
@@ -0,0 +1,313 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/message/sdt"
+)
+
+// dispatchTestMessage overrides the accessors used to derive ordering keys
+type dispatchTestMessage struct {
+	message.InboundMessage
+	destination  string
+	partitionKey string
+}
+
+func (msg *dispatchTestMessage) GetDestinationName() string {
+	return msg.destination
+}
+
+func (msg *dispatchTestMessage) GetProperty(propertyName string) (sdt.Data, bool) {
+	if propertyName == config.QueuePartitionKey && msg.partitionKey != "" {
+		return msg.partitionKey, true
+	}
+	return nil, false
+}
+
+func TestConcurrentDispatcherPreservesOrderPerKey(t *testing.T) {
+	cases := []struct {
+		ordering config.OrderingPolicy
+		message  func(key string) message.InboundMessage
+	}{
+		{config.OrderingPolicyPerTopic, func(key string) message.InboundMessage {
+			return &dispatchTestMessage{destination: key}
+		}},
+		{config.OrderingPolicyPerPartitionKey, func(key string) message.InboundMessage {
+			return &dispatchTestMessage{destination: "some/topic", partitionKey: key}
+		}},
+	}
+	for _, testCase := range cases {
+		t.Run(string(testCase.ordering), func(t *testing.T) {
+			const keys = 5
+			const messagesPerKey = 50
+			interrupt := make(chan struct{})
+			dispatcher := newConcurrentDispatcher(4, testCase.ordering, interrupt, nil)
+			dispatcher.start()
+			var lock sync.Mutex
+			handled := make(map[string][]int)
+			for i := 0; i < messagesPerKey; i++ {
+				for k := 0; k < keys; k++ {
+					key := fmt.Sprintf("key-%d", k)
+					sequence := i
+					dispatcher.dispatch(testCase.message(key), dispatchTask{handle: func() {
+						// encourage interleaving between workers
+						time.Sleep(time.Duration(sequence%3) * 100 * time.Microsecond)
+						lock.Lock()
+						defer lock.Unlock()
+						handled[key] = append(handled[key], sequence)
+					}})
+				}
+			}
+			dispatcher.shutdown()
+			if len(handled) != keys {
+				t.Fatalf("expected messages to be handled for %d keys, got %d", keys, len(handled))
+			}
+			for key, sequences := range handled {
+				if len(sequences) != messagesPerKey {
+					t.Errorf("expected %d messages to be handled for key %s, got %d", messagesPerKey, key, len(sequences))
+				}
+				for i, sequence := range sequences {
+					if sequence != i {
+						t.Errorf("expected message %d for key %s to be handled in order, got %d", i, key, sequence)
+						break
+					}
+				}
+			}
+			if dispatcher.inFlightCount() != 0 {
+				t.Errorf("expected no messages in flight after shutdown, got %d", dispatcher.inFlightCount())
+			}
+		})
+	}
+}
+
+func TestConcurrentDispatcherKeyFunction(t *testing.T) {
+	dispatcher := newConcurrentDispatcher(4, config.OrderingPolicyPerPartitionKey, make(chan struct{}), nil)
+	dispatcher.setKeyFunction(func(msg message.InboundMessage) string {
+		if msg.GetDestinationName() == "panic" {
+			panic("no key")
+		}
+		// key by the first level of the topic, ignoring the partition key
+		return strings.SplitN(msg.GetDestinationName(), "/", 2)[0]
+	}, logging.Default)
+	if key := dispatcher.orderingKey(&dispatchTestMessage{destination: "orders/created", partitionKey: "abc"}); key != "orders" {
+		t.Errorf("expected key from key function, got '%s'", key)
+	}
+	if key := dispatcher.orderingKey(&dispatchTestMessage{destination: "panic", partitionKey: "abc"}); key != "" {
+		t.Errorf("expected empty key when key function panics, got '%s'", key)
+	}
+	dispatcher.start()
+	var lock sync.Mutex
+	var handled []string
+	for i := 0; i < 20; i++ {
+		topic := fmt.Sprintf("orders/%d", i)
+		dispatcher.dispatch(&dispatchTestMessage{destination: topic}, dispatchTask{handle: func() {
+			lock.Lock()
+			defer lock.Unlock()
+			handled = append(handled, topic)
+		}})
+	}
+	dispatcher.shutdown()
+	if len(handled) != 20 {
+		t.Fatalf("expected 20 handled messages, got %d", len(handled))
+	}
+	for i, topic := range handled {
+		if expected := fmt.Sprintf("orders/%d", i); topic != expected {
+			t.Errorf("expected messages with the same key to be handled in order, got %s at %d", topic, i)
+		}
+	}
+}
+
+func TestConcurrentDispatcherUnorderedHandlesConcurrently(t *testing.T) {
+	const workers = 4
+	dispatcher := newConcurrentDispatcher(workers, config.OrderingPolicyUnordered, make(chan struct{}), nil)
+	dispatcher.start()
+	defer dispatcher.shutdown()
+	var started sync.WaitGroup
+	started.Add(workers)
+	release := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		dispatcher.dispatch(&dispatchTestMessage{destination: "some/topic"}, dispatchTask{handle: func() {
+			started.Done()
+			<-release
+		}})
+	}
+	allStarted := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(allStarted)
+	}()
+	select {
+	case <-allStarted:
+		// success
+	case <-time.After(5 * time.Second):
+		t.Error("timed out waiting for all workers to handle a message concurrently")
+	}
+	if dispatcher.inFlightCount() != workers {
+		t.Errorf("expected %d messages in flight, got %d", workers, dispatcher.inFlightCount())
+	}
+	close(release)
+}
+
+func TestConcurrentDispatcherInterruptDiscardsQueuedMessages(t *testing.T) {
+	interrupt := make(chan struct{})
+	completed := make(chan struct{}, 10)
+	dispatcher := newConcurrentDispatcher(2, config.OrderingPolicyPerTopic, interrupt, func() {
+		completed <- struct{}{}
+	})
+	dispatcher.start()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	handledCount := 0
+	discardedCount := 0
+	// all messages share a topic and are therefore queued behind the first, blocking message
+	dispatcher.dispatch(&dispatchTestMessage{destination: "some/topic"}, dispatchTask{handle: func() {
+		close(started)
+		<-release
+		handledCount++
+	}})
+	<-started
+	for i := 0; i < 3; i++ {
+		dispatcher.dispatch(&dispatchTestMessage{destination: "some/topic"}, dispatchTask{
+			handle: func() {
+				handledCount++
+			},
+			discard: func() {
+				discardedCount++
+			},
+		})
+	}
+	if dispatcher.inFlightCount() != 4 {
+		t.Errorf("expected 4 messages in flight, got %d", dispatcher.inFlightCount())
+	}
+	close(interrupt)
+	close(release)
+	dispatcher.shutdown()
+	if handledCount != 1 {
+		t.Errorf("expected the in-progress message to be handled, got %d handled messages", handledCount)
+	}
+	if discardedCount != 3 || dispatcher.discardedCount() != 3 {
+		t.Errorf("expected 3 discarded messages, got %d and a count of %d", discardedCount, dispatcher.discardedCount())
+	}
+	if len(completed) != 4 {
+		t.Errorf("expected completion to be notified for 4 messages, got %d", len(completed))
+	}
+	if dispatcher.inFlightCount() != 0 {
+		t.Errorf("expected no messages in flight after shutdown, got %d", dispatcher.inFlightCount())
+	}
+}
+
+func TestConcurrentDispatcherNil(t *testing.T) {
+	var dispatcher *concurrentDispatcher
+	dispatcher.shutdown()
+	if dispatcher.inFlightCount() != 0 {
+		t.Errorf("expected nil dispatcher to have no messages in flight, got %d", dispatcher.inFlightCount())
+	}
+	if dispatcher.discardedCount() != 0 {
+		t.Errorf("expected nil dispatcher to have no discarded messages, got %d", dispatcher.discardedCount())
+	}
+}
+
+func TestConcurrentDispatchProperties(t *testing.T) {
+	workers, ordering, err := concurrentDispatchProperties(map[config.ReceiverProperty]interface{}{})
+	if err != nil || workers != 1 || ordering != config.OrderingPolicyUnordered {
+		t.Errorf("expected defaults of 1 worker and unordered dispatch, got %d, %s and %v", workers, ordering, err)
+	}
+	workers, ordering, err = concurrentDispatchProperties(map[config.ReceiverProperty]interface{}{
+		config.ReceiverPropertyConcurrentDispatchWorkers:  uint(8),
+		config.ReceiverPropertyConcurrentDispatchOrdering: config.OrderingPolicyPerPartitionKey,
+	})
+	if err != nil || workers != 8 || ordering != config.OrderingPolicyPerPartitionKey {
+		t.Errorf("expected 8 workers and per partition key dispatch, got %d, %s and %v", workers, ordering, err)
+	}
+	_, ordering, err = concurrentDispatchProperties(map[config.ReceiverProperty]interface{}{
+		config.ReceiverPropertyConcurrentDispatchOrdering: "PER_TOPIC",
+	})
+	if err != nil || ordering != config.OrderingPolicyPerTopic {
+		t.Errorf("expected per topic dispatch from string property, got %s and %v", ordering, err)
+	}
+	invalid := []config.ReceiverPropertyMap{
+		{config.ReceiverPropertyConcurrentDispatchWorkers: 0},
+		{config.ReceiverPropertyConcurrentDispatchWorkers: "many"},
+		{config.ReceiverPropertyConcurrentDispatchOrdering: "PER_QUEUE"},
+	}
+	for _, properties := range invalid {
+		if _, _, err := concurrentDispatchProperties(properties); err == nil {
+			t.Errorf("expected error for properties %v", properties)
+		} else if _, ok := err.(*solace.IllegalArgumentError); !ok {
+			t.Errorf("expected IllegalArgumentError for properties %v, got %T", properties, err)
+		}
+	}
+}
+
+func TestConcurrentDispatcherPauseHoldsQueuedMessages(t *testing.T) {
+	dispatcher := newConcurrentDispatcher(2, config.OrderingPolicyUnordered, make(chan struct{}), nil)
+	dispatcher.pause()
+	dispatcher.start()
+	handled := make(chan struct{}, 4)
+	for i := 0; i < 4; i++ {
+		dispatcher.dispatch(&dispatchTestMessage{destination: "some/topic"}, dispatchTask{handle: func() {
+			handled <- struct{}{}
+		}})
+	}
+	select {
+	case <-handled:
+		t.Fatal("expected no message to be handled while paused")
+	case <-time.After(10 * time.Millisecond):
+	}
+	dispatcher.resume()
+	for i := 0; i < 4; i++ {
+		select {
+		case <-handled:
+		case <-time.After(time.Second):
+			t.Fatalf("expected all messages to be handled once resumed, got %d", i)
+		}
+	}
+	dispatcher.shutdown()
+}
+
+func TestConcurrentDispatcherInterruptedDispatchIsDiscarded(t *testing.T) {
+	interrupt := make(chan struct{})
+	dispatcher := newConcurrentDispatcher(1, config.OrderingPolicyUnordered, interrupt, nil)
+	// without started workers the lane fills up, blocking the next dispatch until interrupted
+	for i := 0; i < concurrentDispatchLaneCapacity; i++ {
+		dispatcher.dispatch(&dispatchTestMessage{destination: "some/topic"}, dispatchTask{handle: func() {}})
+	}
+	close(interrupt)
+	discarded := false
+	if dispatcher.dispatch(&dispatchTestMessage{destination: "some/topic"}, dispatchTask{
+		handle:  func() {},
+		discard: func() { discarded = true },
+	}) {
+		t.Error("expected dispatch to fail once interrupted")
+	}
+	if !discarded || dispatcher.discardedCount() != 1 {
+		t.Errorf("expected the interrupted message to be discarded and counted, got a count of %d", dispatcher.discardedCount())
+	}
+	if dispatcher.inFlightCount() != concurrentDispatchLaneCapacity {
+		t.Errorf("expected %d messages in flight, got %d", concurrentDispatchLaneCapacity, dispatcher.inFlightCount())
+	}
+}
@@ -17,6 +17,7 @@
 package receiver
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"runtime/debug"
@@ -34,6 +35,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
@@ -57,12 +59,15 @@ const (
 
 type directMessageReceiverImpl struct {
 	basicMessageReceiver
+	payloadDecoder
 
 	logger logging.LogLevelLogger
 
 	subscriptionsLock           sync.Mutex
 	subscriptionTerminationLock sync.RWMutex
 	subscriptions               []string
+	// routes are the routes added with AddRoute keyed by topic pattern, guarded by the subscriptions lock
+	routes map[string]*topicRoute
 	// we want to synchronize calls to subscribe/unsubscribe to avoid crashing due to thread limitations
 	subscriptionsSynchronizationLock sync.Mutex
 
@@ -81,6 +86,9 @@ type directMessageReceiverImpl struct {
 	rxCallback    unsafe.Pointer
 	isDiscard     int32
 
+	// dispatcher calls the message handler concurrently, nil when messages are handled by the receiver goroutine
+	dispatcher *concurrentDispatcher
+
 	dispatch uintptr
 
 	terminationHandlerID uint
@@ -114,6 +122,8 @@ type directMessageReceiverImpl struct {
 type directInboundMessage struct {
 	pointer ccsmp.SolClientMessagePt
 	discard bool
+	// route is the route the message was dispatched to, nil if dispatched to the receiver's subscriptions
+	route *topicRoute
 }
 
 type directMessageReceiverProps struct {
@@ -122,16 +132,21 @@ type directMessageReceiverProps struct {
 	backpressureStrategy   receiverBackpressureStrategy
 	backpressureBufferSize int
 	shareName              *resource.ShareName
+	codecs                 []solace.Codec
+	dispatchWorkers        int
+	dispatchOrdering       config.OrderingPolicy
 }
 
 func (receiver *directMessageReceiverImpl) construct(props *directMessageReceiverProps) {
 	receiver.basicMessageReceiver.construct(props.internalReceiver)
+	receiver.payloadDecoder = newPayloadDecoder(props.codecs)
 	receiver.shareName = props.shareName
 	receiver.subscriptions = make([]string, len(props.startupSubscriptions))
 	for i, subscription := range props.startupSubscriptions {
 		receiver.subscriptions[i] = receiver.buildSubscription(subscription)
 	}
 	receiver.buffer = make(chan *directInboundMessage, props.backpressureBufferSize)
+	receiver.routes = make(map[string]*topicRoute)
 	receiver.bufferClosed = 0
 	receiver.backpressureStrategy = props.backpressureStrategy
 	receiver.isDiscard = 0
@@ -142,7 +157,11 @@ func (receiver *directMessageReceiverImpl) construct(props *directMessageReceive
 	receiver.bufferEmptyOnTerminateFlag = 0
 	receiver.bufferEmptyOnTerminate = make(chan struct{})
 
-	receiver.logger = logging.For(receiver)
+	if props.dispatchWorkers > 1 {
+		receiver.dispatcher = newConcurrentDispatcher(props.dispatchWorkers, props.dispatchOrdering, receiver.terminationNotification, nil)
+	}
+
+	receiver.logger = receiver.internalReceiver.Logger().For(receiver)
 
 	atomic.StorePointer(&receiver.rxCallback, nil)
 	receiver.rxCallbackSet = make(chan bool, 1)
@@ -257,7 +276,17 @@ func (receiver *directMessageReceiverImpl) StartAsyncCallback(callback func(sola
 // unfinished tasks or in-flight messages.
 // This function blocks until the service is terminated.
 // If gracePeriod is less than 0, the function will wait indefinitely.
-func (receiver *directMessageReceiverImpl) Terminate(gracePeriod time.Duration) (err error) {
+func (receiver *directMessageReceiverImpl) Terminate(gracePeriod time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+	return receiver.TerminateWithContext(ctx)
+}
+
+// TerminateWithContext will terminate the service gracefully and synchronously.
+// A graceful shutdown will be attempted until the given context is done, at which point
+// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+// This function blocks until the service is terminated.
+func (receiver *directMessageReceiverImpl) TerminateWithContext(ctx context.Context) (err error) {
 	if proceed, err := receiver.basicMessageReceiver.terminate(); !proceed {
 		return err
 	}
@@ -273,8 +302,9 @@ func (receiver *directMessageReceiverImpl) Terminate(gracePeriod time.Duration)
 	}()
 	// On an ungraceful termination, we want to skip subscription removal
 	receiver.cleanupSubscriptions()
-	// Remove the dispatch callback from the internal receiver
+	// Remove the dispatch callbacks from the internal receiver
 	receiver.internalReceiver.UnregisterRXCallback(receiver.dispatch)
+	receiver.unregisterRoutes()
 	// Remove the termination event handler
 	receiver.internalReceiver.Events().RemoveEventHandler(receiver.terminationHandlerID)
 
@@ -310,31 +340,55 @@ func (receiver *directMessageReceiverImpl) Terminate(gracePeriod time.Duration)
 	}
 
 	// Wait for the message receiver goroutine to shutdown. It may not shut down if the message handler is blocking indefinitely
-	timer := time.NewTimer(gracePeriod)
 	select {
-	case <-timer.C:
+	case <-ctx.Done():
 		// timed out waiting for messages to be delivered
 		close(receiver.terminationNotification)
 		// join receiver thread
 		<-receiver.terminationComplete
 		undeliveredCount := receiver.drainQueue()
+		if undeliveredCount > 0 {
+			receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
+		}
+		// messages discarded by the dispatcher are accounted for in the metrics when discarded
+		undeliveredCount += receiver.dispatcher.discardedCount()
 		// we may have terminated on the last message, in which case we were successful.
 		if undeliveredCount > 0 {
 			if receiver.logger.IsDebugEnabled() {
 				receiver.logger.Debug(fmt.Sprintf("Receiver terminated with %d undelivered messages", undeliveredCount))
 			}
-			err := solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
-			receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
-			return err
+			return solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
 		}
 	case <-receiver.bufferEmptyOnTerminate:
 		// successfully drained buffer
-		timer.Stop()
-		// join receiver thread. we want to make sure that if we enter with 0 messages in the buffer but one message
-		// is still being processed by the async callback, we will not terminate until that message callback is complete
-		<-receiver.terminationComplete
+		if receiver.dispatcher == nil {
+			// join receiver thread. we want to make sure that if we enter with 0 messages in the buffer but one message
+			// is still being processed by the async callback, we will not terminate until that message callback is complete
+			<-receiver.terminationComplete
+		} else {
+			err = receiver.awaitDispatchedMessages(ctx)
+		}
 	}
 	receiver.teardownCache()
+	return err
+}
+
+// awaitDispatchedMessages joins the receiver thread, which completes once the dispatched messages are handled,
+// until the given context is done. Returns an IncompleteMessageDeliveryError if messages were discarded.
+func (receiver *directMessageReceiverImpl) awaitDispatchedMessages(ctx context.Context) error {
+	select {
+	case <-receiver.terminationComplete:
+	case <-ctx.Done():
+		// timed out waiting for dispatched messages to be handled, discard those not yet handled
+		close(receiver.terminationNotification)
+		<-receiver.terminationComplete
+	}
+	if undeliveredCount := receiver.dispatcher.discardedCount(); undeliveredCount > 0 {
+		if receiver.logger.IsDebugEnabled() {
+			receiver.logger.Debug(fmt.Sprintf("Receiver terminated with %d undelivered messages", undeliveredCount))
+		}
+		return solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
+	}
 	return nil
 }
 
@@ -346,8 +400,9 @@ func (receiver *directMessageReceiverImpl) unsolicitedTermination(eventInfo core
 	receiver.logger.Debug("Received unsolicited termination with event info " + eventInfo.GetInfoString())
 	defer receiver.logger.Debug("Unsolicited termination complete")
 	timestamp := time.Now()
-	// Remove the dispatch callback from the internal receiver in case we still get any messages
+	// Remove the dispatch callbacks from the internal receiver in case we still get any messages
 	receiver.internalReceiver.UnregisterRXCallback(receiver.dispatch)
+	receiver.unregisterRoutes()
 	// Remove the event handler
 	receiver.internalReceiver.Events().RemoveEventHandler(receiver.terminationHandlerID)
 
@@ -373,7 +428,7 @@ func (receiver *directMessageReceiverImpl) unsolicitedTermination(eventInfo core
 			receiver.logger.Debug(fmt.Sprintf("Terminated with %d undelivered messages", undeliveredCount))
 		}
 		err = solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
-		receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
+		receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
 	}
 	// notify of termination with error, this will be retrievable with subsequent calls to "Terminate"
 	receiver.terminated(err)
@@ -419,6 +474,7 @@ func (receiver *directMessageReceiverImpl) cleanupSubscriptions() {
 			}
 		}
 	}
+	receiver.cleanupRoutes()
 }
 
 // drainQueue will drain out all remaining messages in the receiver buffer and will return the
@@ -702,6 +758,16 @@ func (receiver *directMessageReceiverImpl) RemoveSubscriptionAsync(subscription
 }
 
 func (receiver *directMessageReceiverImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, error) {
+	return receiver.receiveMessage(context.Background(), timeout)
+}
+
+// ReceiveMessageWithContext receives a message synchronously from the receiver, waiting
+// until a message is available or the given context is done.
+func (receiver *directMessageReceiverImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, error) {
+	return receiver.receiveMessage(ctx, -1)
+}
+
+func (receiver *directMessageReceiverImpl) receiveMessage(ctx context.Context, timeout time.Duration) (apimessage.InboundMessage, error) {
 	state := receiver.getState()
 	if state == messageReceiverStateNotStarted || state == messageReceiverStateStarting {
 		return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveNotStarted, nil)
@@ -714,25 +780,23 @@ func (receiver *directMessageReceiverImpl) ReceiveMessage(timeout time.Duration)
 		}
 	}()
 	var msg *directInboundMessage
+	var inboundMessage *message.InboundMessageImpl
 	var ok bool
+	var timeoutChan <-chan time.Time
 	if timeout >= 0 {
 		timer := time.NewTimer(timeout)
-		select {
-		case msg, ok = <-receiver.buffer:
-			timer.Stop()
-		case <-timer.C:
-			return nil, solace.NewError(&solace.TimeoutError{}, constants.ReceiverTimedOutWaitingForMessage, nil)
-		case <-receiver.bufferEmptyOnTerminate:
-			timer.Stop()
-			goto terminated
-		}
-	} else {
-		select {
-		case msg, ok = <-receiver.buffer:
-			// success
-		case <-receiver.bufferEmptyOnTerminate:
-			goto terminated
-		}
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+	select {
+	case msg, ok = <-receiver.buffer:
+		// success
+	case <-timeoutChan:
+		return nil, solace.NewError(&solace.TimeoutError{}, constants.ReceiverTimedOutWaitingForMessage, nil)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-receiver.bufferEmptyOnTerminate:
+		goto terminated
 	}
 	if !ok {
 		goto terminated
@@ -746,9 +810,11 @@ func (receiver *directMessageReceiverImpl) ReceiveMessage(timeout time.Duration)
 		msg.discard = atomic.CompareAndSwapInt32(&receiver.isDiscard, discardTrue, discardFalse)
 	}
 	if msg.discard {
-		receiver.internalReceiver.IncrementMetric(core.MetricInternalDiscardNotifications, 1)
+		receiver.incrementMetric(core.MetricInternalDiscardNotifications, 1)
 	}
-	return message.NewInboundMessage(msg.pointer, msg.discard), nil
+	inboundMessage = message.NewInboundMessage(msg.pointer, msg.discard)
+	receiver.recordReceive(inboundMessage)
+	return inboundMessage, nil
 terminated:
 	return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveAlreadyTerminated, nil)
 }
@@ -778,12 +844,17 @@ func (receiver *directMessageReceiverImpl) ReceiveAsync(callback solace.MessageH
 	return nil
 }
 
-func (receiver *directMessageReceiverImpl) messageCallback(msg core.Receivable) (ret bool) {
+func (receiver *directMessageReceiverImpl) messageCallback(msg core.Receivable) bool {
+	return receiver.bufferMessage(msg, nil)
+}
+
+// bufferMessage pushes a message dispatched to the given route, or to the receiver's subscriptions if nil, to the receiver buffer
+func (receiver *directMessageReceiverImpl) bufferMessage(msg core.Receivable, route *topicRoute) (ret bool) {
 	currentState := receiver.getState()
 	if currentState == messageReceiverStateTerminating || currentState == messageReceiverStateTerminated {
 		// we should not be handling this message
 		receiver.logger.Debug("received message after receiver was terminated, dropping message")
-		receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
+		receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
 		return false
 	}
 	defer func() {
@@ -791,7 +862,7 @@ func (receiver *directMessageReceiverImpl) messageCallback(msg core.Receivable)
 			// we may have a race where the receiver buffer is closed before this function is called if unsubscribes are slow
 			if err, ok := r.(error); ok && err.Error() == "send on closed channel" {
 				receiver.logger.Debug("Caught a channel closed panic when trying to write to the message buffer, receiver must be terminated.")
-				receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
+				receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
 			} else {
 				// this shouldn't ever happen, but panics are unpredictable. We want this message to make it into the logs
 				receiver.logger.Error(fmt.Sprintf("Caught panic in message callback! %s\n%s", err, string(debug.Stack())))
@@ -805,7 +876,7 @@ func (receiver *directMessageReceiverImpl) messageCallback(msg core.Receivable)
 		setDiscard = atomic.CompareAndSwapInt32(&receiver.isDiscard, discardTrue, discardFalse)
 	}
 	// push a new message to the receiver buffer
-	toPush := &directInboundMessage{msg, setDiscard}
+	toPush := &directInboundMessage{msg, setDiscard, route}
 	select {
 	case receiver.buffer <- toPush:
 		// success
@@ -836,7 +907,7 @@ func (receiver *directMessageReceiverImpl) messageCallback(msg core.Receivable)
 		}
 		if discard {
 			// increment stats
-			receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesBackpressureDiscarded, uint64(1))
+			receiver.incrementMetric(core.MetricReceivedMessagesBackpressureDiscarded, uint64(1))
 			// keep the message (true) if we have buffered it (ie. backpressure strategy is drop oldest)
 			// otherwise, we use a small optimization where we return false indicating to CCSMP that the message can be freed
 			return receiver.backpressureStrategy == strategyDropOldest
@@ -848,6 +919,8 @@ func (receiver *directMessageReceiverImpl) messageCallback(msg core.Receivable)
 func (receiver *directMessageReceiverImpl) run() {
 	// When the function returns, notify of completion
 	defer close(receiver.terminationComplete)
+	// Before notifying of completion, wait for the dispatched messages to be handled
+	defer receiver.dispatcher.shutdown()
 	// Block until an rx callback is set
 	cont := <-receiver.rxCallbackSet
 	// We will send false on the rxCallbackSet channel when we are terminating, indicating that we should shut down
@@ -855,6 +928,9 @@ func (receiver *directMessageReceiverImpl) run() {
 	if !cont {
 		return
 	}
+	if receiver.dispatcher != nil {
+		receiver.dispatcher.start()
+	}
 	for {
 		// First thing we do in the loop is check if we are terminated.
 		// We must do this first as a select statement will arbitrarily choose a path if both
@@ -880,18 +956,34 @@ func (receiver *directMessageReceiverImpl) run() {
 					received.discard = atomic.CompareAndSwapInt32(&receiver.isDiscard, discardTrue, discardFalse)
 				}
 				if received.discard {
-					receiver.internalReceiver.IncrementMetric(core.MetricInternalDiscardNotifications, 1)
+					receiver.incrementMetric(core.MetricInternalDiscardNotifications, 1)
 				}
 				msg := message.NewInboundMessage(received.pointer, received.discard)
-				if callback != nil {
-					func() {
-						defer func() {
-							if r := recover(); r != nil {
-								receiver.logger.Warning("Message receiver callback paniced: " + fmt.Sprint(r))
-							}
-						}()
-						(*callback)(msg)
-					}()
+				receiver.recordReceive(msg)
+				var handler solace.MessageHandler
+				if received.route != nil {
+					handler = received.route.handle
+				} else if callback != nil {
+					handler = *callback
+				} else {
+					// the run loop was started by a route, there is no handler for messages matching no route
+					msg.Dispose()
+					receiver.metrics.increment(metrics.ReceiverMessagesUnroutedDiscarded, 1)
+					continue
+				}
+				if receiver.dispatcher == nil {
+					receiver.deliver(handler, msg)
+				} else if !receiver.dispatcher.dispatch(msg, dispatchTask{
+					handle: func() {
+						receiver.deliver(handler, msg)
+					},
+					discard: func() {
+						msg.Dispose()
+						receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, 1)
+					},
+				}) {
+					// we are being forced to terminate while awaiting a worker, the dispatcher discarded the message
+					return
 				}
 			} else {
 				// We must safely handle closing of receiver.bufferEmpty
@@ -908,6 +1000,16 @@ func (receiver *directMessageReceiverImpl) run() {
 	}
 }
 
+// deliver calls the given message handler with the given message, recovering from any panic
+func (receiver *directMessageReceiverImpl) deliver(callback solace.MessageHandler, msg apimessage.InboundMessage) {
+	defer func() {
+		if r := recover(); r != nil {
+			receiver.logger.Warning("Message receiver callback paniced: " + fmt.Sprint(r))
+		}
+	}()
+	callback(msg)
+}
+
 func (receiver *directMessageReceiverImpl) String() string {
 	return fmt.Sprintf("solace.DirectMessageReceiver at %p", receiver)
 }
@@ -1185,6 +1287,7 @@ type directMessageReceiverBuilderImpl struct {
 	internalReceiver core.Receiver
 	properties       map[config.ReceiverProperty]interface{}
 	subscriptions    []resource.Subscription
+	codecs           []solace.Codec
 }
 
 // NewDirectMessageReceiverBuilderImpl function
@@ -1234,6 +1337,11 @@ func (builder *directMessageReceiverBuilderImpl) BuildWithShareName(shareName *r
 		}
 	}
 
+	dispatchWorkers, dispatchOrdering, err := concurrentDispatchProperties(builder.properties)
+	if err != nil {
+		return nil, err
+	}
+
 	var receiverBackpressureStrategyEnum receiverBackpressureStrategy
 	switch receiverBackpressureStrategyString {
 	case config.ReceiverBackPressureStrategyDropLatest:
@@ -1250,6 +1358,9 @@ func (builder *directMessageReceiverBuilderImpl) BuildWithShareName(shareName *r
 			backpressureStrategy:   receiverBackpressureStrategyEnum,
 			backpressureBufferSize: receiverBackpressureBufferSize,
 			shareName:              shareName,
+			codecs:                 builder.codecs,
+			dispatchWorkers:        dispatchWorkers,
+			dispatchOrdering:       dispatchOrdering,
 		},
 	)
 
@@ -1263,6 +1374,12 @@ func (builder *directMessageReceiverBuilderImpl) WithSubscriptions(topics ...res
 	return builder
 }
 
+// WithCodec registers the given codec to decode message payloads with DecodePayload.
+func (builder *directMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.DirectMessageReceiverBuilder {
+	builder.codecs = append(builder.codecs, codec)
+	return builder
+}
+
 // FromConfigurationProvider will configure the direct receiver with the given properties.
 // Built in ReceiverPropertiesConfigurationProvider implementations include:
 //
@@ -1297,14 +1414,27 @@ func (builder *directMessageReceiverBuilderImpl) OnBackPressureDropOldest(buffer
 	})
 }
 
+// WithConcurrentDispatch will configure the receiver to call the message handler from the given
+// number of workers concurrently, preserving the order of messages as defined by the given ordering policy.
+// workers must be >= 1
+func (builder *directMessageReceiverBuilderImpl) WithConcurrentDispatch(workers uint, ordering config.OrderingPolicy) solace.DirectMessageReceiverBuilder {
+	return builder.FromConfigurationProvider(config.ReceiverPropertyMap{
+		config.ReceiverPropertyConcurrentDispatchWorkers:  workers,
+		config.ReceiverPropertyConcurrentDispatchOrdering: ordering,
+	})
+}
+
 func (builder *directMessageReceiverBuilderImpl) String() string {
 	return fmt.Sprintf("solace.DirectMessageReceiverBuilder at %p", builder)
 }
 
 // Validate the subscription type is one supported by
 func checkDirectMessageReceiverSubscriptionType(subscription resource.Subscription) error {
-	switch subscription.(type) {
+	switch topicSubscription := subscription.(type) {
 	case *resource.TopicSubscription:
+		if err := resource.ValidateTopicSubscription(topicSubscription.GetName()); err != nil {
+			return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+		}
 		return nil
 	}
 	return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.DirectReceiverUnsupportedSubscriptionType, subscription), nil)
@@ -28,6 +28,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
 	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 	"solace.dev/go/messaging/pkg/solace/subcode"
 )
@@ -816,6 +817,9 @@ func TestDirectReceiverBackpressureStrategyDropOldest(t *testing.T) {
 	if !metricsIncremented {
 		t.Error("metrics not incremented when message was dropped")
 	}
+	if discarded := receiver.Metrics().GetValue(metrics.ReceiverMessagesBackpressureDiscarded); discarded != 1 {
+		t.Errorf("expected receiver metrics to count 1 message dropped, got %d", discarded)
+	}
 }
 
 func TestDirectReceiverBackpressureStrategyDropNewest(t *testing.T) {
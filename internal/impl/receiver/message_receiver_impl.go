@@ -53,6 +53,8 @@ type basicMessageReceiver struct {
 	terminationListener solace.TerminationNotificationListener
 
 	startFuture, terminateFuture future.FutureError
+
+	metrics receiverMetricsImpl
 }
 
 func (receiver *basicMessageReceiver) construct(internalReceiver core.Receiver) {
@@ -26,8 +26,10 @@ import (
 
 	"solace.dev/go/messaging/internal/ccsmp"
 	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 	"solace.dev/go/messaging/pkg/solace/subcode"
 )
@@ -514,6 +516,7 @@ type mockInternalReceiver struct {
 	subscribe                            func(topic string, ptr uintptr) (core.SubscriptionCorrelationID, <-chan core.SubscriptionEvent, core.ErrorInfo)
 	unsubscribe                          func(topic string, ptr uintptr) (core.SubscriptionCorrelationID, <-chan core.SubscriptionEvent, core.ErrorInfo)
 	incrementMetric                      func(metric core.NextGenMetric, amount uint64)
+	recordLatency                        func(metric metrics.LatencyMetric, latency time.Duration)
 	incrementDuplicateAckCount           func()
 	newPersistentReceiver                func(props []string, callback core.RxCallback, eventCallback core.PersistentEventCallback) (core.PersistentReceiver, *ccsmp.SolClientErrorInfoWrapper)
 	processCacheResponseFunc             func(*mockInternalReceiver, *sync.Map, core.CoreCacheEventInfo)
@@ -574,6 +577,10 @@ func (mock *mockInternalReceiver) CleanupCacheRequestSubscriptions(cacheRequest
 	return nil
 }
 
+func (mock *mockInternalReceiver) Logger() logging.LogLevelLogger {
+	return logging.Default
+}
+
 func (mock *mockInternalReceiver) Events() core.Events {
 	if mock.events != nil {
 		return mock.events()
@@ -634,6 +641,10 @@ func (mock *mockInternalReceiver) ProvisionEndpoint(name string, exclusive bool)
 	return nil
 }
 
+func (mock *mockInternalReceiver) ProvisionTopicEndpoint(name string) *ccsmp.SolClientErrorInfoWrapper {
+	return nil
+}
+
 func (mock *mockInternalReceiver) EndpointUnsubscribe(queueName string, topic string) (core.SubscriptionCorrelationID, <-chan core.SubscriptionEvent, core.ErrorInfo) {
 	return 0, nil, nil
 }
@@ -644,6 +655,12 @@ func (mock *mockInternalReceiver) IncrementMetric(metric core.NextGenMetric, amo
 	}
 }
 
+func (mock *mockInternalReceiver) RecordLatency(metric metrics.LatencyMetric, latency time.Duration) {
+	if mock.recordLatency != nil {
+		mock.recordLatency(metric, latency)
+	}
+}
+
 func (mock *mockInternalReceiver) IncrementDuplicateAckCount() {
 	if mock.incrementDuplicateAckCount != nil {
 		mock.incrementDuplicateAckCount()
@@ -728,6 +745,10 @@ func (mock *mockPersistentReceiver) Destination() (destination string, durable b
 	return
 }
 
+func (mock *mockPersistentReceiver) FlowID() uint64 {
+	return 0
+}
+
 type mockEvents struct {
 }
 
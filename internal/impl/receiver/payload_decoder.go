@@ -0,0 +1,49 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/codec"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+)
+
+// payloadDecoder implements solace.PayloadDecoder with the codecs registered on a receiver
+// builder, falling back to the default codec registry when none of them match a message.
+type payloadDecoder struct {
+	codecs *codec.Registry
+}
+
+func newPayloadDecoder(codecs []solace.Codec) payloadDecoder {
+	if len(codecs) == 0 {
+		return payloadDecoder{}
+	}
+	return payloadDecoder{codecs: codec.NewRegistry(codecs...)}
+}
+
+// DecodePayload decodes the payload of the given message into value with the codec matching
+// the HTTP content type or application message type of the message.
+// Returns solace/errors.*IllegalArgumentError if no codec matches the message or the payload
+// cannot be decoded into value.
+func (decoder payloadDecoder) DecodePayload(msg apimessage.InboundMessage, value interface{}) error {
+	if decoder.codecs != nil {
+		if matched, ok := decoder.codecs.ForMessage(msg); ok {
+			return matched.Decode(msg, value)
+		}
+	}
+	return codec.Decode(msg, value)
+}
@@ -18,8 +18,11 @@
 package receiver
 
 import (
+	"context"
 	"fmt"
+	"math"
 	"runtime/debug"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -43,14 +46,17 @@ import (
 
 type persistentMessageReceiverImpl struct {
 	basicMessageReceiver
+	payloadDecoder
 
 	logger logging.LogLevelLogger
 
 	internalFlowStopped    int32
 	internalFlow           core.PersistentReceiver
 	internalFlowProperties []string
+	transactedSession      core.TransactedSession
 
 	queue                    *resource.Queue
+	topicEndpoint            *resource.TopicEndpoint
 	doCreateMissingResources bool
 
 	doAutoAck bool
@@ -84,6 +90,9 @@ type persistentMessageReceiverImpl struct {
 	rxCallbackSet chan bool
 	rxCallback    unsafe.Pointer
 
+	// dispatcher calls the message handler concurrently, nil when messages are handled by the receiver goroutine
+	dispatcher *concurrentDispatcher
+
 	terminationHandlerID uint
 
 	eventExecutor executor.Executor
@@ -92,17 +101,25 @@ type persistentMessageReceiverImpl struct {
 type persistentMessageReceiverProps struct {
 	flowProperties                     []string
 	internalReceiver                   core.Receiver
+	transactedSession                  core.TransactedSession
 	startupSubscriptions               []resource.Subscription
 	endpoint                           *resource.Queue
+	topicEndpoint                      *resource.TopicEndpoint
 	bufferHighwater, bufferLowwater    int
 	doCreateMissingResource, doAutoAck bool
 	stateChangeListener                solace.ReceiverStateChangeListener
+	codecs                             []solace.Codec
+	dispatchWorkers                    int
+	dispatchOrdering                   config.OrderingPolicy
+	dispatchKeyFunction                solace.MessageKeyFunction
 }
 
 func (receiver *persistentMessageReceiverImpl) construct(props *persistentMessageReceiverProps) {
 	receiver.basicMessageReceiver.construct(props.internalReceiver)
+	receiver.payloadDecoder = newPayloadDecoder(props.codecs)
 	receiver.eventExecutor = executor.NewExecutor()
 	receiver.internalFlowProperties = props.flowProperties
+	receiver.transactedSession = props.transactedSession
 
 	receiver.subscriptions = make([]string, len(props.startupSubscriptions))
 	for i, subscription := range props.startupSubscriptions {
@@ -120,9 +137,22 @@ func (receiver *persistentMessageReceiverImpl) construct(props *persistentMessag
 	receiver.terminationNotification = make(chan struct{})
 	receiver.terminationComplete = make(chan struct{})
 
-	receiver.logger = logging.For(receiver)
+	if props.dispatchWorkers > 1 {
+		receiver.dispatcher = newConcurrentDispatcher(props.dispatchWorkers, props.dispatchOrdering, receiver.terminationNotification, receiver.resumeFlowBelowLowwater)
+	}
+
+	receiver.logger = receiver.internalReceiver.Logger().For(receiver)
+	if props.endpoint != nil {
+		receiver.logger = receiver.logger.With(logging.Attribute{Key: logging.AttributeDestination, Value: props.endpoint.GetName()})
+	} else if props.topicEndpoint != nil {
+		receiver.logger = receiver.logger.With(logging.Attribute{Key: logging.AttributeDestination, Value: props.topicEndpoint.GetName()})
+	}
+	if receiver.dispatcher != nil && props.dispatchKeyFunction != nil {
+		receiver.dispatcher.setKeyFunction(props.dispatchKeyFunction, receiver.logger)
+	}
 
 	receiver.queue = props.endpoint
+	receiver.topicEndpoint = props.topicEndpoint
 	receiver.doCreateMissingResources = props.doCreateMissingResource
 	receiver.doAutoAck = props.doAutoAck
 
@@ -230,7 +260,12 @@ func (receiver *persistentMessageReceiverImpl) Start() (err error) {
 	}
 	receiver.terminationHandlerID = receiver.internalReceiver.Events().AddEventHandler(core.SolClientEventDown, receiver.onDownEvent)
 	var errInfoWrapper core.ErrorInfo
-	receiver.internalFlow, errInfoWrapper = receiver.internalReceiver.NewPersistentReceiver(receiver.internalFlowProperties, receiver.messageCallback, receiver.onFlowEvent)
+	if receiver.transactedSession != nil {
+		// messages received on a transacted flow are settled by the transaction
+		receiver.internalFlow, errInfoWrapper = receiver.transactedSession.NewPersistentReceiver(receiver.internalFlowProperties, receiver.messageCallback, receiver.onFlowEvent)
+	} else {
+		receiver.internalFlow, errInfoWrapper = receiver.internalReceiver.NewPersistentReceiver(receiver.internalFlowProperties, receiver.messageCallback, receiver.onFlowEvent)
+	}
 	if errInfoWrapper != nil {
 		return core.ToNativeError(errInfoWrapper, "error while creating receiver flow: ")
 	}
@@ -273,6 +308,9 @@ func (receiver *persistentMessageReceiverImpl) Start() (err error) {
 }
 
 func (receiver *persistentMessageReceiverImpl) provisionEndpoint() error {
+	if receiver.topicEndpoint != nil {
+		return receiver.provisionTopicEndpoint()
+	}
 	if receiver.doCreateMissingResources && receiver.queue.IsDurable() {
 		errInfo := receiver.internalReceiver.ProvisionEndpoint(receiver.queue.GetName(), receiver.queue.IsExclusivelyAccessible())
 		if errInfo != nil {
@@ -289,6 +327,23 @@ func (receiver *persistentMessageReceiverImpl) provisionEndpoint() error {
 	return nil
 }
 
+func (receiver *persistentMessageReceiverImpl) provisionTopicEndpoint() error {
+	if receiver.doCreateMissingResources && receiver.topicEndpoint.IsDurable() {
+		errInfo := receiver.internalReceiver.ProvisionTopicEndpoint(receiver.topicEndpoint.GetName())
+		if errInfo != nil {
+			if subcode.Code(errInfo.SubCode()) == subcode.EndpointAlreadyExists {
+				receiver.logger.Info("Topic endpoint '" + receiver.topicEndpoint.GetName() + "' already exists")
+			} else {
+				receiver.logger.Warning("Failed to provision topic endpoint '" + receiver.topicEndpoint.GetName() + "', " + errInfo.GetMessageAsString())
+				return core.ToNativeError(errInfo)
+			}
+		} else {
+			receiver.logger.Info("Topic endpoint '" + receiver.topicEndpoint.GetName() + "' provisioned successfully")
+		}
+	}
+	return nil
+}
+
 // StartAsync will start the service asynchronously.
 // Before this function is called, the service is considered
 // off-duty. To operate normally, this function must be called on
@@ -324,7 +379,17 @@ func (receiver *persistentMessageReceiverImpl) StartAsyncCallback(callback func(
 // unfinished tasks or in-flight messages.
 // This function blocks until the service is terminated.
 // If gracePeriod is less than 0, the function will wait indefinitely.
-func (receiver *persistentMessageReceiverImpl) Terminate(gracePeriod time.Duration) (err error) {
+func (receiver *persistentMessageReceiverImpl) Terminate(gracePeriod time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gracePeriod)
+	defer cancel()
+	return receiver.TerminateWithContext(ctx)
+}
+
+// TerminateWithContext will terminate the service gracefully and synchronously.
+// A graceful shutdown will be attempted until the given context is done, at which point
+// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+// This function blocks until the service is terminated.
+func (receiver *persistentMessageReceiverImpl) TerminateWithContext(ctx context.Context) (err error) {
 	if proceed, err := receiver.basicMessageReceiver.terminate(); !proceed {
 		return err
 	}
@@ -403,29 +468,54 @@ func (receiver *persistentMessageReceiverImpl) Terminate(gracePeriod time.Durati
 	}
 
 	// Wait for the message receiver goroutine to shutdown. It may not shut down if the message handler is blocking indefinitely
-	timer := time.NewTimer(gracePeriod)
 	select {
-	case <-timer.C:
+	case <-ctx.Done():
 		// timed out waiting for messages to be delivered
 		close(receiver.terminationNotification)
 		// join receiver thread
 		<-receiver.terminationComplete
 		undeliveredCount := receiver.drainQueue()
+		if undeliveredCount > 0 {
+			receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
+		}
+		// messages discarded by the dispatcher are accounted for in the metrics when discarded
+		undeliveredCount += receiver.dispatcher.discardedCount()
 		// we may have terminated on the last message, in which case we were successful.
 		if undeliveredCount > 0 {
 			if receiver.logger.IsDebugEnabled() {
 				receiver.logger.Debug(fmt.Sprintf("Receiver terminated with %d undelivered messages", undeliveredCount))
 			}
-			err := solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
-			receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
-			return err
+			return solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
 		}
 	case <-receiver.bufferEmptyOnTerminate:
-		timer.Stop()
-		// join receiver thread. we want to make sure that if we enter with 0 messages in the buffer but one message
-		// is still being processed by the async callback, we will not terminate until that message callback is complete
+		if receiver.dispatcher == nil {
+			// join receiver thread. we want to make sure that if we enter with 0 messages in the buffer but one message
+			// is still being processed by the async callback, we will not terminate until that message callback is complete
+			<-receiver.terminationComplete
+		} else {
+			return receiver.awaitDispatchedMessages(ctx)
+		}
+	}
+	return nil
+}
+
+// awaitDispatchedMessages joins the receiver thread, which completes once the dispatched messages are handled,
+// until the given context is done. Returns an IncompleteMessageDeliveryError if messages were discarded,
+// in which case they are not acknowledged and will be redelivered by the broker.
+func (receiver *persistentMessageReceiverImpl) awaitDispatchedMessages(ctx context.Context) error {
+	select {
+	case <-receiver.terminationComplete:
+	case <-ctx.Done():
+		// timed out waiting for dispatched messages to be handled, discard those not yet handled
+		close(receiver.terminationNotification)
 		<-receiver.terminationComplete
 	}
+	if undeliveredCount := receiver.dispatcher.discardedCount(); undeliveredCount > 0 {
+		if receiver.logger.IsDebugEnabled() {
+			receiver.logger.Debug(fmt.Sprintf("Receiver terminated with %d undelivered messages", undeliveredCount))
+		}
+		return solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
+	}
 	return nil
 }
 
@@ -465,7 +555,7 @@ func (receiver *persistentMessageReceiverImpl) unsolicitedTermination(eventInfo
 			receiver.logger.Debug(fmt.Sprintf("Terminated with %d undelivered messages", undeliveredCount))
 		}
 		err = solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, undeliveredCount), nil)
-		receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
+		receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(undeliveredCount))
 	}
 	// notify of termination with error, this will be retrievable with subsequent calls to "Terminate"
 	receiver.terminated(err)
@@ -540,6 +630,9 @@ func (receiver *persistentMessageReceiverImpl) AddSubscription(subscription reso
 	if err := checkPersistentMessageReceiverSubscriptionType(subscription); err != nil {
 		return err
 	}
+	if receiver.topicEndpoint != nil {
+		return solace.NewError(&solace.IllegalStateError{}, constants.PersistentReceiverTopicEndpointSubscriptions, nil)
+	}
 	result, err := receiver.addSubscription(subscription)
 	if err != nil {
 		return err
@@ -618,6 +711,9 @@ func (receiver *persistentMessageReceiverImpl) RemoveSubscription(subscription r
 	if err := checkPersistentMessageReceiverSubscriptionType(subscription); err != nil {
 		return err
 	}
+	if receiver.topicEndpoint != nil {
+		return solace.NewError(&solace.IllegalStateError{}, constants.PersistentReceiverTopicEndpointSubscriptions, nil)
+	}
 	result, err := receiver.removeSubscription(subscription)
 	if err != nil {
 		return err
@@ -729,6 +825,9 @@ func (receiver *persistentMessageReceiverImpl) AddSubscriptionAsync(subscription
 	if err := checkPersistentMessageReceiverSubscriptionType(subscription); err != nil {
 		return err
 	}
+	if receiver.topicEndpoint != nil {
+		return solace.NewError(&solace.IllegalStateError{}, constants.PersistentReceiverTopicEndpointSubscriptions, nil)
+	}
 	go func() {
 		result, err := receiver.addSubscription(subscription)
 		if listener != nil {
@@ -767,6 +866,9 @@ func (receiver *persistentMessageReceiverImpl) RemoveSubscriptionAsync(subscript
 	if err := checkPersistentMessageReceiverSubscriptionType(subscription); err != nil {
 		return err
 	}
+	if receiver.topicEndpoint != nil {
+		return solace.NewError(&solace.IllegalStateError{}, constants.PersistentReceiverTopicEndpointSubscriptions, nil)
+	}
 	go func() {
 		result, err := receiver.removeSubscription(subscription)
 		if listener != nil {
@@ -861,6 +963,10 @@ func (receiver *persistentMessageReceiverImpl) Settle(msg apimessage.InboundMess
 	if errInfo != nil {
 		return core.ToNativeError(errInfo)
 	}
+	// messages accepted on an auto-acking receiver were already counted when auto-acknowledged
+	if !receiver.doAutoAck || outcome != config.PersistentReceiverAcceptedOutcome {
+		receiver.recordSettle(outcome)
+	}
 	return nil
 }
 
@@ -882,6 +988,8 @@ func (receiver *persistentMessageReceiverImpl) Pause() error {
 
 	// tell the loop that on the next iteration message reception should be paused
 	atomic.StoreInt32(&receiver.doPause, 1)
+	// hold the messages already queued for concurrent dispatch
+	receiver.dispatcher.pause()
 
 	// queue a pause interrupt event
 	select {
@@ -909,6 +1017,8 @@ func (receiver *persistentMessageReceiverImpl) Resume() error {
 
 	// tell the receiver loop to not stop and check
 	atomic.StoreInt32(&receiver.doPause, 0)
+	// release the messages held for concurrent dispatch
+	receiver.dispatcher.resume()
 
 	// notify the unpause channel
 	select {
@@ -944,6 +1054,16 @@ func (receiver *persistentMessageReceiverImpl) ReceiveAsync(callback solace.Mess
 }
 
 func (receiver *persistentMessageReceiverImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, error) {
+	return receiver.receiveMessage(context.Background(), timeout)
+}
+
+// ReceiveMessageWithContext receives a message synchronously from the receiver, waiting
+// until a message is available or the given context is done.
+func (receiver *persistentMessageReceiverImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, error) {
+	return receiver.receiveMessage(ctx, -1)
+}
+
+func (receiver *persistentMessageReceiverImpl) receiveMessage(ctx context.Context, timeout time.Duration) (apimessage.InboundMessage, error) {
 	state := receiver.getState()
 	if state == messageReceiverStateNotStarted || state == messageReceiverStateStarting {
 		return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveNotStarted, nil)
@@ -954,34 +1074,29 @@ func (receiver *persistentMessageReceiverImpl) ReceiveMessage(timeout time.Durat
 	var msgID message.MessageID
 	var msgP ccsmp.SolClientMessagePt
 	var ok bool
+	var timeoutChan <-chan time.Time
 	if timeout >= 0 {
 		timer := time.NewTimer(timeout)
-		select {
-		case msgP, ok = <-receiver.buffer:
-			timer.Stop()
-		case <-timer.C:
-			return nil, solace.NewError(&solace.TimeoutError{}, constants.ReceiverTimedOutWaitingForMessage, nil)
-		case <-receiver.bufferEmptyOnTerminate:
-			timer.Stop()
-			goto terminated
-		}
-	} else {
-		select {
-		case msgP, ok = <-receiver.buffer:
-			// success
-		case <-receiver.bufferEmptyOnTerminate:
-			goto terminated
-		}
+		defer timer.Stop()
+		timeoutChan = timer.C
 	}
-	if !ok {
+	select {
+	case msgP, ok = <-receiver.buffer:
+		// success
+	case <-timeoutChan:
+		return nil, solace.NewError(&solace.TimeoutError{}, constants.ReceiverTimedOutWaitingForMessage, nil)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-receiver.bufferEmptyOnTerminate:
 		goto terminated
 	}
-	// reenable underlying flow if we are below lowwater AND we are not terminating/terminated
-	if len(receiver.buffer) <= receiver.lowwater && receiver.getState() == messageReceiverStateStarted {
-		receiver.startFlow()
+	if !ok {
+		goto terminated
 	}
+	receiver.resumeFlowBelowLowwater()
 	// Prepare message for delivery
 	msg = message.NewInboundMessage(msgP, false)
+	receiver.recordReceive(msg)
 	// Ack the message just prior to return
 	if receiver.doAutoAck {
 		msgID, ok = message.GetMessageID(msg)
@@ -993,6 +1108,7 @@ func (receiver *persistentMessageReceiverImpl) ReceiveMessage(timeout time.Durat
 			}
 			// Successful Auto-ack, increment the auto-ack duplicate counter
 			receiver.internalReceiver.IncrementDuplicateAckCount()
+			receiver.recordSettle(config.PersistentReceiverAcceptedOutcome)
 		} else {
 			receiver.logger.Error(fmt.Sprintf("Could not retrieve message ID from message %s", msg))
 		}
@@ -1012,6 +1128,19 @@ func (receiver *persistentMessageReceiverImpl) checkEmptyBufferAndNotify() bool
 	return false
 }
 
+// pendingMessages returns the number of messages received but not yet handled, including those
+// awaiting or being handled by a concurrent dispatch worker
+func (receiver *persistentMessageReceiverImpl) pendingMessages() int {
+	return len(receiver.buffer) + receiver.dispatcher.inFlightCount()
+}
+
+// resumeFlowBelowLowwater reenables the underlying flow if we are below lowwater AND we are not terminating/terminated
+func (receiver *persistentMessageReceiverImpl) resumeFlowBelowLowwater() {
+	if receiver.pendingMessages() <= receiver.lowwater && receiver.getState() == messageReceiverStateStarted {
+		receiver.startFlow()
+	}
+}
+
 // startFlow will start the message flow of the receiver's underlying flow
 // it will do this idempotently and it depends on internalFlowStopped being true (1)
 // if successful, it will set internalFlowStopped to 0 (false), preventing additional calls
@@ -1056,7 +1185,7 @@ func (receiver *persistentMessageReceiverImpl) messageCallback(msg core.Receivab
 	if currentState == messageReceiverStateTerminating || currentState == messageReceiverStateTerminated {
 		// we should not be handling this message
 		receiver.logger.Debug("received message after receiver was terminated, dropping message")
-		receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
+		receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
 		return false
 	}
 	defer func() {
@@ -1064,7 +1193,7 @@ func (receiver *persistentMessageReceiverImpl) messageCallback(msg core.Receivab
 			// we may have a race where the receiver buffer is closed before this function is called if unsubscribes are slow
 			if err, ok := r.(error); ok && err.Error() == "send on closed channel" {
 				receiver.logger.Debug("Caught a channel closed panic when trying to write to the message buffer, receiver must be terminated.")
-				receiver.internalReceiver.IncrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
+				receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, uint64(1))
 			} else {
 				// this shouldn't ever happen, but panics are unpredictable. We want this message to make it into the logs
 				receiver.logger.Error(fmt.Sprintf("Caught panic in message callback! %s\n%s", err, string(debug.Stack())))
@@ -1077,11 +1206,11 @@ func (receiver *persistentMessageReceiverImpl) messageCallback(msg core.Receivab
 		// success
 
 		// check if we are above the highwater mark and make sure we are not stopped
-		if len(receiver.buffer) >= receiver.highwater && receiver.stopFlow() {
+		if receiver.pendingMessages() >= receiver.highwater && receiver.stopFlow() {
 			// There is a potential issue here where the flow is stopped, but before the flow stopped flag is set
 			// the entire queue drains, thus there is no unpause. This is resolved by resuming the flow after the
 			// fact if we have dropped below the low water mark
-			if len(receiver.buffer) < receiver.lowwater && receiver.getState() == messageReceiverStateStarted {
+			if receiver.pendingMessages() < receiver.lowwater && receiver.getState() == messageReceiverStateStarted {
 				receiver.startFlow()
 			}
 		}
@@ -1094,6 +1223,8 @@ func (receiver *persistentMessageReceiverImpl) messageCallback(msg core.Receivab
 
 func (receiver *persistentMessageReceiverImpl) run() {
 	defer close(receiver.terminationComplete)
+	// Before notifying of completion, wait for the dispatched messages to be handled
+	defer receiver.dispatcher.shutdown()
 	// Block until an rx callback is set
 	cont := <-receiver.rxCallbackSet
 	// We will send false on the rxCallbackSet channel when we are terminating, indicating that we should shut down
@@ -1101,6 +1232,9 @@ func (receiver *persistentMessageReceiverImpl) run() {
 	if !cont {
 		return
 	}
+	if receiver.dispatcher != nil {
+		receiver.dispatcher.start()
+	}
 	for {
 		// First check if we should pause
 		if atomic.LoadInt32(&receiver.doPause) == 1 {
@@ -1138,39 +1272,27 @@ func (receiver *persistentMessageReceiverImpl) run() {
 				// we never set a discard notification on messages received by a persistent receiver
 				// since we never discard any messages.
 				msg := message.NewInboundMessage(msgP, false)
+				receiver.recordReceive(msg)
 				msgID, present := message.GetMessageID(msg)
 				if !present && receiver.logger.IsDebugEnabled() {
 					receiver.logger.Debug(fmt.Sprintf("Could not retrieve message ID from message %s", msg))
 				}
-				callbackPanic := false
-				if callback != nil {
-					func() {
-						defer func() {
-							if r := recover(); r != nil {
-								callbackPanic = true
-								receiver.logger.Warning("Message receiver callback paniced: " + fmt.Sprint(r))
-							}
-						}()
-						(*callback)(msg)
-					}()
-				}
-				if receiver.doAutoAck {
-					if callbackPanic {
-						receiver.logger.Info("ReceiveAsync callback paniced, will not auto acknowledge")
-					} else {
-						errInfo := receiver.internalFlow.Ack(msgID)
-						if errInfo != nil {
-							receiver.logger.Warning("Failed to acknowledge message: " + errInfo.GetMessageAsString() + ", sub code: " + fmt.Sprint(errInfo.SubCode()))
-						} else {
-							// Successful Auto-Ack, increment the auto-ack duplicate counter
-							receiver.internalReceiver.IncrementDuplicateAckCount()
-						}
-					}
-				}
-				// reenable underlying flow if we are below lowwater AND we are not terminating/terminated
-				if len(receiver.buffer) <= receiver.lowwater && receiver.getState() == messageReceiverStateStarted {
-					receiver.startFlow()
+				if receiver.dispatcher == nil {
+					receiver.deliver(callback, msg, msgID)
+				} else if !receiver.dispatcher.dispatch(msg, dispatchTask{
+					handle: func() {
+						receiver.deliver(callback, msg, msgID)
+					},
+					discard: func() {
+						// the message is not acknowledged and will be redelivered
+						msg.Dispose()
+						receiver.incrementMetric(core.MetricReceivedMessagesTerminationDiscarded, 1)
+					},
+				}) {
+					// we are being forced to terminate while awaiting a worker, the dispatcher discarded the message
+					return
 				}
+				receiver.resumeFlowBelowLowwater()
 			} else {
 				// Since we cannot receive any more messages, the receiver buffer is empty and we should exit
 				if atomic.CompareAndSwapInt32(&receiver.bufferEmptyOnTerminateFlag, 0, 1) {
@@ -1186,6 +1308,40 @@ func (receiver *persistentMessageReceiverImpl) run() {
 	}
 }
 
+// deliver calls the given message handler with the given message, recovering from any panic, and
+// acknowledges the message once handled if auto acknowledgement is configured
+func (receiver *persistentMessageReceiverImpl) deliver(callback *solace.MessageHandler, msg apimessage.InboundMessage, msgID message.MessageID) {
+	callbackPanic := false
+	if callback != nil {
+		func() {
+			defer func() {
+				if r := recover(); r != nil {
+					callbackPanic = true
+					receiver.logger.Warning("Message receiver callback paniced: " + fmt.Sprint(r))
+				}
+			}()
+			(*callback)(msg)
+		}()
+	}
+	if receiver.doAutoAck {
+		if callbackPanic {
+			receiver.logger.Info("ReceiveAsync callback paniced, will not auto acknowledge")
+		} else {
+			errInfo := receiver.internalFlow.Ack(msgID)
+			if errInfo != nil {
+				receiver.logger.With(
+					logging.Attribute{Key: logging.AttributeFlowID, Value: receiver.internalFlow.FlowID()},
+					logging.Attribute{Key: logging.AttributeSubcode, Value: subcode.Code(errInfo.SubCode())},
+				).Warning("Failed to acknowledge message: " + errInfo.GetMessageAsString())
+			} else {
+				// Successful Auto-Ack, increment the auto-ack duplicate counter
+				receiver.internalReceiver.IncrementDuplicateAckCount()
+				receiver.recordSettle(config.PersistentReceiverAcceptedOutcome)
+			}
+		}
+	}
+}
+
 func (receiver *persistentMessageReceiverImpl) String() string {
 	return fmt.Sprintf("solace.PersistentMessageReceiver at %p", receiver)
 }
@@ -1194,6 +1350,8 @@ type persistentMessageReceiverBuilderImpl struct {
 	internalReceiver core.Receiver
 	properties       map[config.ReceiverProperty]interface{}
 	subscriptions    []resource.Subscription
+	codecs           []solace.Codec
+	keyFunction      solace.MessageKeyFunction
 }
 
 // NewPersistentMessageReceiverBuilderImpl function
@@ -1207,6 +1365,87 @@ func NewPersistentMessageReceiverBuilderImpl(internalReceiver core.Receiver) sol
 // Build will build a new PersistentMessageReceiver with the given properties.
 // Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
 func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue) (messageReceiver solace.PersistentMessageReceiver, err error) {
+	// Add queue name
+	if queue == nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverMissingQueue, nil)
+	}
+	return builder.build(queueEndpointProperties(queue), &persistentMessageReceiverProps{
+		startupSubscriptions: builder.subscriptions,
+		endpoint:             queue,
+	})
+}
+
+// queueEndpointProperties returns the flow properties binding to the given queue
+func queueEndpointProperties(queue *resource.Queue) []string {
+	var endpointProperties []string = []string{
+		// set the entity type to queue
+		ccsmp.SolClientFlowPropBindEntityID, ccsmp.SolClientFlowPropBindEntityQueue,
+	}
+	if queue.GetName() != "" || queue.IsDurable() {
+		endpointProperties = append(endpointProperties, ccsmp.SolClientFlowPropBindName, queue.GetName())
+	}
+	// Set queue durability
+	return append(endpointProperties, ccsmp.SolClientFlowPropBindEntityDurable, durabilityProperty(queue.IsDurable()))
+}
+
+// BuildWithTopicEndpoint creates a PersistentMessageReceiver bound to the given topic endpoint
+// with the given topic subscription.
+func (builder *persistentMessageReceiverBuilderImpl) BuildWithTopicEndpoint(topicEndpoint *resource.TopicEndpoint, subscription *resource.TopicSubscription) (messageReceiver solace.PersistentMessageReceiver, err error) {
+	if topicEndpoint == nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverMissingTopicEndpoint, nil)
+	}
+	if topicEndpoint.IsDurable() && topicEndpoint.GetName() == "" {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverTopicEndpointMissingName, nil)
+	}
+	if subscription == nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverTopicEndpointMissingSubscription, nil)
+	}
+	if len(builder.subscriptions) > 0 {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverTopicEndpointSubscriptions, nil)
+	}
+	var endpointProperties []string = []string{
+		// set the entity type to topic endpoint
+		ccsmp.SolClientFlowPropBindEntityID, ccsmp.SolClientFlowPropBindEntityTe,
+	}
+	if topicEndpoint.GetName() != "" {
+		endpointProperties = append(endpointProperties, ccsmp.SolClientFlowPropBindName, topicEndpoint.GetName())
+	}
+	endpointProperties = append(endpointProperties,
+		ccsmp.SolClientFlowPropBindEntityDurable, durabilityProperty(topicEndpoint.IsDurable()),
+		// the topic endpoint attracts messages with the single subscription given in the bind
+		ccsmp.SolClientFlowPropTopic, subscription.GetName(),
+	)
+	return builder.build(endpointProperties, &persistentMessageReceiverProps{
+		topicEndpoint: topicEndpoint,
+	})
+}
+
+// persistentReceiverFlowTuning maps the flow tuning receiver properties to their ccsmp flow properties and valid ranges
+var persistentReceiverFlowTuning = []struct {
+	property      config.ReceiverProperty
+	ccsmpProperty string
+	min, max      int
+	excludeZero   bool
+	isDuration    bool
+}{
+	{property: config.ReceiverPropertyPersistentFlowWindowSize, ccsmpProperty: ccsmp.SolClientFlowPropWindowsize, min: 1, max: 255},
+	{property: config.ReceiverPropertyPersistentFlowMaxUnackedMessages, ccsmpProperty: ccsmp.SolClientFlowPropMaxUnackedMessages, min: -1, max: math.MaxInt32, excludeZero: true},
+	{property: config.ReceiverPropertyPersistentFlowAckThreshold, ccsmpProperty: ccsmp.SolClientFlowPropAckThreshold, min: 1, max: 75},
+	{property: config.ReceiverPropertyPersistentFlowAckTimer, ccsmpProperty: ccsmp.SolClientFlowPropAckTimerMs, min: 20, max: 1500, isDuration: true},
+	{property: config.ReceiverPropertyPersistentFlowReconnectAttempts, ccsmpProperty: ccsmp.SolClientFlowPropMaxReconnectTries, min: -1, max: math.MaxInt32},
+	{property: config.ReceiverPropertyPersistentFlowBindTimeout, ccsmpProperty: ccsmp.SolClientFlowPropBindTimeoutMs, min: 1, max: math.MaxInt32, isDuration: true},
+}
+
+func durabilityProperty(durable bool) string {
+	if durable {
+		return ccsmp.SolClientPropEnableVal
+	}
+	return ccsmp.SolClientPropDisableVal
+}
+
+// build creates the receiver from the given endpoint specific flow properties, layering the properties
+// common to all endpoints configured on the builder, and the given partially populated receiver properties.
+func (builder *persistentMessageReceiverBuilderImpl) build(endpointProperties []string, receiverProps *persistentMessageReceiverProps) (messageReceiver solace.PersistentMessageReceiver, err error) {
 	// Validate that subscriptions are of correct type
 	for _, subscription := range builder.subscriptions {
 		if err = checkPersistentMessageReceiverSubscriptionType(subscription); err != nil {
@@ -1246,6 +1485,11 @@ func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue
 		}
 	}
 
+	dispatchWorkers, dispatchOrdering, err := concurrentDispatchProperties(builder.properties)
+	if err != nil {
+		return nil, err
+	}
+
 	var receiverStateChangeListener solace.ReceiverStateChangeListener = nil
 	if stateChangeListenerInterface, ok := builder.properties[config.ReceiverPropertyPersistentStateChangeListener]; ok {
 		receiverStateChangeListener, ok = stateChangeListenerInterface.(solace.ReceiverStateChangeListener)
@@ -1257,8 +1501,6 @@ func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue
 
 	// Now deal with the CCSMP flow properties
 	var properties []string = []string{
-		// set the entity type to queue
-		ccsmp.SolClientFlowPropBindEntityID, ccsmp.SolClientFlowPropBindEntityQueue,
 		// set the ackmode to client, we handle auto ack in the receiver, NOT through ccsmp
 		ccsmp.SolClientFlowPropAckmode, ccsmp.SolClientFlowPropAckmodeClient,
 		// set the active flow indicator to enabled
@@ -1267,22 +1509,7 @@ func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue
 		ccsmp.SolClientFlowPropStartState, ccsmp.SolClientPropDisableVal,
 	}
 
-	// Add queue name
-	if queue == nil {
-		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverMissingQueue, nil)
-	}
-	if queue.GetName() != "" || queue.IsDurable() {
-		properties = append(properties, ccsmp.SolClientFlowPropBindName, queue.GetName())
-	}
-
-	// Set queue durability
-	var isDurable string
-	if queue.IsDurable() {
-		isDurable = ccsmp.SolClientPropEnableVal
-	} else {
-		isDurable = ccsmp.SolClientPropDisableVal
-	}
-	properties = append(properties, ccsmp.SolClientFlowPropBindEntityDurable, isDurable)
+	properties = append(properties, endpointProperties...)
 
 	// If we have a selector configured, add that to the flow props
 	if selector, ok := builder.properties[config.ReceiverPropertyPersistentMessageSelectorQuery]; ok {
@@ -1295,6 +1522,25 @@ func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue
 		}
 	}
 
+	// Add the flow tuning properties such as the window size
+	for _, tuning := range persistentReceiverFlowTuning {
+		value := builder.properties[tuning.property]
+		if duration, ok := value.(time.Duration); ok && tuning.isDuration {
+			value = int(duration / time.Millisecond)
+		}
+		prop, present, err := validation.IntegerPropertyValidationWithRange(string(tuning.property), value, tuning.min, tuning.max)
+		if present {
+			if err != nil {
+				return nil, err
+			}
+			if prop == 0 && tuning.excludeZero {
+				return nil, solace.NewError(&solace.IllegalArgumentError{},
+					fmt.Sprintf("expected configured value for %s to be -1 or greater than 0, got 0", tuning.property), nil)
+			}
+			properties = append(properties, tuning.ccsmpProperty, strconv.Itoa(prop))
+		}
+	}
+
 	// If we have a replay strategy configured, add that to the flow props
 	if replayStrategyInterface, ok := builder.properties[config.ReceiverPropertyPersistentMessageReplayStrategy]; ok {
 		replayStrategy, present, err := validation.StringPropertyValidation(
@@ -1389,19 +1635,20 @@ func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue
 
 	// Create the receiver with the given properties
 	receiver := &persistentMessageReceiverImpl{}
-	receiver.construct(
-		&persistentMessageReceiverProps{
-			flowProperties:          properties,
-			internalReceiver:        builder.internalReceiver,
-			startupSubscriptions:    builder.subscriptions,
-			bufferHighwater:         bufferHighwaterDefault,
-			bufferLowwater:          bufferLowwaterDefault,
-			endpoint:                queue,
-			doCreateMissingResource: doCreateMissingResource,
-			doAutoAck:               doAutoAck,
-			stateChangeListener:     receiverStateChangeListener,
-		},
-	)
+	receiverProps.flowProperties = properties
+	receiverProps.internalReceiver = builder.internalReceiver
+	receiverProps.bufferHighwater = bufferHighwaterDefault
+	receiverProps.bufferLowwater = bufferLowwaterDefault
+	receiverProps.doCreateMissingResource = doCreateMissingResource
+	receiverProps.doAutoAck = doAutoAck
+	receiverProps.stateChangeListener = receiverStateChangeListener
+	receiverProps.codecs = builder.codecs
+	receiverProps.dispatchWorkers = dispatchWorkers
+	receiverProps.dispatchOrdering = dispatchOrdering
+	if dispatchOrdering == config.OrderingPolicyPerPartitionKey {
+		receiverProps.dispatchKeyFunction = builder.keyFunction
+	}
+	receiver.construct(receiverProps)
 
 	return receiver, nil
 }
@@ -1427,6 +1674,31 @@ func (builder *persistentMessageReceiverBuilderImpl) FromConfigurationProvider(p
 	return builder
 }
 
+// WithCodec registers the given codec to decode message payloads with DecodePayload.
+func (builder *persistentMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.PersistentMessageReceiverBuilder {
+	builder.codecs = append(builder.codecs, codec)
+	return builder
+}
+
+// WithConcurrentDispatch will configure the receiver to call the message handler from the given
+// number of workers concurrently, preserving the order of messages as defined by the given ordering policy.
+func (builder *persistentMessageReceiverBuilderImpl) WithConcurrentDispatch(workers uint, ordering config.OrderingPolicy) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyConcurrentDispatchWorkers] = workers
+	builder.properties[config.ReceiverPropertyConcurrentDispatchOrdering] = ordering
+	builder.keyFunction = nil
+	return builder
+}
+
+// WithKeyedDispatch will configure the receiver to call the message handler from the given number of workers
+// concurrently, handling messages with the same key in order. Messages are keyed by the given key function,
+// or by their partition key if nil.
+func (builder *persistentMessageReceiverBuilderImpl) WithKeyedDispatch(workers uint, key solace.MessageKeyFunction) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyConcurrentDispatchWorkers] = workers
+	builder.properties[config.ReceiverPropertyConcurrentDispatchOrdering] = config.OrderingPolicyPerPartitionKey
+	builder.keyFunction = key
+	return builder
+}
+
 // WithActivationPassivationSupport sets the listener to receiver broker notifications
 // about state changes for the resulting receiver. This change can happen if there are
 // multiple instances of the same receiver for high availability and activity is exchanged.
@@ -1459,6 +1731,42 @@ func (builder *persistentMessageReceiverBuilderImpl) WithMessageSelector(filterS
 	return builder
 }
 
+// WithFlowWindowSize sets the maximum number of messages that can be in transit from the broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithFlowWindowSize(windowSize uint) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowWindowSize] = windowSize
+	return builder
+}
+
+// WithMaxUnackedMessages sets the maximum number of messages that may be delivered without being acknowledged.
+func (builder *persistentMessageReceiverBuilderImpl) WithMaxUnackedMessages(maxUnackedMessages int) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowMaxUnackedMessages] = maxUnackedMessages
+	return builder
+}
+
+// WithAckThreshold sets the threshold for sending acknowledgements as a percentage of the window size.
+func (builder *persistentMessageReceiverBuilderImpl) WithAckThreshold(percentage uint) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowAckThreshold] = percentage
+	return builder
+}
+
+// WithAckTimer sets the maximum time acknowledgements are batched before being sent to the broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithAckTimer(interval time.Duration) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowAckTimer] = interval
+	return builder
+}
+
+// WithFlowReconnectAttempts sets the number of times the flow is rebound after the broker unbinds it.
+func (builder *persistentMessageReceiverBuilderImpl) WithFlowReconnectAttempts(attempts int) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowReconnectAttempts] = attempts
+	return builder
+}
+
+// WithBindTimeout sets the time to wait for the broker to confirm the flow bind.
+func (builder *persistentMessageReceiverBuilderImpl) WithBindTimeout(timeout time.Duration) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowBindTimeout] = timeout
+	return builder
+}
+
 // WithMissingResourcesCreationStrategy sets the missing resource creation strategy
 // defining what actions the API may take when missing resources are detected.
 func (builder *persistentMessageReceiverBuilderImpl) WithMissingResourcesCreationStrategy(strategy config.MissingResourcesCreationStrategy) solace.PersistentMessageReceiverBuilder {
@@ -1518,8 +1826,11 @@ func (builder *persistentMessageReceiverBuilderImpl) String() string {
 
 // Validate the subscription type is one supported by
 func checkPersistentMessageReceiverSubscriptionType(subscription resource.Subscription) error {
-	switch subscription.(type) {
+	switch topicSubscription := subscription.(type) {
 	case *resource.TopicSubscription:
+		if err := resource.ValidateTopicSubscription(topicSubscription.GetName()); err != nil {
+			return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+		}
 		return nil
 	}
 	return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PersistentReceiverUnsupportedSubscriptionType, subscription), nil)
@@ -295,6 +295,135 @@ func TestPersistentBuilderWithInvalidSubscriptionType(t *testing.T) {
 	}
 }
 
+func TestPersistentBuilderWithFlowTuning(t *testing.T) {
+	builder := NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{})
+	builder.WithFlowWindowSize(10).
+		WithMaxUnackedMessages(5).
+		WithAckThreshold(50).
+		WithAckTimer(100 * time.Millisecond).
+		WithFlowReconnectAttempts(0).
+		WithBindTimeout(2 * time.Second)
+	receiver, err := builder.Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatalf("did not expect to get an error when building with valid properties, got %s", err)
+	}
+	receiverImpl := receiver.(*persistentMessageReceiverImpl)
+	expected := map[string]string{
+		ccsmp.SolClientFlowPropWindowsize:         "10",
+		ccsmp.SolClientFlowPropMaxUnackedMessages: "5",
+		ccsmp.SolClientFlowPropAckThreshold:       "50",
+		ccsmp.SolClientFlowPropAckTimerMs:         "100",
+		ccsmp.SolClientFlowPropMaxReconnectTries:  "0",
+		ccsmp.SolClientFlowPropBindTimeoutMs:      "2000",
+	}
+	flowProperties := make(map[string]string)
+	for i := 0; i+1 < len(receiverImpl.internalFlowProperties); i += 2 {
+		flowProperties[receiverImpl.internalFlowProperties[i]] = receiverImpl.internalFlowProperties[i+1]
+	}
+	for key, value := range expected {
+		if flowProperties[key] != value {
+			t.Errorf("expected flow property %s to equal %s, got %s", key, value, flowProperties[key])
+		}
+	}
+}
+
+func TestPersistentBuilderWithInvalidFlowTuning(t *testing.T) {
+	invalidProperties := []config.ReceiverPropertyMap{
+		{config.ReceiverPropertyPersistentFlowWindowSize: 0},
+		{config.ReceiverPropertyPersistentFlowWindowSize: 256},
+		{config.ReceiverPropertyPersistentFlowMaxUnackedMessages: 0},
+		{config.ReceiverPropertyPersistentFlowMaxUnackedMessages: -2},
+		{config.ReceiverPropertyPersistentFlowAckThreshold: 76},
+		{config.ReceiverPropertyPersistentFlowAckTimer: 10 * time.Millisecond},
+		{config.ReceiverPropertyPersistentFlowAckTimer: 2000},
+		{config.ReceiverPropertyPersistentFlowReconnectAttempts: -2},
+		{config.ReceiverPropertyPersistentFlowBindTimeout: 0},
+		{config.ReceiverPropertyPersistentFlowBindTimeout: "hello"},
+	}
+	for _, properties := range invalidProperties {
+		builder := NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{})
+		receiver, err := builder.FromConfigurationProvider(properties).Build(resource.QueueDurableExclusive("hello"))
+		if _, ok := err.(*solace.IllegalArgumentError); !ok {
+			t.Errorf("expected illegal argument error for %v, got %s", properties, err)
+		}
+		if receiver != nil {
+			t.Error("expected receiver to equal nil, it was not")
+		}
+	}
+}
+
+func TestPersistentBuilderWithTopicEndpoint(t *testing.T) {
+	builder := NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{})
+	receiver, err := builder.BuildWithTopicEndpoint(resource.TopicEndpointDurable("hello"), resource.TopicSubscriptionOf("mytopic"))
+	if err != nil {
+		t.Errorf("did not expect to get an error when building with valid properties, got %s", err)
+	}
+	receiverImpl, ok := receiver.(*persistentMessageReceiverImpl)
+	if !ok {
+		t.Fatal("expected to get a persistentMessageReceiverImpl returned")
+	}
+	expected := map[string]string{
+		ccsmp.SolClientFlowPropBindEntityID:      ccsmp.SolClientFlowPropBindEntityTe,
+		ccsmp.SolClientFlowPropBindName:          "hello",
+		ccsmp.SolClientFlowPropBindEntityDurable: ccsmp.SolClientPropEnableVal,
+		ccsmp.SolClientFlowPropTopic:             "mytopic",
+	}
+	flowProperties := make(map[string]string)
+	for i := 0; i+1 < len(receiverImpl.internalFlowProperties); i += 2 {
+		flowProperties[receiverImpl.internalFlowProperties[i]] = receiverImpl.internalFlowProperties[i+1]
+	}
+	for key, value := range expected {
+		if flowProperties[key] != value {
+			t.Errorf("expected flow property %s to equal %s, got %s", key, value, flowProperties[key])
+		}
+	}
+	if receiverImpl.queue != nil || receiverImpl.topicEndpoint == nil {
+		t.Error("expected receiver to be bound to the topic endpoint")
+	}
+}
+
+func TestPersistentBuilderWithTopicEndpointInvalidArguments(t *testing.T) {
+	subscription := resource.TopicSubscriptionOf("mytopic")
+	buildFunctions := []func() (solace.PersistentMessageReceiver, error){
+		func() (solace.PersistentMessageReceiver, error) {
+			return NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{}).BuildWithTopicEndpoint(nil, subscription)
+		},
+		func() (solace.PersistentMessageReceiver, error) {
+			return NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{}).BuildWithTopicEndpoint(resource.TopicEndpointDurable("hello"), nil)
+		},
+		func() (solace.PersistentMessageReceiver, error) {
+			return NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{}).BuildWithTopicEndpoint(resource.TopicEndpointDurable(""), subscription)
+		},
+		func() (solace.PersistentMessageReceiver, error) {
+			return NewPersistentMessageReceiverBuilderImpl(&mockInternalReceiver{}).WithSubscriptions(subscription).
+				BuildWithTopicEndpoint(resource.TopicEndpointDurable("hello"), subscription)
+		},
+	}
+	for _, fn := range buildFunctions {
+		receiver, err := fn()
+		if _, ok := err.(*solace.IllegalArgumentError); !ok {
+			t.Errorf("expected illegal argument error, got %s", err)
+		}
+		if receiver != nil {
+			t.Error("expected receiver to equal nil, it was not")
+		}
+	}
+}
+
+func TestPersistentReceiverSubscribeWithTopicEndpoint(t *testing.T) {
+	receiver := persistentMessageReceiverImpl{
+		topicEndpoint:        resource.TopicEndpointDurable("hello"),
+		basicMessageReceiver: basicMessageReceiver{internalReceiver: &mockInternalReceiver{}, state: messageReceiverStateStarted},
+	}
+	subscription := resource.TopicSubscriptionOf("mytopic")
+	if _, ok := receiver.AddSubscription(subscription).(*solace.IllegalStateError); !ok {
+		t.Error("expected illegal state error when adding a subscription to a topic endpoint receiver")
+	}
+	if _, ok := receiver.RemoveSubscription(subscription).(*solace.IllegalStateError); !ok {
+		t.Error("expected illegal state error when removing a subscription from a topic endpoint receiver")
+	}
+}
+
 func TestPersistentReceiverSubscribeWithInvalidSubscriptionType(t *testing.T) {
 	receiver := persistentMessageReceiverImpl{
 		subscriptions:        []string{},
@@ -0,0 +1,442 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/internal/impl/validation"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+type queueBrowserImpl struct {
+	basicMessageReceiver
+
+	logger logging.LogLevelLogger
+
+	internalFlow           core.PersistentReceiver
+	internalFlowProperties []string
+
+	queue  *resource.Queue
+	buffer chan ccsmp.SolClientMessagePt
+
+	terminationNotification chan struct{}
+	terminationHandlerID    uint
+}
+
+type queueBrowserProps struct {
+	flowProperties   []string
+	internalReceiver core.Receiver
+	queue            *resource.Queue
+	windowSize       int
+}
+
+func (browser *queueBrowserImpl) construct(props *queueBrowserProps) {
+	browser.basicMessageReceiver.construct(props.internalReceiver)
+	browser.internalFlowProperties = props.flowProperties
+	browser.queue = props.queue
+	// the broker delivers at most one window of messages per flow start, keep space for a second
+	// window in case messages are still in flight when the flow is restarted
+	browser.buffer = make(chan ccsmp.SolClientMessagePt, 2*props.windowSize)
+	browser.terminationNotification = make(chan struct{})
+	browser.logger = browser.internalReceiver.Logger().For(browser)
+}
+
+func (browser *queueBrowserImpl) onDownEvent(eventInfo core.SessionEventInfo) {
+	browser.logger.Debug("Received session event down error! Terminating...")
+	// skip native cleanup of the flow as the session is destroyed along with it
+	go browser.unsolicitedTermination(eventInfo, false)
+}
+
+func (browser *queueBrowserImpl) onFlowEvent(event ccsmp.SolClientFlowEvent, eventInfo core.FlowEventInfo) {
+	switch event {
+	case ccsmp.SolClientFlowEventDownError:
+		browser.logger.Debug("Received flow event down error! Terminating...")
+		go browser.unsolicitedTermination(eventInfo, true)
+	case ccsmp.SolClientFlowEventBindFailedError:
+		browser.logger.Debug("Received flow event bind failed! Info string: " + eventInfo.GetInfoString())
+	default:
+		if browser.logger.IsDebugEnabled() {
+			browser.logger.Debug(fmt.Sprintf("Received flow event %d", event))
+		}
+	}
+}
+
+// Start will start the service synchronously.
+// Before this function is called, the service is considered
+// off-duty. To operate normally, this function must be called on
+// a receiver or publisher instance. This function is idempotent.
+// Returns an error if one occurred or nil if successful.
+func (browser *queueBrowserImpl) Start() (err error) {
+	if proceed, err := browser.starting(); !proceed {
+		return err
+	}
+	browser.logger.Debug("Start queue browser start")
+	defer func() {
+		if err == nil {
+			browser.started(err)
+			browser.logger.Debug("Start queue browser complete")
+		} else {
+			browser.logger.Debug("Start queue browser complete with error: " + err.Error())
+			browser.internalReceiver.Events().RemoveEventHandler(browser.terminationHandlerID)
+			if browser.internalFlow != nil {
+				browser.internalFlow.Destroy(true)
+			}
+			browser.terminated(nil)
+			browser.startFuture.Complete(err)
+		}
+	}()
+	browser.terminationHandlerID = browser.internalReceiver.Events().AddEventHandler(core.SolClientEventDown, browser.onDownEvent)
+	var errInfo core.ErrorInfo
+	browser.internalFlow, errInfo = browser.internalReceiver.NewPersistentReceiver(browser.internalFlowProperties, browser.messageCallback, browser.onFlowEvent)
+	if errInfo != nil {
+		return core.ToNativeError(errInfo, "error while creating browser flow: ")
+	}
+	// the flow is created in the stopped state, it is started on demand by ReceiveMessage
+	return nil
+}
+
+// StartAsync will start the service asynchronously.
+// Before this function is called, the service is considered
+// off-duty. To operate normally, this function must be called on
+// a receiver or publisher instance. This function is idempotent.
+// Returns a channel that will receive an error if one occurred or
+// nil if successful. Subsequent calls will return additional
+// channels that can await an error, or nil if already started.
+func (browser *queueBrowserImpl) StartAsync() <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- browser.Start()
+		close(result)
+	}()
+	return result
+}
+
+// StartAsyncCallback will start the QueueBrowser asynchronously.
+// Calls the callback when started with an error if one occurred or nil
+// if successful.
+func (browser *queueBrowserImpl) StartAsyncCallback(callback func(solace.QueueBrowser, error)) {
+	go func() {
+		callback(browser, browser.Start())
+	}()
+}
+
+// Terminate will terminate the service gracefully and synchronously.
+// This function is idempotent. The only way to resume operation
+// after this function is called is to create a new instance.
+// Browsed messages are never consumed from the queue, so any messages
+// still buffered by the browser are discarded without error and the
+// grace period has no effect.
+func (browser *queueBrowserImpl) Terminate(gracePeriod time.Duration) (err error) {
+	if proceed, err := browser.basicMessageReceiver.terminate(); !proceed {
+		return err
+	}
+	browser.logger.Debug("Terminate queue browser start")
+	defer func() {
+		browser.terminated(err)
+		browser.logger.Debug("Terminate queue browser complete")
+	}()
+	// interrupt any pending calls to ReceiveMessage
+	close(browser.terminationNotification)
+	if errInfo := browser.internalFlow.Stop(); errInfo != nil {
+		browser.logger.Info("Encountered error while stopping browser flow: " + errInfo.String())
+	}
+	browser.internalReceiver.Events().RemoveEventHandler(browser.terminationHandlerID)
+	if errInfo := browser.internalFlow.Destroy(true); errInfo != nil {
+		browser.logger.Info("Encountered error while trying to clean up browser flow: " + errInfo.String())
+	}
+	browser.drainBuffer()
+	return nil
+}
+
+// TerminateWithContext will terminate the service synchronously.
+// As buffered messages are discarded without waiting, the context is not used.
+// For more information, see Terminate.
+func (browser *queueBrowserImpl) TerminateWithContext(ctx context.Context) error {
+	return browser.Terminate(0)
+}
+
+// TerminateAsync will terminate the service asynchronously.
+// This function is idempotent. The only way to resume operation
+// after this function is called is to create a new instance.
+// Returns a channel that will receive an error if one occurred or
+// nil if successfully terminated.
+func (browser *queueBrowserImpl) TerminateAsync(gracePeriod time.Duration) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- browser.Terminate(gracePeriod)
+		close(result)
+	}()
+	return result
+}
+
+// TerminateAsyncCallback will terminate the QueueBrowser asynchronously.
+// Calls the callback when terminated with nil if successful or an error if
+// one occurred.
+func (browser *queueBrowserImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(browser.Terminate(gracePeriod))
+	}()
+}
+
+func (browser *queueBrowserImpl) unsolicitedTermination(eventInfo core.EventInfo, shouldCleanUpNative bool) {
+	if proceed, _ := browser.basicMessageReceiver.terminate(); !proceed {
+		// we are already terminated, nothing to do
+		return
+	}
+	browser.logger.Debug("Received unsolicited termination with event info " + eventInfo.GetInfoString())
+	defer browser.logger.Debug("Unsolicited termination complete")
+	timestamp := time.Now()
+	close(browser.terminationNotification)
+	browser.internalFlow.Destroy(shouldCleanUpNative)
+	browser.internalReceiver.Events().RemoveEventHandler(browser.terminationHandlerID)
+	browser.drainBuffer()
+	browser.terminated(nil)
+	if browser.terminationListener != nil {
+		browser.terminationListener(&receiverTerminationEvent{
+			timestamp,
+			eventInfo.GetError(),
+		})
+	}
+}
+
+// drainBuffer frees any browsed messages that were not yet delivered to the application
+func (browser *queueBrowserImpl) drainBuffer() {
+	for {
+		select {
+		case msgP := <-browser.buffer:
+			ccsmp.SolClientMessageFree(&msgP)
+		default:
+			return
+		}
+	}
+}
+
+// ReceiveMessage receives the next browsed message synchronously from the browser.
+// Returns an error if the browser is not started or already terminated.
+// This function waits until the specified timeout to receive a message or waits
+// forever if timeout value is negative. If a timeout occurs, a solace.TimeoutError
+// is returned.
+func (browser *queueBrowserImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, error) {
+	return browser.receiveMessage(context.Background(), timeout)
+}
+
+// ReceiveMessageWithContext receives the next browsed message synchronously from the browser,
+// waiting until a message is available or the given context is done.
+func (browser *queueBrowserImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, error) {
+	return browser.receiveMessage(ctx, -1)
+}
+
+func (browser *queueBrowserImpl) receiveMessage(ctx context.Context, timeout time.Duration) (apimessage.InboundMessage, error) {
+	switch browser.getState() {
+	case messageReceiverStateNotStarted, messageReceiverStateStarting:
+		return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveNotStarted, nil)
+	case messageReceiverStateTerminating, messageReceiverStateTerminated:
+		return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveAlreadyTerminated, nil)
+	}
+	select {
+	case msgP := <-browser.buffer:
+		return message.NewInboundMessage(msgP, false), nil
+	default:
+	}
+	// The browser flow closes its window as messages are delivered, ask the broker for the next window
+	if errInfo := browser.internalFlow.Start(); errInfo != nil {
+		return nil, core.ToNativeError(errInfo, "error while requesting messages from browser flow: ")
+	}
+	var timeoutChan <-chan time.Time
+	if timeout >= 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutChan = timer.C
+	}
+	select {
+	case msgP := <-browser.buffer:
+		return message.NewInboundMessage(msgP, false), nil
+	case <-timeoutChan:
+		return nil, solace.NewError(&solace.TimeoutError{}, constants.ReceiverTimedOutWaitingForMessage, nil)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-browser.terminationNotification:
+		return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveAlreadyTerminated, nil)
+	}
+}
+
+// Remove removes the specified browsed message from the queue.
+func (browser *queueBrowserImpl) Remove(msg apimessage.InboundMessage) error {
+	switch browser.getState() {
+	case messageReceiverStateNotStarted, messageReceiverStateStarting:
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToRemoveNotStarted, nil)
+	case messageReceiverStateTerminating, messageReceiverStateTerminated:
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToRemoveAlreadyTerminated, nil)
+	}
+	msgImpl, ok := msg.(*message.InboundMessageImpl)
+	if !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.InvalidInboundMessageType, msg), nil)
+	}
+	msgID, present := message.GetMessageID(msgImpl)
+	if !present {
+		return solace.NewError(&solace.IllegalArgumentError{}, constants.UnableToRetrieveMessageID, nil)
+	}
+	// acknowledging a message on a browser flow removes it from the queue
+	if errInfo := browser.internalFlow.Ack(msgID); errInfo != nil {
+		return core.ToNativeError(errInfo)
+	}
+	return nil
+}
+
+func (browser *queueBrowserImpl) messageCallback(msg core.Receivable) (ret bool) {
+	currentState := browser.getState()
+	if currentState == messageReceiverStateTerminating || currentState == messageReceiverStateTerminated {
+		browser.logger.Debug("received message after queue browser was terminated, dropping message")
+		return false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			browser.logger.Error(fmt.Sprintf("Caught panic in message callback! %s\n%s", r, string(debug.Stack())))
+			ret = false
+		}
+	}()
+	select {
+	case browser.buffer <- msg:
+		return true
+	default:
+		// the message remains on the queue and will be browsed again by a new browser
+		browser.logger.Error("Unable to push browsed message to buffer")
+		return false
+	}
+}
+
+func (browser *queueBrowserImpl) String() string {
+	return fmt.Sprintf("solace.QueueBrowser at %p", browser)
+}
+
+type queueBrowserBuilderImpl struct {
+	internalReceiver core.Receiver
+	properties       map[config.ReceiverProperty]interface{}
+}
+
+// NewQueueBrowserBuilderImpl function
+func NewQueueBrowserBuilderImpl(internalReceiver core.Receiver) solace.QueueBrowserBuilder {
+	return &queueBrowserBuilderImpl{
+		internalReceiver: internalReceiver,
+		properties:       constants.DefaultQueueBrowserProperties.GetConfiguration(),
+	}
+}
+
+// Build will build a new QueueBrowser with the given properties.
+// Returns solace/errors.*IllegalArgumentError if the queue is nil or anonymous.
+// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+func (builder *queueBrowserBuilderImpl) Build(queue *resource.Queue) (solace.QueueBrowser, error) {
+	if queue == nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.QueueBrowserMissingQueue, nil)
+	}
+	if queue.GetName() == "" {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.QueueBrowserAnonymousQueue, nil)
+	}
+	windowSize, _, err := validation.IntegerPropertyValidationWithRange(string(config.ReceiverPropertyQueueBrowserWindowSize),
+		builder.properties[config.ReceiverPropertyQueueBrowserWindowSize], 1, 255)
+	if err != nil {
+		return nil, err
+	}
+
+	var isDurable string
+	if queue.IsDurable() {
+		isDurable = ccsmp.SolClientPropEnableVal
+	} else {
+		isDurable = ccsmp.SolClientPropDisableVal
+	}
+	var properties []string = []string{
+		// set the entity type to queue
+		ccsmp.SolClientFlowPropBindEntityID, ccsmp.SolClientFlowPropBindEntityQueue,
+		ccsmp.SolClientFlowPropBindName, queue.GetName(),
+		ccsmp.SolClientFlowPropBindEntityDurable, isDurable,
+		// bind as a browser so that messages are not consumed
+		ccsmp.SolClientFlowPropBrowser, ccsmp.SolClientPropEnableVal,
+		// client ack is required to remove browsed messages
+		ccsmp.SolClientFlowPropAckmode, ccsmp.SolClientFlowPropAckmodeClient,
+		// start the flow in the 'stopped' state, messages are requested on demand
+		ccsmp.SolClientFlowPropStartState, ccsmp.SolClientPropDisableVal,
+		ccsmp.SolClientFlowPropWindowsize, strconv.Itoa(windowSize),
+	}
+
+	if selector, ok := builder.properties[config.ReceiverPropertyPersistentMessageSelectorQuery]; ok {
+		prop, present, err := validation.StringPropertyValidation(string(config.ReceiverPropertyPersistentMessageSelectorQuery), selector)
+		if present {
+			if err != nil {
+				return nil, err
+			}
+			properties = append(properties, ccsmp.SolClientFlowPropSelector, prop)
+		}
+	}
+
+	browser := &queueBrowserImpl{}
+	browser.construct(&queueBrowserProps{
+		flowProperties:   properties,
+		internalReceiver: builder.internalReceiver,
+		queue:            queue,
+		windowSize:       windowSize,
+	})
+	return browser, nil
+}
+
+// WithMessageSelector will set the message selector to the given string.
+// If an empty string is given, the filter will be cleared.
+func (builder *queueBrowserBuilderImpl) WithMessageSelector(filterSelectorExpression string) solace.QueueBrowserBuilder {
+	if filterSelectorExpression == "" {
+		delete(builder.properties, config.ReceiverPropertyPersistentMessageSelectorQuery)
+	} else {
+		builder.properties[config.ReceiverPropertyPersistentMessageSelectorQuery] = filterSelectorExpression
+	}
+	return builder
+}
+
+// WithQueueBrowserWindowSize sets the maximum number of messages that can be
+// requested from the broker at a time.
+func (builder *queueBrowserBuilderImpl) WithQueueBrowserWindowSize(windowSize uint) solace.QueueBrowserBuilder {
+	builder.properties[config.ReceiverPropertyQueueBrowserWindowSize] = windowSize
+	return builder
+}
+
+// FromConfigurationProvider will configure the queue browser with the given properties.
+// Built in ReceiverPropertiesConfigurationProvider implementations include:
+//
+//	ReceiverPropertyMap, a map of ReceiverProperty keys to values
+func (builder *queueBrowserBuilderImpl) FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) solace.QueueBrowserBuilder {
+	if provider == nil {
+		return builder
+	}
+	for key, value := range provider.GetConfiguration() {
+		builder.properties[key] = value
+	}
+	return builder
+}
+
+func (builder *queueBrowserBuilderImpl) String() string {
+	return fmt.Sprintf("solace.QueueBrowserBuilder at %p", builder)
+}
@@ -0,0 +1,242 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+func flowPropertyValue(properties []string, key string) (string, bool) {
+	for i := 0; i+1 < len(properties); i += 2 {
+		if properties[i] == key {
+			return properties[i+1], true
+		}
+	}
+	return "", false
+}
+
+func TestQueueBrowserBuilderProperties(t *testing.T) {
+	builder := NewQueueBrowserBuilderImpl(nil)
+	builder.WithMessageSelector("a = 1").WithQueueBrowserWindowSize(10)
+	browser, err := builder.Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatalf("did not expect error building queue browser, got %s", err)
+	}
+	properties := browser.(*queueBrowserImpl).internalFlowProperties
+	expected := map[string]string{
+		ccsmp.SolClientFlowPropBrowser:           ccsmp.SolClientPropEnableVal,
+		ccsmp.SolClientFlowPropBindName:          "hello",
+		ccsmp.SolClientFlowPropBindEntityDurable: ccsmp.SolClientPropEnableVal,
+		ccsmp.SolClientFlowPropAckmode:           ccsmp.SolClientFlowPropAckmodeClient,
+		ccsmp.SolClientFlowPropStartState:        ccsmp.SolClientPropDisableVal,
+		ccsmp.SolClientFlowPropWindowsize:        "10",
+		ccsmp.SolClientFlowPropSelector:          "a = 1",
+	}
+	for key, value := range expected {
+		if actual, ok := flowPropertyValue(properties, key); !ok || actual != value {
+			t.Errorf("expected flow property %s to be %s, got %s", key, value, actual)
+		}
+	}
+	builder.WithMessageSelector("")
+	browser, err = builder.Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatalf("did not expect error building queue browser, got %s", err)
+	}
+	if _, ok := flowPropertyValue(browser.(*queueBrowserImpl).internalFlowProperties, ccsmp.SolClientFlowPropSelector); ok {
+		t.Error("expected selector to be cleared")
+	}
+}
+
+func TestQueueBrowserBuilderInvalidConfiguration(t *testing.T) {
+	if _, err := NewQueueBrowserBuilderImpl(nil).Build(nil); err == nil {
+		t.Error("expected error building queue browser with nil queue")
+	} else if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected IllegalArgumentError, got %T", err)
+	}
+	if _, err := NewQueueBrowserBuilderImpl(nil).Build(resource.QueueNonDurableExclusiveAnonymous()); err == nil {
+		t.Error("expected error building queue browser with anonymous queue")
+	}
+	for _, windowSize := range []interface{}{0, 256, "10"} {
+		builder := NewQueueBrowserBuilderImpl(nil).FromConfigurationProvider(config.ReceiverPropertyMap{
+			config.ReceiverPropertyQueueBrowserWindowSize: windowSize,
+		})
+		if _, err := builder.Build(resource.QueueDurableExclusive("hello")); err == nil {
+			t.Errorf("expected error building queue browser with window size %v", windowSize)
+		}
+	}
+}
+
+func TestQueueBrowserReceiveMessage(t *testing.T) {
+	flowStarted := 0
+	var rxCallback core.RxCallback
+	internalReceiver := &mockInternalReceiver{}
+	internalReceiver.newPersistentReceiver = func(props []string, callback core.RxCallback, eventCallback core.PersistentEventCallback) (core.PersistentReceiver, *ccsmp.SolClientErrorInfoWrapper) {
+		rxCallback = callback
+		return &mockPersistentReceiver{
+			start: func() *ccsmp.SolClientErrorInfoWrapper {
+				flowStarted++
+				return nil
+			},
+		}, nil
+	}
+	browser, err := NewQueueBrowserBuilderImpl(internalReceiver).Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := browser.ReceiveMessage(0); err == nil {
+		t.Error("expected error receiving message before start")
+	}
+	if err := browser.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if flowStarted != 0 {
+		t.Error("expected browser flow to remain stopped until a message is requested")
+	}
+	if _, err := browser.ReceiveMessage(10 * time.Millisecond); err == nil {
+		t.Error("expected timeout error when no messages are available")
+	} else if _, ok := err.(*solace.TimeoutError); !ok {
+		t.Errorf("expected TimeoutError, got %T", err)
+	}
+	if flowStarted != 1 {
+		t.Errorf("expected browser flow to be started once, got %d", flowStarted)
+	}
+	msgP, errInfo := ccsmp.SolClientMessageAlloc()
+	if errInfo != nil {
+		t.Fatal(errInfo)
+	}
+	if !rxCallback(msgP) {
+		t.Error("expected browser to take the message")
+	}
+	msg, err := browser.ReceiveMessage(10 * time.Millisecond)
+	if err != nil {
+		t.Fatalf("expected to receive browsed message, got %s", err)
+	}
+	msg.Dispose()
+	if flowStarted != 1 {
+		t.Error("expected buffered message to be delivered without restarting the flow")
+	}
+	if err := browser.Terminate(0); err != nil {
+		t.Errorf("expected terminate to succeed, got %s", err)
+	}
+	if _, err := browser.ReceiveMessage(0); err == nil {
+		t.Error("expected error receiving message after terminate")
+	}
+}
+
+func TestQueueBrowserReceiveMessageWithContext(t *testing.T) {
+	internalReceiver := &mockInternalReceiver{}
+	internalReceiver.newPersistentReceiver = func(props []string, callback core.RxCallback, eventCallback core.PersistentEventCallback) (core.PersistentReceiver, *ccsmp.SolClientErrorInfoWrapper) {
+		return &mockPersistentReceiver{}, nil
+	}
+	browser, err := NewQueueBrowserBuilderImpl(internalReceiver).Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := browser.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer browser.Terminate(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := browser.ReceiveMessageWithContext(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestQueueBrowserTerminateInterruptsReceive(t *testing.T) {
+	browser, err := NewQueueBrowserBuilderImpl(&mockInternalReceiver{}).Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := browser.Start(); err != nil {
+		t.Fatal(err)
+	}
+	result := make(chan error, 1)
+	go func() {
+		_, err := browser.ReceiveMessage(-1)
+		result <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	browser.Terminate(0)
+	select {
+	case err := <-result:
+		if _, ok := err.(*solace.IllegalStateError); !ok {
+			t.Errorf("expected IllegalStateError, got %T", err)
+		}
+	case <-time.After(time.Second):
+		t.Error("timed out waiting for ReceiveMessage to be interrupted")
+	}
+}
+
+func TestQueueBrowserRemoveInBadState(t *testing.T) {
+	browser, err := NewQueueBrowserBuilderImpl(&mockInternalReceiver{}).Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := browser.Remove(nil); err == nil {
+		t.Error("expected error removing message before start")
+	}
+	browser.Start()
+	if err := browser.Remove(nil); err == nil {
+		t.Error("expected error removing a message that was not browsed")
+	} else if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected IllegalArgumentError, got %T", err)
+	}
+	browser.Terminate(0)
+	if err := browser.Remove(nil); err == nil {
+		t.Error("expected error removing message after terminate")
+	} else if _, ok := err.(*solace.IllegalStateError); !ok {
+		t.Errorf("expected IllegalStateError, got %T", err)
+	}
+}
+
+func TestQueueBrowserUnsolicitedTermination(t *testing.T) {
+	var eventCallback core.PersistentEventCallback
+	internalReceiver := &mockInternalReceiver{}
+	internalReceiver.newPersistentReceiver = func(props []string, callback core.RxCallback, flowEventCallback core.PersistentEventCallback) (core.PersistentReceiver, *ccsmp.SolClientErrorInfoWrapper) {
+		eventCallback = flowEventCallback
+		return &mockPersistentReceiver{}, nil
+	}
+	browser, err := NewQueueBrowserBuilderImpl(internalReceiver).Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := browser.Start(); err != nil {
+		t.Fatal(err)
+	}
+	terminated := make(chan solace.TerminationEvent, 1)
+	browser.SetTerminationNotificationListener(func(event solace.TerminationEvent) {
+		terminated <- event
+	})
+	eventCallback(ccsmp.SolClientFlowEventDownError, mockEvent{})
+	select {
+	case <-terminated:
+		if !browser.IsTerminated() {
+			t.Error("expected browser to be terminated")
+		}
+	case <-time.After(time.Second):
+		t.Error("timed out waiting for termination notification")
+	}
+}
@@ -0,0 +1,102 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package receiver
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+// receiverMetricsByNextGenMetric maps the session wide metrics incremented by receivers
+// to the scoped receiver metrics maintained alongside them
+var receiverMetricsByNextGenMetric = map[core.NextGenMetric]metrics.ReceiverMetric{
+	core.MetricReceivedMessagesTerminationDiscarded:  metrics.ReceiverMessagesTerminationDiscarded,
+	core.MetricReceivedMessagesBackpressureDiscarded: metrics.ReceiverMessagesBackpressureDiscarded,
+	core.MetricInternalDiscardNotifications:          metrics.ReceiverInternalDiscardNotifications,
+}
+
+// receiverMetricsBySettlementOutcome maps settlement outcomes to the receiver metric counting them
+var receiverMetricsBySettlementOutcome = map[config.MessageSettlementOutcome]metrics.ReceiverMetric{
+	config.PersistentReceiverAcceptedOutcome: metrics.ReceiverMessagesAcknowledged,
+	config.PersistentReceiverFailedOutcome:   metrics.ReceiverMessagesFailed,
+	config.PersistentReceiverRejectedOutcome: metrics.ReceiverMessagesRejected,
+}
+
+// receiverMetricsImpl holds the metrics of a single receiver
+type receiverMetricsImpl struct {
+	values [metrics.ReceiverMetricCount]uint64
+}
+
+// GetValue will retrieve the value/count of the given ReceiverMetric.
+func (receiverMetrics *receiverMetricsImpl) GetValue(metric metrics.ReceiverMetric) uint64 {
+	if metric < 0 || int(metric) >= metrics.ReceiverMetricCount {
+		return 0
+	}
+	return atomic.LoadUint64(&receiverMetrics.values[metric])
+}
+
+// Reset will reset all receiver metrics.
+func (receiverMetrics *receiverMetricsImpl) Reset() {
+	for i := range receiverMetrics.values {
+		atomic.StoreUint64(&receiverMetrics.values[i], 0)
+	}
+}
+
+func (receiverMetrics *receiverMetricsImpl) increment(metric metrics.ReceiverMetric, amount uint64) {
+	atomic.AddUint64(&receiverMetrics.values[metric], amount)
+}
+
+func (receiverMetrics *receiverMetricsImpl) String() string {
+	return fmt.Sprintf("metrics.ReceiverMetrics at %p", receiverMetrics)
+}
+
+// Metrics returns the metrics of this receiver.
+func (receiver *basicMessageReceiver) Metrics() metrics.ReceiverMetrics {
+	return &receiver.metrics
+}
+
+// incrementMetric increments the given session wide metric and the receiver metric maintained alongside it
+func (receiver *basicMessageReceiver) incrementMetric(metric core.NextGenMetric, amount uint64) {
+	receiver.internalReceiver.IncrementMetric(metric, amount)
+	if receiverMetric, ok := receiverMetricsByNextGenMetric[metric]; ok {
+		receiver.metrics.increment(receiverMetric, amount)
+	}
+}
+
+// recordReceive updates the receiver metrics with the given message delivered to the application
+func (receiver *basicMessageReceiver) recordReceive(msg *message.InboundMessageImpl) {
+	receiver.metrics.increment(metrics.ReceiverMessagesReceived, 1)
+	receiver.metrics.increment(metrics.ReceiverBytesReceived, message.GetInboundMessagePayloadSize(msg))
+	if senderTimestamp, ok := msg.GetSenderTimestamp(); ok {
+		// messages timestamped ahead of the local clock are not measured, see metrics.EndToEndLatency
+		if latency := time.Since(senderTimestamp); latency >= 0 {
+			receiver.internalReceiver.RecordLatency(metrics.EndToEndLatency, latency)
+		}
+	}
+}
+
+// recordSettle updates the receiver metrics with a message successfully settled with the given outcome
+func (receiver *basicMessageReceiver) recordSettle(outcome config.MessageSettlementOutcome) {
+	if receiverMetric, ok := receiverMetricsBySettlementOutcome[outcome]; ok {
+		receiver.metrics.increment(receiverMetric, 1)
+	}
+}
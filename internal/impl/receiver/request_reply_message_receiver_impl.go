@@ -17,6 +17,7 @@
 package receiver
 
 import (
+	"context"
 	"fmt"
 	"runtime"
 	"time"
@@ -28,6 +29,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace"
 	"solace.dev/go/messaging/pkg/solace/config"
 	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
@@ -110,6 +112,14 @@ func (receiver *requestReplyMessageReceiverImpl) Terminate(gracePeriod time.Dura
 	return receiver.directReceiver.Terminate(gracePeriod)
 }
 
+// TerminateWithContext will terminate the service gracefully and synchronously.
+// A graceful shutdown will be attempted until the given context is done, at which point
+// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+// This function blocks until the service is terminated.
+func (receiver *requestReplyMessageReceiverImpl) TerminateWithContext(ctx context.Context) error {
+	return receiver.directReceiver.TerminateWithContext(ctx)
+}
+
 // TerminateAsync will terminate the service asynchronously.
 // This function is idempotent. The only way to resume operation
 // after this function is called is to create a new instance.
@@ -169,8 +179,29 @@ func (receiver *requestReplyMessageReceiverImpl) RemoveSubscriptionAsync(subscri
 	return receiver.directReceiver.RemoveSubscriptionAsync(subscription, listener)
 }
 
+// Metrics returns the metrics of this receiver.
+func (receiver *requestReplyMessageReceiverImpl) Metrics() metrics.ReceiverMetrics {
+	return receiver.directReceiver.Metrics()
+}
+
+// DecodePayload decodes the payload of the given request into value with the codec matching
+// the HTTP content type or application message type of the request.
+func (receiver *requestReplyMessageReceiverImpl) DecodePayload(msg apimessage.InboundMessage, value interface{}) error {
+	return receiver.directReceiver.DecodePayload(msg, value)
+}
+
 func (receiver *requestReplyMessageReceiverImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, solace.Replier, error) {
-	inboundMessage, err := receiver.directReceiver.ReceiveMessage(timeout)
+	return receiver.receiveMessage(context.Background(), timeout)
+}
+
+// ReceiveMessageWithContext receives a request message synchronously from the receiver, waiting
+// until a message is available or the given context is done.
+func (receiver *requestReplyMessageReceiverImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, solace.Replier, error) {
+	return receiver.receiveMessage(ctx, -1)
+}
+
+func (receiver *requestReplyMessageReceiverImpl) receiveMessage(ctx context.Context, timeout time.Duration) (apimessage.InboundMessage, solace.Replier, error) {
+	inboundMessage, err := receiver.directReceiver.receiveMessage(ctx, timeout)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -299,6 +330,12 @@ func (builder *requestReplyMessageReceiverBuilderImpl) BuildWithSharedSubscripti
 	return rrReceiver, nil
 }
 
+// WithCodec registers the given codec to decode request payloads with DecodePayload.
+func (builder *requestReplyMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.RequestReplyMessageReceiverBuilder {
+	builder.directReceiverBuilder.WithCodec(codec)
+	return builder
+}
+
 // FromConfigurationProvider will configure the request reply receiver with the given properties.
 // Built in ReceiverPropertiesConfigurationProvider implementations include:
 //
@@ -0,0 +1,227 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"fmt"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/pkg/solace"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// topicRoute is a route added with AddRoute. Each route is subscribed to with its own dispatch such that
+// CCSMP dispatches the messages matching the route's subscription directly to the route.
+type topicRoute struct {
+	pattern *resource.TopicPattern
+	handler solace.TopicRouteHandler
+	// topic is the subscription as subscribed, including the share name of a shared receiver
+	topic    string
+	dispatch uintptr
+}
+
+// handle calls the handler of the route with the named levels captured from the topic of the message
+func (route *topicRoute) handle(msg apimessage.InboundMessage) {
+	route.handler(msg, route.pattern.Capture(msg.GetDestinationName()))
+}
+
+// AddRoute adds a subscription to the given topic pattern and routes the messages received on it to the given handler.
+// Will block until the subscription is added.
+// Returns a solace/errors.*IllegalStateError if the receiver is not running.
+// Returns a solace/errors.*IllegalArgumentError if the topic pattern is invalid or already has a route.
+func (receiver *directMessageReceiverImpl) AddRoute(pattern string, handler solace.TopicRouteHandler) error {
+	currentState := receiver.getState()
+	if currentState != messageReceiverStateStarted {
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, messageReceiverStateNames[currentState]), nil)
+	}
+	topicPattern, err := resource.ParseTopicPattern(pattern)
+	if err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+	}
+	if handler == nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.TopicRouteHandlerNil, pattern), nil)
+	}
+	route := &topicRoute{
+		pattern: topicPattern,
+		handler: handler,
+		topic:   receiver.buildSubscription(topicPattern.Subscription()),
+	}
+	result, err := receiver.addRoute(route)
+	if err != nil {
+		return err
+	}
+	if receiver.logger.IsDebugEnabled() {
+		receiver.logger.Debug("AddRoute awaiting confirm on subscription '" + route.topic + "'")
+	}
+	event := <-result
+	if event.GetError() != nil {
+		if receiver.logger.IsDebugEnabled() {
+			receiver.logger.Debug("AddRoute received error on subscription '" + route.topic + "': " + event.GetError().Error())
+		}
+		receiver.dropRoute(route)
+		return event.GetError()
+	}
+	// routed messages are delivered by the receiver loop even if no callback is registered with ReceiveAsync
+	select {
+	case receiver.rxCallbackSet <- true:
+		// success
+	default:
+		// we do not want to block if there is already a queued notification
+	}
+	return nil
+}
+
+func (receiver *directMessageReceiverImpl) addRoute(route *topicRoute) (<-chan core.SubscriptionEvent, error) {
+	// Acquire the termination lock such that we are not terminating over the course of subscription addition
+	receiver.subscriptionTerminationLock.RLock()
+	defer receiver.subscriptionTerminationLock.RUnlock()
+
+	// Check the state again after acquiring the lock to make sure that we did not just terminate
+	currentState := receiver.getState()
+	if currentState != messageReceiverStateStarted {
+		return nil, solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, messageReceiverStateNames[currentState]), nil)
+	}
+
+	route.dispatch = receiver.internalReceiver.RegisterRXCallback(func(msg core.Receivable) bool {
+		return receiver.bufferMessage(msg, route)
+	})
+	receiver.subscriptionsLock.Lock()
+	if _, ok := receiver.routes[route.pattern.GetName()]; ok {
+		receiver.subscriptionsLock.Unlock()
+		receiver.internalReceiver.UnregisterRXCallback(route.dispatch)
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.TopicRouteAlreadyAdded, route.pattern.GetName()), nil)
+	}
+	receiver.routes[route.pattern.GetName()] = route
+	receiver.subscriptionsLock.Unlock()
+
+	receiver.subscriptionsSynchronizationLock.Lock()
+	_, result, internalErr := receiver.internalReceiver.Subscribe(route.topic, route.dispatch)
+	receiver.subscriptionsSynchronizationLock.Unlock()
+	if internalErr != nil {
+		receiver.dropRoute(route)
+		return nil, core.ToNativeError(internalErr)
+	}
+	return result, nil
+}
+
+// dropRoute removes a route that failed to subscribe
+func (receiver *directMessageReceiverImpl) dropRoute(route *topicRoute) {
+	receiver.subscriptionsLock.Lock()
+	if receiver.routes[route.pattern.GetName()] == route {
+		delete(receiver.routes, route.pattern.GetName())
+	}
+	receiver.subscriptionsLock.Unlock()
+	receiver.internalReceiver.UnregisterRXCallback(route.dispatch)
+}
+
+// RemoveRoute removes the route added with the given topic pattern and its subscription.
+// Will block until the subscription is removed.
+// Returns a solace/errors.*IllegalStateError if the receiver is not running.
+// Returns a solace/errors.*IllegalArgumentError if the topic pattern has no route.
+func (receiver *directMessageReceiverImpl) RemoveRoute(pattern string) error {
+	currentState := receiver.getState()
+	if currentState != messageReceiverStateStarted {
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, messageReceiverStateNames[currentState]), nil)
+	}
+	route, result, err := receiver.removeRoute(pattern)
+	if err != nil {
+		return err
+	}
+	if receiver.logger.IsDebugEnabled() {
+		receiver.logger.Debug("RemoveRoute awaiting confirm on subscription '" + route.topic + "'")
+	}
+	event := <-result
+	if event.GetError() != nil && receiver.logger.IsDebugEnabled() {
+		receiver.logger.Debug("RemoveRoute received error on subscription '" + route.topic + "': " + event.GetError().Error())
+	}
+	receiver.internalReceiver.UnregisterRXCallback(route.dispatch)
+	return event.GetError()
+}
+
+func (receiver *directMessageReceiverImpl) removeRoute(pattern string) (*topicRoute, <-chan core.SubscriptionEvent, error) {
+	// Acquire the termination lock such that we are not terminating over the course of subscription removal
+	receiver.subscriptionTerminationLock.RLock()
+	defer receiver.subscriptionTerminationLock.RUnlock()
+
+	// Check the state again after acquiring the lock to make sure that we did not just terminate
+	currentState := receiver.getState()
+	if currentState != messageReceiverStateStarted {
+		return nil, nil, solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, messageReceiverStateNames[currentState]), nil)
+	}
+
+	receiver.subscriptionsLock.Lock()
+	route, ok := receiver.routes[pattern]
+	if !ok {
+		receiver.subscriptionsLock.Unlock()
+		return nil, nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.TopicRouteNotFound, pattern), nil)
+	}
+	delete(receiver.routes, pattern)
+	receiver.subscriptionsLock.Unlock()
+
+	receiver.subscriptionsSynchronizationLock.Lock()
+	_, result, internalErr := receiver.internalReceiver.Unsubscribe(route.topic, route.dispatch)
+	receiver.subscriptionsSynchronizationLock.Unlock()
+	if internalErr != nil {
+		// the route is still subscribed to, restore it such that it can be removed again
+		receiver.subscriptionsLock.Lock()
+		if _, ok := receiver.routes[pattern]; !ok {
+			receiver.routes[pattern] = route
+		}
+		receiver.subscriptionsLock.Unlock()
+		return nil, nil, core.ToNativeError(internalErr)
+	}
+	return route, result, nil
+}
+
+// cleanupRoutes removes the subscriptions of all routes, the subscription termination lock must be held
+func (receiver *directMessageReceiverImpl) cleanupRoutes() {
+	receiver.subscriptionsLock.Lock()
+	routes := make([]*topicRoute, 0, len(receiver.routes))
+	for _, route := range receiver.routes {
+		routes = append(routes, route)
+	}
+	receiver.subscriptionsLock.Unlock()
+	results := make([]<-chan core.SubscriptionEvent, len(routes))
+	for i, route := range routes {
+		_, result, err := receiver.internalReceiver.Unsubscribe(route.topic, route.dispatch)
+		if err != nil {
+			receiver.logger.Error("encountered error unsubscribing from route topic in direct receiver terminate: " + err.GetMessageAsString())
+		} else {
+			results[i] = result
+		}
+	}
+	for i, result := range results {
+		if result != nil {
+			event := <-result
+			if event.GetError() != nil {
+				receiver.logger.Debug("Failed to unsubscribe from route topic '" + routes[i].topic +
+					"' when cleaning up subscriptions: " + event.GetError().Error())
+			}
+		}
+	}
+}
+
+// unregisterRoutes removes the dispatch callbacks of all routes from the internal receiver
+func (receiver *directMessageReceiverImpl) unregisterRoutes() {
+	receiver.subscriptionsLock.Lock()
+	defer receiver.subscriptionsLock.Unlock()
+	for _, route := range receiver.routes {
+		receiver.internalReceiver.UnregisterRXCallback(route.dispatch)
+	}
+}
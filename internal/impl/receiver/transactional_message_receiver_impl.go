@@ -0,0 +1,189 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"fmt"
+	"strconv"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/validation"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// transactionalMessageReceiverImpl is a persistent receiver bound to a flow on a transacted session.
+// Messages are never acknowledged by the receiver, they are settled when the transaction is
+// committed or rolled back.
+type transactionalMessageReceiverImpl struct {
+	*persistentMessageReceiverImpl
+}
+
+// StartAsyncCallback will start the TransactionalMessageReceiver asynchronously.
+// Calls the callback when started with an error if one occurred or nil
+// if successful.
+func (receiver *transactionalMessageReceiverImpl) StartAsyncCallback(callback func(solace.TransactionalMessageReceiver, error)) {
+	go func() {
+		callback(receiver, receiver.Start())
+	}()
+}
+
+func (receiver *transactionalMessageReceiverImpl) String() string {
+	return fmt.Sprintf("solace.TransactionalMessageReceiver at %p", receiver)
+}
+
+type transactionalMessageReceiverBuilderImpl struct {
+	internalReceiver  core.Receiver
+	transactedSession core.TransactedSession
+	onBuild           func(solace.LifecycleControl)
+	properties        map[config.ReceiverProperty]interface{}
+	codecs            []solace.Codec
+}
+
+// NewTransactionalMessageReceiverBuilderImpl function. The transacted session is nil when the
+// transactional messaging service is not connected, and onBuild is called with each built receiver.
+func NewTransactionalMessageReceiverBuilderImpl(internalReceiver core.Receiver, transactedSession core.TransactedSession, onBuild func(solace.LifecycleControl)) solace.TransactionalMessageReceiverBuilder {
+	return &transactionalMessageReceiverBuilderImpl{
+		internalReceiver:  internalReceiver,
+		transactedSession: transactedSession,
+		onBuild:           onBuild,
+		properties:        constants.DefaultPersistentReceiverProperties.GetConfiguration(),
+	}
+}
+
+// Build will build a new TransactionalMessageReceiver bound to the given queue with the given properties.
+// Returns solace/errors.*IllegalStateError if the transactional messaging service is not connected.
+// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+func (builder *transactionalMessageReceiverBuilderImpl) Build(queue *resource.Queue) (messageReceiver solace.TransactionalMessageReceiver, err error) {
+	if queue == nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverMissingQueue, nil)
+	}
+	if builder.transactedSession == nil {
+		return nil, solace.NewError(&solace.IllegalStateError{}, constants.TransactionalServiceNotConnected, nil)
+	}
+
+	const bufferHighwaterDefault = 50
+	const bufferLowwaterDefault = 40
+
+	var doCreateMissingResource bool = false
+	if strategy, ok := builder.properties[config.ReceiverPropertyPersistentMissingResourceCreationStrategy]; ok {
+		if strategyAsMissingResourceCreationStrategy, ok := strategy.(config.MissingResourcesCreationStrategy); ok {
+			strategy = string(strategyAsMissingResourceCreationStrategy)
+		}
+		prop, present, err := validation.StringPropertyValidation(string(config.ReceiverPropertyPersistentMissingResourceCreationStrategy), strategy,
+			string(config.PersistentReceiverCreateOnStartMissingResources), string(config.PersistentReceiverDoNotCreateMissingResources))
+		if present {
+			if err != nil {
+				return nil, err
+			}
+			doCreateMissingResource = prop == string(config.PersistentReceiverCreateOnStartMissingResources)
+		}
+	}
+
+	// Transacted flows are always client acknowledged by the transaction, ccsmp rejects the ack mode properties
+	var properties []string = []string{
+		// set the active flow indicator to enabled
+		ccsmp.SolClientFlowPropActiveFlowInd, ccsmp.SolClientPropEnableVal,
+		// start the flow in the 'stopped' state, SOL-63525
+		ccsmp.SolClientFlowPropStartState, ccsmp.SolClientPropDisableVal,
+	}
+	properties = append(properties, queueEndpointProperties(queue)...)
+
+	if selector, ok := builder.properties[config.ReceiverPropertyPersistentMessageSelectorQuery]; ok {
+		prop, present, err := validation.StringPropertyValidation(string(config.ReceiverPropertyPersistentMessageSelectorQuery), selector)
+		if present {
+			if err != nil {
+				return nil, err
+			}
+			properties = append(properties, ccsmp.SolClientFlowPropSelector, prop)
+		}
+	}
+
+	windowSize, present, err := validation.IntegerPropertyValidationWithRange(string(config.ReceiverPropertyPersistentFlowWindowSize),
+		builder.properties[config.ReceiverPropertyPersistentFlowWindowSize], 1, 255)
+	if present {
+		if err != nil {
+			return nil, err
+		}
+		properties = append(properties, ccsmp.SolClientFlowPropWindowsize, strconv.Itoa(windowSize))
+	}
+
+	receiver := &persistentMessageReceiverImpl{}
+	receiver.construct(&persistentMessageReceiverProps{
+		flowProperties:          properties,
+		internalReceiver:        builder.internalReceiver,
+		transactedSession:       builder.transactedSession,
+		endpoint:                queue,
+		bufferHighwater:         bufferHighwaterDefault,
+		bufferLowwater:          bufferLowwaterDefault,
+		doCreateMissingResource: doCreateMissingResource,
+		codecs:                  builder.codecs,
+	})
+	transactionalReceiver := &transactionalMessageReceiverImpl{receiver}
+	if builder.onBuild != nil {
+		builder.onBuild(transactionalReceiver)
+	}
+	return transactionalReceiver, nil
+}
+
+// WithMessageSelector will set the message selector to the given string.
+// If an empty string is given, the filter will be cleared.
+func (builder *transactionalMessageReceiverBuilderImpl) WithMessageSelector(filterSelectorExpression string) solace.TransactionalMessageReceiverBuilder {
+	if filterSelectorExpression == "" {
+		delete(builder.properties, config.ReceiverPropertyPersistentMessageSelectorQuery)
+	} else {
+		builder.properties[config.ReceiverPropertyPersistentMessageSelectorQuery] = filterSelectorExpression
+	}
+	return builder
+}
+
+// WithCodec registers the given codec to decode message payloads with DecodePayload.
+func (builder *transactionalMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.TransactionalMessageReceiverBuilder {
+	builder.codecs = append(builder.codecs, codec)
+	return builder
+}
+
+// WithFlowWindowSize sets the maximum number of messages that can be in transit from the broker.
+func (builder *transactionalMessageReceiverBuilderImpl) WithFlowWindowSize(windowSize uint) solace.TransactionalMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowWindowSize] = windowSize
+	return builder
+}
+
+// WithMissingResourcesCreationStrategy sets the missing resource creation strategy
+// defining what actions the API may take when missing resources are detected.
+func (builder *transactionalMessageReceiverBuilderImpl) WithMissingResourcesCreationStrategy(strategy config.MissingResourcesCreationStrategy) solace.TransactionalMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentMissingResourceCreationStrategy] = strategy
+	return builder
+}
+
+// FromConfigurationProvider will set the given properties to the resulting message receiver.
+func (builder *transactionalMessageReceiverBuilderImpl) FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) solace.TransactionalMessageReceiverBuilder {
+	if provider == nil {
+		return builder
+	}
+	for key, value := range provider.GetConfiguration() {
+		builder.properties[key] = value
+	}
+	return builder
+}
+
+func (builder *transactionalMessageReceiverBuilderImpl) String() string {
+	return fmt.Sprintf("solace.TransactionalMessageReceiverBuilder at %p", builder)
+}
@@ -0,0 +1,114 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package receiver
+
+import (
+	"testing"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+func TestTransactionalBuilderRequiresConnectedService(t *testing.T) {
+	builder := NewTransactionalMessageReceiverBuilderImpl(&mockInternalReceiver{}, nil, nil)
+	_, err := builder.Build(resource.QueueDurableExclusive("hello"))
+	if _, ok := err.(*solace.IllegalStateError); !ok {
+		t.Errorf("expected illegal state error building without a transacted session, got %T", err)
+	}
+}
+
+func TestTransactionalBuilderWithNilQueue(t *testing.T) {
+	builder := NewTransactionalMessageReceiverBuilderImpl(&mockInternalReceiver{}, &mockTransactedSession{}, nil)
+	_, err := builder.Build(nil)
+	if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected illegal argument error building with a nil queue, got %T", err)
+	}
+}
+
+func TestTransactionalBuilderFlowProperties(t *testing.T) {
+	var built solace.LifecycleControl
+	transactedSession := &mockTransactedSession{}
+	builder := NewTransactionalMessageReceiverBuilderImpl(&mockInternalReceiver{}, transactedSession, func(child solace.LifecycleControl) {
+		built = child
+	})
+	receiver, err := builder.WithFlowWindowSize(10).WithMessageSelector("a = 1").Build(resource.QueueDurableExclusive("hello"))
+	if err != nil {
+		t.Fatalf("did not expect to get an error when building with valid properties, got %s", err)
+	}
+	if built != receiver {
+		t.Error("expected the built receiver to be passed to the build callback")
+	}
+	receiverImpl := receiver.(*transactionalMessageReceiverImpl)
+	if receiverImpl.transactedSession != transactedSession {
+		t.Error("expected receiver to be bound to the transacted session")
+	}
+	flowProperties := make(map[string]string)
+	for i := 0; i+1 < len(receiverImpl.internalFlowProperties); i += 2 {
+		flowProperties[receiverImpl.internalFlowProperties[i]] = receiverImpl.internalFlowProperties[i+1]
+	}
+	expected := map[string]string{
+		ccsmp.SolClientFlowPropBindName:   "hello",
+		ccsmp.SolClientFlowPropWindowsize: "10",
+		ccsmp.SolClientFlowPropSelector:   "a = 1",
+	}
+	for key, value := range expected {
+		if flowProperties[key] != value {
+			t.Errorf("expected flow property %s to equal %s, got %s", key, value, flowProperties[key])
+		}
+	}
+	// transacted flows do not support ack modes
+	for _, unsupported := range []string{ccsmp.SolClientFlowPropAckmode, ccsmp.SolClientFlowPropMaxUnackedMessages} {
+		if _, ok := flowProperties[unsupported]; ok {
+			t.Errorf("expected flow property %s to not be set on a transacted flow", unsupported)
+		}
+	}
+}
+
+func TestTransactionalBuilderWithInvalidWindowSize(t *testing.T) {
+	builder := NewTransactionalMessageReceiverBuilderImpl(&mockInternalReceiver{}, &mockTransactedSession{}, nil)
+	builder.FromConfigurationProvider(config.ReceiverPropertyMap{
+		config.ReceiverPropertyPersistentFlowWindowSize: 256,
+	})
+	if _, err := builder.Build(resource.QueueDurableExclusive("hello")); err == nil {
+		t.Error("expected error building with an out of range window size")
+	}
+}
+
+type mockTransactedSession struct{}
+
+func (session *mockTransactedSession) Publish(message core.Publishable) core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) NewPersistentReceiver(properties []string, callback core.RxCallback, eventCallback core.PersistentEventCallback) (core.PersistentReceiver, core.ErrorInfo) {
+	return &mockPersistentReceiver{}, nil
+}
+
+func (session *mockTransactedSession) Commit() core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) Rollback() core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) Destroy() core.ErrorInfo {
+	return nil
+}
@@ -0,0 +1,191 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impl
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/internal/impl/publisher"
+	"solace.dev/go/messaging/internal/impl/receiver"
+	"solace.dev/go/messaging/pkg/solace"
+)
+
+type transactionalMessagingServiceImpl struct {
+	messagingService *messagingServiceImpl
+	properties       []string
+	logger           logging.LogLevelLogger
+
+	// lock guards the transacted session and the publishers and receivers created on it.
+	// Commit and Rollback hold the read lock across the native call such that Disconnect,
+	// which holds the write lock, waits for them before destroying the session.
+	lock              sync.RWMutex
+	transactedSession core.TransactedSession
+	children          []solace.LifecycleControl
+}
+
+// Connect connects the transactional messaging service by creating a transacted session
+// on the parent messaging service. This function is idempotent.
+func (service *transactionalMessagingServiceImpl) Connect() error {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	if service.transactedSession != nil {
+		return nil
+	}
+	if !service.messagingService.IsConnected() {
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToConnectTransactionalServiceParentNotConnected, nil)
+	}
+	transactedSession, errInfo := service.messagingService.transport.NewTransactedSession(service.properties)
+	if errInfo != nil {
+		return core.ToNativeError(errInfo, constants.FailedToCreateTransactedSession)
+	}
+	service.transactedSession = transactedSession
+	return nil
+}
+
+// Disconnect disconnects the transactional messaging service, terminating all publishers
+// and receivers created from it and rolling back any uncommitted transaction.
+// This function is idempotent.
+func (service *transactionalMessagingServiceImpl) Disconnect() error {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	if service.transactedSession == nil {
+		return nil
+	}
+	// the flows of the receivers are destroyed along with the transacted session, terminate them first
+	for _, child := range service.children {
+		if err := child.Terminate(0); err != nil && service.logger.IsDebugEnabled() {
+			service.logger.Debug(fmt.Sprintf("Error while terminating %v on disconnect: %s", child, err))
+		}
+	}
+	service.children = nil
+	errInfo := service.transactedSession.Destroy()
+	service.transactedSession = nil
+	if errInfo != nil {
+		return core.ToNativeError(errInfo, "an error occurred while destroying the transacted session: ")
+	}
+	return nil
+}
+
+// IsConnected determines if the transactional messaging service is connected.
+func (service *transactionalMessagingServiceImpl) IsConnected() bool {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+	return service.transactedSession != nil
+}
+
+// CreateTransactionalMessagePublisherBuilder creates a new transactional message publisher builder
+// that can be used to configure transactional message publisher instances.
+func (service *transactionalMessagingServiceImpl) CreateTransactionalMessagePublisherBuilder() solace.TransactionalMessagePublisherBuilder {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	return publisher.NewTransactionalMessagePublisherBuilderImpl(service.messagingService.transport.Publisher(), service.transactedSession, service.addChild)
+}
+
+// CreateTransactionalMessageReceiverBuilder creates a new transactional message receiver builder
+// that can be used to configure transactional message receiver instances.
+func (service *transactionalMessagingServiceImpl) CreateTransactionalMessageReceiverBuilder() solace.TransactionalMessageReceiverBuilder {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	return receiver.NewTransactionalMessageReceiverBuilderImpl(service.messagingService.transport.Receiver(), service.transactedSession, service.addChild)
+}
+
+// Commit commits the current transaction. If the broker rolled back the transaction instead,
+// a solace.NativeError carrying the subcode of the rollback reason is returned.
+func (service *transactionalMessagingServiceImpl) Commit() error {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+	if service.transactedSession == nil {
+		return solace.NewError(&solace.IllegalStateError{}, constants.TransactionalServiceNotConnected, nil)
+	}
+	if errInfo := service.transactedSession.Commit(); errInfo != nil {
+		if errInfo.ReturnCode == ccsmp.SolClientReturnCodeRollback {
+			return core.ToNativeError(errInfo, constants.TransactionRolledBack)
+		}
+		return core.ToNativeError(errInfo, constants.FailedToCommitTransaction)
+	}
+	return nil
+}
+
+// Rollback rolls back the current transaction.
+func (service *transactionalMessagingServiceImpl) Rollback() error {
+	service.lock.RLock()
+	defer service.lock.RUnlock()
+	if service.transactedSession == nil {
+		return solace.NewError(&solace.IllegalStateError{}, constants.TransactionalServiceNotConnected, nil)
+	}
+	if errInfo := service.transactedSession.Rollback(); errInfo != nil {
+		return core.ToNativeError(errInfo, constants.FailedToRollbackTransaction)
+	}
+	return nil
+}
+
+func (service *transactionalMessagingServiceImpl) addChild(child solace.LifecycleControl) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	service.children = append(service.children, child)
+}
+
+func (service *transactionalMessagingServiceImpl) String() string {
+	return fmt.Sprintf("solace.TransactionalMessagingService at %p", service)
+}
+
+type transactionalMessagingServiceBuilderImpl struct {
+	messagingService *messagingServiceImpl
+	requestTimeout   time.Duration
+}
+
+// minimum request timeout of a transacted session
+const minTransactedSessionRequestTimeout = time.Second
+
+// Build will build a new TransactionalMessagingService sharing the connection of the parent messaging service.
+// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+func (builder *transactionalMessagingServiceBuilderImpl) Build() (solace.TransactionalMessagingService, error) {
+	// publishers are created on demand, but the transacted session must own a publisher flow to send messages
+	properties := []string{
+		ccsmp.SolClientTransactedSessionPropHasPublisher, ccsmp.SolClientPropEnableVal,
+	}
+	if builder.requestTimeout != 0 {
+		if builder.requestTimeout < minTransactedSessionRequestTimeout {
+			return nil, solace.NewError(&solace.InvalidConfigurationError{},
+				fmt.Sprintf("transactional request timeout must be at least %s, got %s", minTransactedSessionRequestTimeout, builder.requestTimeout), nil)
+		}
+		properties = append(properties, ccsmp.SolClientTransactedSessionPropRequestreplyTimeoutMs, strconv.FormatInt(builder.requestTimeout.Milliseconds(), 10))
+	}
+	service := &transactionalMessagingServiceImpl{
+		messagingService: builder.messagingService,
+		properties:       properties,
+	}
+	service.logger = builder.messagingService.transport.Logger().For(service)
+	return service, nil
+}
+
+// WithRequestTimeout sets the maximum time to wait for the broker to respond to transaction requests.
+func (builder *transactionalMessagingServiceBuilderImpl) WithRequestTimeout(timeout time.Duration) solace.TransactionalMessagingServiceBuilder {
+	builder.requestTimeout = timeout
+	return builder
+}
+
+func (builder *transactionalMessagingServiceBuilderImpl) String() string {
+	return fmt.Sprintf("solace.TransactionalMessagingServiceBuilder at %p", builder)
+}
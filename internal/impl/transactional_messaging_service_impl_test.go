@@ -0,0 +1,228 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impl
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/internal/ccsmp"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/logging"
+	"solace.dev/go/messaging/pkg/solace"
+)
+
+func TestTransactionalMessagingServiceConnectRequiresConnectedParent(t *testing.T) {
+	service := newMessagingServiceImpl(logging.Default)
+	service.transport = &solClientTransportMock{}
+	transactionalService, err := service.CreateTransactionalMessagingServiceBuilder().Build()
+	if err != nil {
+		t.Fatalf("expected build to succeed, got %s", err)
+	}
+	err = transactionalService.Connect()
+	if _, ok := err.(*solace.IllegalStateError); !ok {
+		t.Errorf("expected illegal state error connecting with a disconnected parent, got %T", err)
+	}
+	if transactionalService.IsConnected() {
+		t.Error("expected transactional service to not be connected")
+	}
+	if err = transactionalService.Commit(); err == nil {
+		t.Error("expected commit to fail when not connected")
+	} else if _, ok := err.(*solace.IllegalStateError); !ok {
+		t.Errorf("expected illegal state error committing while not connected, got %T", err)
+	}
+	if _, err = transactionalService.CreateTransactionalMessagePublisherBuilder().Build(); err == nil {
+		t.Error("expected publisher build to fail when not connected")
+	}
+}
+
+func TestTransactionalMessagingServiceBuilderRequestTimeout(t *testing.T) {
+	service := newMessagingServiceImpl(logging.Default)
+	mockTransport := &solClientTransportMock{}
+	service.transport = mockTransport
+	service.state = messagingServiceStateConnected
+	var sessionProperties []string
+	mockTransport.transactedSession = func(properties []string) (core.TransactedSession, core.ErrorInfo) {
+		sessionProperties = properties
+		return &mockTransactedSession{}, nil
+	}
+	if _, err := service.CreateTransactionalMessagingServiceBuilder().WithRequestTimeout(500 * time.Millisecond).Build(); err == nil {
+		t.Error("expected build to fail with a request timeout under one second")
+	} else if _, ok := err.(*solace.InvalidConfigurationError); !ok {
+		t.Errorf("expected invalid configuration error, got %T", err)
+	}
+	transactionalService, err := service.CreateTransactionalMessagingServiceBuilder().WithRequestTimeout(5 * time.Second).Build()
+	if err != nil {
+		t.Fatalf("expected build to succeed, got %s", err)
+	}
+	if err = transactionalService.Connect(); err != nil {
+		t.Fatalf("expected connect to succeed, got %s", err)
+	}
+	found := false
+	for i := 0; i+1 < len(sessionProperties); i += 2 {
+		if sessionProperties[i] == ccsmp.SolClientTransactedSessionPropRequestreplyTimeoutMs {
+			found = true
+			if sessionProperties[i+1] != "5000" {
+				t.Errorf("expected request timeout of 5000ms, got %s", sessionProperties[i+1])
+			}
+		}
+	}
+	if !found {
+		t.Error("expected request timeout to be set on the transacted session")
+	}
+}
+
+func TestTransactionalMessagingServiceCommitAndRollback(t *testing.T) {
+	service := newMessagingServiceImpl(logging.Default)
+	mockTransport := &solClientTransportMock{}
+	service.transport = mockTransport
+	service.state = messagingServiceStateConnected
+	transactedSession := &mockTransactedSession{}
+	mockTransport.transactedSession = func(properties []string) (core.TransactedSession, core.ErrorInfo) {
+		return transactedSession, nil
+	}
+	transactionalService, err := service.CreateTransactionalMessagingServiceBuilder().Build()
+	if err != nil {
+		t.Fatalf("expected build to succeed, got %s", err)
+	}
+	if err = transactionalService.Connect(); err != nil {
+		t.Fatalf("expected connect to succeed, got %s", err)
+	}
+	if !transactionalService.IsConnected() {
+		t.Error("expected transactional service to be connected")
+	}
+	if err = transactionalService.Commit(); err != nil {
+		t.Errorf("expected commit to succeed, got %s", err)
+	}
+	if err = transactionalService.Rollback(); err != nil {
+		t.Errorf("expected rollback to succeed, got %s", err)
+	}
+
+	transactedSession.commit = func() core.ErrorInfo {
+		return &ccsmp.SolClientErrorInfoWrapper{
+			ReturnCode: ccsmp.SolClientReturnCodeRollback,
+		}
+	}
+	err = transactionalService.Commit()
+	if nativeError, ok := err.(*solace.NativeError); !ok {
+		t.Errorf("expected native error on rolled back commit, got %T", err)
+	} else if !strings.HasPrefix(nativeError.Error(), "transaction was rolled back") {
+		t.Errorf("expected rolled back error, got %s", nativeError)
+	}
+
+	if err = transactionalService.Disconnect(); err != nil {
+		t.Errorf("expected disconnect to succeed, got %s", err)
+	}
+	if !transactedSession.destroyed {
+		t.Error("expected transacted session to be destroyed on disconnect")
+	}
+	if transactionalService.IsConnected() {
+		t.Error("expected transactional service to be disconnected")
+	}
+}
+
+func TestTransactionalMessagingServiceDisconnectWaitsForCommit(t *testing.T) {
+	service := newMessagingServiceImpl(logging.Default)
+	mockTransport := &solClientTransportMock{}
+	service.transport = mockTransport
+	service.state = messagingServiceStateConnected
+	transactedSession := &mockTransactedSession{}
+	mockTransport.transactedSession = func(properties []string) (core.TransactedSession, core.ErrorInfo) {
+		return transactedSession, nil
+	}
+	transactionalService, err := service.CreateTransactionalMessagingServiceBuilder().Build()
+	if err != nil {
+		t.Fatalf("expected build to succeed, got %s", err)
+	}
+	if err = transactionalService.Connect(); err != nil {
+		t.Fatalf("expected connect to succeed, got %s", err)
+	}
+
+	commitCalled := make(chan struct{})
+	blockingChannel := make(chan struct{})
+	transactedSession.commit = func() core.ErrorInfo {
+		close(commitCalled)
+		<-blockingChannel
+		return nil
+	}
+	commitResult := make(chan error, 1)
+	go func() {
+		commitResult <- transactionalService.Commit()
+	}()
+	select {
+	case <-commitCalled:
+		// success
+	case <-time.After(100 * time.Millisecond):
+		t.Fatal("timed out waiting for commit to be called")
+	}
+
+	disconnectResult := make(chan error, 1)
+	go func() {
+		disconnectResult <- transactionalService.Disconnect()
+	}()
+	select {
+	case <-disconnectResult:
+		t.Error("did not expect disconnect to return while a commit is in flight")
+	case <-time.After(100 * time.Millisecond):
+		// success
+	}
+
+	close(blockingChannel)
+	for _, result := range []chan error{commitResult, disconnectResult} {
+		select {
+		case err = <-result:
+			if err != nil {
+				t.Errorf("expected success, got %s", err)
+			}
+		case <-time.After(100 * time.Millisecond):
+			t.Fatal("timed out waiting for result")
+		}
+	}
+	if !transactedSession.destroyed {
+		t.Error("expected transacted session to be destroyed on disconnect")
+	}
+}
+
+type mockTransactedSession struct {
+	commit    func() core.ErrorInfo
+	destroyed bool
+}
+
+func (session *mockTransactedSession) Publish(message core.Publishable) core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) NewPersistentReceiver(properties []string, callback core.RxCallback, eventCallback core.PersistentEventCallback) (core.PersistentReceiver, core.ErrorInfo) {
+	return nil, nil
+}
+
+func (session *mockTransactedSession) Commit() core.ErrorInfo {
+	if session.commit != nil {
+		return session.commit()
+	}
+	return nil
+}
+
+func (session *mockTransactedSession) Rollback() core.ErrorInfo {
+	return nil
+}
+
+func (session *mockTransactedSession) Destroy() core.ErrorInfo {
+	session.destroyed = true
+	return nil
+}
@@ -0,0 +1,56 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solace
+
+import (
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// Codec converts application values to and from message payloads.
+// Codecs are registered on an OutboundMessageBuilder with WithCodec to build messages
+// from values, and on receiver builders with WithCodec to decode received payloads.
+// When decoding, the codec is selected by matching its content type against the HTTP
+// content type of the message, or failing that, the application message type.
+// Built in codecs are available in the solace/codec package.
+type Codec interface {
+	// ContentType returns the content type identifying payloads encoded by the codec,
+	// for example "application/json". The HTTP content type of messages built by the
+	// codec is set to this value.
+	ContentType() string
+
+	// Encode builds an outbound message with the given builder carrying the encoded value.
+	// Accepts additional configuration providers to apply only to the built message, with the
+	// last in the list taking precedence.
+	// Returns solace/errors.*IllegalArgumentError if the value cannot be encoded by the codec.
+	Encode(builder OutboundMessageBuilder, value interface{}, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error)
+
+	// Decode decodes the payload of the given message into value, which must be a non-nil pointer.
+	// Returns solace/errors.*IllegalArgumentError if the payload cannot be decoded into value.
+	Decode(msg message.InboundMessage, value interface{}) error
+}
+
+// PayloadDecoder decodes message payloads with the codecs registered on a receiver.
+type PayloadDecoder interface {
+	// DecodePayload decodes the payload of the given message into value, which must be a
+	// non-nil pointer. The codec is selected from the codecs registered on the receiver builder
+	// with WithCodec by the HTTP content type or application message type of the message,
+	// falling back to the codecs registered in the solace/codec package.
+	// Returns solace/errors.*IllegalArgumentError if no codec matches the message or the
+	// payload cannot be decoded into value.
+	DecodePayload(msg message.InboundMessage, value interface{}) error
+}
@@ -0,0 +1,140 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// Registry is a set of codecs keyed by content type. It is safe for concurrent use.
+type Registry struct {
+	lock   sync.RWMutex
+	codecs map[string]solace.Codec
+}
+
+// NewRegistry creates a new Registry holding the given codecs.
+func NewRegistry(codecs ...solace.Codec) *Registry {
+	registry := &Registry{codecs: make(map[string]solace.Codec)}
+	for _, codec := range codecs {
+		registry.Register(codec)
+	}
+	return registry
+}
+
+// Register adds the given codec to the registry, replacing any codec registered
+// with the same content type. A nil codec is ignored.
+func (registry *Registry) Register(codec solace.Codec) {
+	if codec == nil {
+		return
+	}
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+	registry.codecs[mediaType(codec.ContentType())] = codec
+}
+
+// Lookup returns the codec registered for the given content type. Content type parameters
+// such as charset are ignored and the comparison is case insensitive.
+func (registry *Registry) Lookup(contentType string) (solace.Codec, bool) {
+	registry.lock.RLock()
+	defer registry.lock.RUnlock()
+	codec, ok := registry.codecs[mediaType(contentType)]
+	return codec, ok
+}
+
+// ForMessage returns the codec matching the HTTP content type of the given message,
+// or failing that the application message type of the message.
+func (registry *Registry) ForMessage(msg message.InboundMessage) (solace.Codec, bool) {
+	if contentType, ok := msg.GetHTTPContentType(); ok {
+		if codec, ok := registry.Lookup(contentType); ok {
+			return codec, true
+		}
+	}
+	if messageType, ok := msg.GetApplicationMessageType(); ok {
+		if codec, ok := registry.Lookup(messageType); ok {
+			return codec, true
+		}
+	}
+	return nil, false
+}
+
+// Decode decodes the payload of the given message into value with the codec returned by ForMessage.
+// Returns solace/errors.*IllegalArgumentError if no codec matches the message or the payload
+// cannot be decoded into value.
+func (registry *Registry) Decode(msg message.InboundMessage, value interface{}) error {
+	codec, ok := registry.ForMessage(msg)
+	if !ok {
+		return NoCodecError(msg)
+	}
+	return codec.Decode(msg, value)
+}
+
+// NoCodecError returns the error reported when no codec matches the given message.
+func NoCodecError(msg message.InboundMessage) error {
+	contentType, _ := msg.GetHTTPContentType()
+	messageType, _ := msg.GetApplicationMessageType()
+	return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.NoCodecForMessage, contentType, messageType), nil)
+}
+
+var defaultRegistry = NewRegistry(JSON(), SDTMap())
+
+// Register adds the given codec to the default registry used by Decode and by receivers
+// when none of the codecs registered on the receiver match a message.
+// The JSON and SDTMap codecs are registered by default.
+func Register(codec solace.Codec) {
+	defaultRegistry.Register(codec)
+}
+
+// Lookup returns the codec registered in the default registry for the given content type.
+func Lookup(contentType string) (solace.Codec, bool) {
+	return defaultRegistry.Lookup(contentType)
+}
+
+// ForMessage returns the codec in the default registry matching the given message.
+// For more information, see Registry.ForMessage.
+func ForMessage(msg message.InboundMessage) (solace.Codec, bool) {
+	return defaultRegistry.ForMessage(msg)
+}
+
+// Decode decodes the payload of the given message into value with the matching codec
+// in the default registry. For more information, see Registry.Decode.
+func Decode(msg message.InboundMessage, value interface{}) error {
+	return defaultRegistry.Decode(msg, value)
+}
+
+// WithContentType returns the given configuration preceded by the HTTP content type, such that
+// the content type can be overridden by the additional configuration. Codecs pass the result
+// to the builder in Encode to set the content type of the messages they build.
+func WithContentType(contentType string, additionalConfiguration []config.MessagePropertiesConfigurationProvider) []config.MessagePropertiesConfigurationProvider {
+	return append([]config.MessagePropertiesConfigurationProvider{
+		config.MessagePropertyMap{config.MessagePropertyHTTPContentType: contentType},
+	}, additionalConfiguration...)
+}
+
+// mediaType strips any parameters from the given content type and normalizes its case
+func mediaType(contentType string) string {
+	if i := strings.IndexByte(contentType, ';'); i >= 0 {
+		contentType = contentType[:i]
+	}
+	return strings.ToLower(strings.TrimSpace(contentType))
+}
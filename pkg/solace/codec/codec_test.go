@@ -0,0 +1,190 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec_test
+
+import (
+	"testing"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/codec"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/message/sdt"
+)
+
+// recordingBuilder captures the payload and configuration of the built message
+type recordingBuilder struct {
+	solace.OutboundMessageBuilder
+	bytes      []byte
+	sdtMap     sdt.Map
+	properties config.MessagePropertyMap
+}
+
+func (builder *recordingBuilder) record(additionalConfiguration []config.MessagePropertiesConfigurationProvider) {
+	builder.properties = make(config.MessagePropertyMap)
+	for _, provider := range additionalConfiguration {
+		for key, value := range provider.GetConfiguration() {
+			builder.properties[key] = value
+		}
+	}
+}
+
+func (builder *recordingBuilder) BuildWithByteArrayPayload(payload []byte, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	builder.bytes = payload
+	builder.record(additionalConfiguration)
+	return nil, nil
+}
+
+func (builder *recordingBuilder) BuildWithMapPayload(payload sdt.Map, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	builder.sdtMap = payload
+	builder.record(additionalConfiguration)
+	return nil, nil
+}
+
+// fakeInboundMessage returns the configured payload and headers
+type fakeInboundMessage struct {
+	message.InboundMessage
+	bytes       []byte
+	str         *string
+	sdtMap      sdt.Map
+	contentType string
+	messageType string
+}
+
+func (msg *fakeInboundMessage) GetPayloadAsBytes() ([]byte, bool) {
+	return msg.bytes, msg.bytes != nil
+}
+
+func (msg *fakeInboundMessage) GetPayloadAsString() (string, bool) {
+	if msg.str == nil {
+		return "", false
+	}
+	return *msg.str, true
+}
+
+func (msg *fakeInboundMessage) GetPayloadAsMap() (sdt.Map, bool) {
+	return msg.sdtMap, msg.sdtMap != nil
+}
+
+func (msg *fakeInboundMessage) GetHTTPContentType() (string, bool) {
+	return msg.contentType, msg.contentType != ""
+}
+
+func (msg *fakeInboundMessage) GetApplicationMessageType() (string, bool) {
+	return msg.messageType, msg.messageType != ""
+}
+
+type order struct {
+	ID       string `json:"id"`
+	Quantity int    `json:"quantity"`
+}
+
+func TestJSONCodecRoundTrip(t *testing.T) {
+	builder := &recordingBuilder{}
+	if _, err := codec.JSON().Encode(builder, order{ID: "abc", Quantity: 3}); err != nil {
+		t.Fatalf("expected encode to succeed, got %s", err)
+	}
+	if builder.properties[config.MessagePropertyHTTPContentType] != codec.ContentTypeJSON {
+		t.Errorf("expected content type %s, got %v", codec.ContentTypeJSON, builder.properties[config.MessagePropertyHTTPContentType])
+	}
+	var decoded order
+	err := codec.Decode(&fakeInboundMessage{bytes: builder.bytes, contentType: "application/json; charset=utf-8"}, &decoded)
+	if err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if decoded != (order{ID: "abc", Quantity: 3}) {
+		t.Errorf("expected decoded value to match encoded value, got %v", decoded)
+	}
+}
+
+func TestJSONCodecDecodesStringPayload(t *testing.T) {
+	payload := `{"id":"xyz"}`
+	var decoded order
+	if err := codec.JSON().Decode(&fakeInboundMessage{str: &payload}, &decoded); err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if decoded.ID != "xyz" {
+		t.Errorf("expected id xyz, got %s", decoded.ID)
+	}
+}
+
+func TestJSONCodecEncodeContentTypeOverride(t *testing.T) {
+	builder := &recordingBuilder{}
+	override := config.MessagePropertyMap{config.MessagePropertyHTTPContentType: "application/vnd.orders+json"}
+	if _, err := codec.JSON().Encode(builder, order{}, override); err != nil {
+		t.Fatalf("expected encode to succeed, got %s", err)
+	}
+	if builder.properties[config.MessagePropertyHTTPContentType] != "application/vnd.orders+json" {
+		t.Errorf("expected additional configuration to override the content type, got %v", builder.properties[config.MessagePropertyHTTPContentType])
+	}
+}
+
+func TestSDTMapCodecRoundTrip(t *testing.T) {
+	builder := &recordingBuilder{}
+	if _, err := codec.SDTMap().Encode(builder, map[string]interface{}{"key": int32(1)}); err != nil {
+		t.Fatalf("expected encode to succeed, got %s", err)
+	}
+	if _, err := codec.SDTMap().Encode(builder, "not a map"); err == nil {
+		t.Error("expected error encoding a string with the SDT map codec")
+	} else if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected IllegalArgumentError, got %T", err)
+	}
+	var decoded sdt.Map
+	if err := codec.SDTMap().Decode(&fakeInboundMessage{sdtMap: builder.sdtMap}, &decoded); err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if value, err := decoded.GetInt32("key"); err != nil || value != 1 {
+		t.Errorf("expected key to be 1, got %v (%v)", value, err)
+	}
+	var plain map[string]interface{}
+	if err := codec.SDTMap().Decode(&fakeInboundMessage{sdtMap: builder.sdtMap}, &plain); err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if plain["key"] != int32(1) {
+		t.Errorf("expected key to be 1, got %v", plain["key"])
+	}
+	if err := codec.SDTMap().Decode(&fakeInboundMessage{bytes: []byte{1}}, &decoded); err == nil {
+		t.Error("expected error decoding a message without a map payload")
+	}
+}
+
+func TestRegistryForMessage(t *testing.T) {
+	custom := &stubCodec{contentType: "text/csv"}
+	registry := codec.NewRegistry(codec.JSON(), custom)
+	if matched, ok := registry.ForMessage(&fakeInboundMessage{contentType: "TEXT/CSV"}); !ok || matched != custom {
+		t.Errorf("expected codec to be matched by content type, got %v", matched)
+	}
+	if matched, ok := registry.ForMessage(&fakeInboundMessage{contentType: "text/plain", messageType: "text/csv"}); !ok || matched != custom {
+		t.Errorf("expected codec to be matched by application message type, got %v", matched)
+	}
+	if _, ok := registry.ForMessage(&fakeInboundMessage{contentType: "text/plain"}); ok {
+		t.Error("expected no codec to match an unregistered content type")
+	}
+	err := registry.Decode(&fakeInboundMessage{contentType: "text/plain"}, new(string))
+	if _, ok := err.(*solace.IllegalArgumentError); !ok {
+		t.Errorf("expected IllegalArgumentError when no codec matches, got %T", err)
+	}
+}
+
+type stubCodec struct {
+	solace.Codec
+	contentType string
+}
+
+func (codec *stubCodec) ContentType() string {
+	return codec.contentType
+}
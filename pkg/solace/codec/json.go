@@ -0,0 +1,65 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// ContentTypeJSON is the content type of payloads encoded by the JSON codec.
+const ContentTypeJSON = "application/json"
+
+// JSON returns a codec encoding values as JSON with the encoding/json package.
+// Payloads are carried as byte arrays, and string payloads are accepted when decoding.
+func JSON() solace.Codec {
+	return jsonCodec{}
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (codec jsonCodec) Encode(builder solace.OutboundMessageBuilder, value interface{}, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecFailedToEncode, ContentTypeJSON)+err.Error(), err)
+	}
+	return builder.BuildWithByteArrayPayload(payload, WithContentType(ContentTypeJSON, additionalConfiguration)...)
+}
+
+func (codec jsonCodec) Decode(msg message.InboundMessage, value interface{}) error {
+	payload, ok := msg.GetPayloadAsBytes()
+	if !ok {
+		str, ok := msg.GetPayloadAsString()
+		if !ok {
+			return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecMissingPayload, ContentTypeJSON), nil)
+		}
+		payload = []byte(str)
+	}
+	if err := json.Unmarshal(payload, value); err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecFailedToDecode, ContentTypeJSON)+err.Error(), err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"fmt"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/message/sdt"
+)
+
+// ContentTypeSDTMap is the content type of payloads encoded by the SDTMap codec.
+const ContentTypeSDTMap = "application/vnd.solace.sdt-map"
+
+// SDTMap returns a codec carrying values as SDT map payloads, readable by any Solace API.
+// Values of type sdt.Map and map[string]interface{} can be encoded, and payloads can be
+// decoded into either type. Any SDT map payload is decoded, regardless of content type,
+// once the codec has been selected for a message.
+func SDTMap() solace.Codec {
+	return sdtMapCodec{}
+}
+
+type sdtMapCodec struct{}
+
+func (sdtMapCodec) ContentType() string {
+	return ContentTypeSDTMap
+}
+
+func (codec sdtMapCodec) Encode(builder solace.OutboundMessageBuilder, value interface{}, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message.OutboundMessage, error) {
+	var payload sdt.Map
+	switch typed := value.(type) {
+	case sdt.Map:
+		payload = typed
+	case map[string]interface{}:
+		payload = make(sdt.Map, len(typed))
+		for key, item := range typed {
+			payload[key] = item
+		}
+	default:
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecUnsupportedValueType, ContentTypeSDTMap, value), nil)
+	}
+	return builder.BuildWithMapPayload(payload, WithContentType(ContentTypeSDTMap, additionalConfiguration)...)
+}
+
+func (codec sdtMapCodec) Decode(msg message.InboundMessage, value interface{}) error {
+	payload, ok := msg.GetPayloadAsMap()
+	if !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecMissingPayload, ContentTypeSDTMap), nil)
+	}
+	switch typed := value.(type) {
+	case *sdt.Map:
+		if typed != nil {
+			*typed = payload
+			return nil
+		}
+	case *map[string]interface{}:
+		if typed != nil {
+			*typed = make(map[string]interface{}, len(payload))
+			for key, item := range payload {
+				(*typed)[key] = item
+			}
+			return nil
+		}
+	}
+	return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.CodecUnsupportedTargetType, ContentTypeSDTMap, value), nil)
+}
@@ -0,0 +1,108 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec
+
+import (
+	"reflect"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// DecodePayload decodes the payload of the given message into a new value of type T with the
+// codec in the default registry matching the HTTP content type or application message type
+// of the message. If T is a pointer type, the payload is decoded into a newly allocated value
+// of the type pointed to, for example a generated protobuf message. For more information,
+// see Registry.Decode.
+func DecodePayload[T any](msg message.InboundMessage) (T, error) {
+	return decodeValue[T](func(value interface{}) error {
+		return Decode(msg, value)
+	})
+}
+
+// DecodePayloadWith decodes the payload of the given message into a new value of type T with
+// the codecs registered on the given receiver. Pointer types are handled as for DecodePayload.
+// For more information, see solace.PayloadDecoder.
+func DecodePayloadWith[T any](decoder solace.PayloadDecoder, msg message.InboundMessage) (T, error) {
+	return decodeValue[T](func(value interface{}) error {
+		return decoder.DecodePayload(msg, value)
+	})
+}
+
+// PublishTyped encodes the given value with the codec registered on the builder and publishes
+// it to the given topic with the direct publisher. For more information, see
+// solace.OutboundMessageBuilder.BuildWithValue and solace.DirectMessagePublisher.Publish.
+func PublishTyped[T any](publisher solace.DirectMessagePublisher, builder solace.OutboundMessageBuilder, destination *resource.Topic, value T) error {
+	msg, err := builder.BuildWithValue(value)
+	if err != nil {
+		return err
+	}
+	// the publisher publishes a copy of the message
+	defer msg.Dispose()
+	return publisher.Publish(msg, destination)
+}
+
+// PublishTypedAwaitAcknowledgement encodes the given value with the codec registered on the builder,
+// publishes it to the given destination with the persistent publisher and awaits the publish
+// acknowledgement. For more information, see solace.PersistentMessagePublisher.PublishAwaitAcknowledgement.
+func PublishTypedAwaitAcknowledgement[T any](publisher solace.PersistentMessagePublisher, builder solace.OutboundMessageBuilder, destination resource.Destination, value T, timeout time.Duration) error {
+	msg, err := builder.BuildWithValue(value)
+	if err != nil {
+		return err
+	}
+	defer msg.Dispose()
+	return publisher.PublishAwaitAcknowledgement(msg, destination, timeout, nil)
+}
+
+// RequestTyped encodes the given request with the codec registered on the builder, publishes it
+// with the request reply publisher, and decodes the reply into a new value of type R with the
+// codec in the default registry matching the reply. Pointer types are handled as for DecodePayload.
+// For more information, see solace.RequestReplyMessagePublisher.PublishAwaitResponse.
+func RequestTyped[T any, R any](publisher solace.RequestReplyMessagePublisher, builder solace.OutboundMessageBuilder, destination *resource.Topic, request T, replyTimeout time.Duration) (R, error) {
+	var reply R
+	msg, err := builder.BuildWithValue(request)
+	if err != nil {
+		return reply, err
+	}
+	defer msg.Dispose()
+	replyMessage, err := publisher.PublishAwaitResponse(msg, destination, replyTimeout, nil)
+	if err != nil {
+		return reply, err
+	}
+	defer replyMessage.Dispose()
+	return decodeValue[R](func(value interface{}) error {
+		return Decode(replyMessage, value)
+	})
+}
+
+// decodeValue calls decode with a pointer to a new value of type T. If T is itself a pointer
+// type, decode is given a pointer to a newly allocated value of the type pointed to instead,
+// such that codecs expecting pointers to message types are not given a pointer to a pointer.
+func decodeValue[T any](decode func(value interface{}) error) (T, error) {
+	var value T
+	if valueType := reflect.TypeOf((*T)(nil)).Elem(); valueType.Kind() == reflect.Ptr {
+		target := reflect.New(valueType.Elem())
+		if err := decode(target.Interface()); err != nil {
+			return value, err
+		}
+		return target.Interface().(T), nil
+	}
+	err := decode(&value)
+	return value, err
+}
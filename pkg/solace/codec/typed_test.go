@@ -0,0 +1,53 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codec_test
+
+import (
+	"testing"
+
+	"solace.dev/go/messaging/pkg/solace/codec"
+)
+
+func TestDecodePayload(t *testing.T) {
+	decoded, err := codec.DecodePayload[order](&fakeInboundMessage{bytes: []byte(`{"id":"abc","quantity":2}`), contentType: codec.ContentTypeJSON})
+	if err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if decoded != (order{ID: "abc", Quantity: 2}) {
+		t.Errorf("expected decoded order, got %v", decoded)
+	}
+	if _, err := codec.DecodePayload[order](&fakeInboundMessage{bytes: []byte(`{}`)}); err == nil {
+		t.Error("expected error decoding a message without a content type")
+	}
+}
+
+func TestDecodePayloadIntoPointer(t *testing.T) {
+	decoded, err := codec.DecodePayload[*order](&fakeInboundMessage{bytes: []byte(`{"id":"abc","quantity":2}`), contentType: codec.ContentTypeJSON})
+	if err != nil {
+		t.Fatalf("expected decode to succeed, got %s", err)
+	}
+	if decoded == nil || *decoded != (order{ID: "abc", Quantity: 2}) {
+		t.Errorf("expected decoded order, got %v", decoded)
+	}
+	decoded, err = codec.DecodePayload[*order](&fakeInboundMessage{bytes: []byte(`{`), contentType: codec.ContentTypeJSON})
+	if err == nil {
+		t.Error("expected error decoding an invalid payload")
+	}
+	if decoded != nil {
+		t.Errorf("expected nil value on error, got %v", decoded)
+	}
+}
@@ -38,4 +38,14 @@
 //	json.Unmarshal(myJsonConfig, &myServicePropertyMap)
 //	messaging.NewMessagingServiceBuilder().FromConfigurationProvider(myServicePropertyMap)
 //	...
+//
+// The maps may also be loaded from layered sources such as YAML or .properties files,
+// environment variables and secret files, where later sources take precedence:
+//
+//	properties, err := config.LoadServiceProperties(
+//		config.YAMLFileSource("solace.yaml"),
+//		config.SecretDirectorySource("/etc/secrets/solace"),
+//		config.EnvironmentSource(), // SOLACE_TRANSPORT_HOST, SOLACE_AUTHENTICATION_BASIC_PASSWORD_FILE, ...
+//	)
+//	...
 package config
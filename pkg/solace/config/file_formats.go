@@ -0,0 +1,243 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// parseYAML parses the subset of YAML used for configuration files into a flat map of property
+// names to string values. Block mappings are flattened into dotted names and block sequences of
+// scalars are joined with commas. Anchors, flow collections, block scalars and multiple documents
+// are not supported.
+func parseYAML(data []byte) (map[string]interface{}, error) {
+	type mapping struct {
+		indent int
+		prefix string
+	}
+	var parents []mapping
+	result := make(map[string]interface{})
+	sequences := make(map[string][]string)
+	// the key without a value on the previous line, which may be followed by sequence items
+	openKey, openIndent := "", -1
+	for i, raw := range strings.Split(string(data), "\n") {
+		lineNumber := i + 1
+		line := strings.TrimRight(stripYAMLComment(raw), " \t\r")
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || trimmed == "---" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("line %d: tabs cannot be used for indentation", lineNumber)
+		}
+		if trimmed == "..." {
+			break
+		}
+		indent := len(line) - len(trimmed)
+		if trimmed == "-" || strings.HasPrefix(trimmed, "- ") {
+			if openKey == "" || indent < openIndent {
+				return nil, fmt.Errorf("line %d: unexpected sequence item", lineNumber)
+			}
+			item, err := parseYAMLScalar(strings.TrimSpace(trimmed[1:]))
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+			if item != nil {
+				sequences[openKey] = append(sequences[openKey], *item)
+			}
+			continue
+		}
+		openKey = ""
+		for len(parents) > 0 && indent <= parents[len(parents)-1].indent {
+			parents = parents[:len(parents)-1]
+		}
+		separator := yamlKeySeparator(trimmed)
+		if separator < 0 {
+			return nil, fmt.Errorf("line %d: expected key and value separated by ':'", lineNumber)
+		}
+		key, err := parseYAMLScalar(strings.TrimSpace(trimmed[:separator]))
+		if err != nil || key == nil || *key == "" {
+			return nil, fmt.Errorf("line %d: invalid key", lineNumber)
+		}
+		name := *key
+		if len(parents) > 0 {
+			name = parents[len(parents)-1].prefix + "." + name
+		}
+		value := strings.TrimSpace(trimmed[separator+1:])
+		if value == "" {
+			parents = append(parents, mapping{indent: indent, prefix: name})
+			openKey, openIndent = name, indent
+			continue
+		}
+		switch value[0] {
+		case '|', '>', '[', '{', '&', '*', '!':
+			return nil, fmt.Errorf("line %d: unsupported value for %s", lineNumber, name)
+		}
+		scalar, err := parseYAMLScalar(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+		if scalar != nil {
+			result[name] = *scalar
+		}
+	}
+	for name, items := range sequences {
+		result[name] = strings.Join(items, ",")
+	}
+	return result, nil
+}
+
+// stripYAMLComment removes a comment starting with '#' at the start of the line or after
+// whitespace, outside of quotes.
+func stripYAMLComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// yamlKeySeparator returns the index of the ':' separating a key from its value, or -1.
+func yamlKeySeparator(line string) int {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' {
+				i++
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == ':' && (i+1 == len(line) || line[i+1] == ' ' || line[i+1] == '\t'):
+			return i
+		}
+	}
+	return -1
+}
+
+// parseYAMLScalar returns the string value of a plain or quoted scalar, or nil for null.
+func parseYAMLScalar(value string) (*string, error) {
+	switch {
+	case value == "" || value == "~" || value == "null" || value == "Null" || value == "NULL":
+		return nil, nil
+	case strings.HasPrefix(value, "\""):
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid double quoted value %s", value)
+		}
+		return &unquoted, nil
+	case strings.HasPrefix(value, "'"):
+		if len(value) < 2 || !strings.HasSuffix(value, "'") {
+			return nil, fmt.Errorf("invalid single quoted value %s", value)
+		}
+		unquoted := strings.ReplaceAll(value[1:len(value)-1], "''", "'")
+		return &unquoted, nil
+	}
+	return &value, nil
+}
+
+// parseProperties parses a Java style .properties file into a map of property names to string
+// values. Keys are separated from values by '=', ':' or whitespace, lines starting with '#' or
+// '!' are comments and lines ending with a backslash are continued on the next line.
+func parseProperties(data []byte) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	lines := strings.Split(string(data), "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimLeft(strings.TrimRight(lines[i], "\r"), " \t\f")
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+		for continuesOnNextLine(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(strings.TrimRight(lines[i], "\r"), " \t\f")
+		}
+		key, value := splitProperty(line)
+		result[unescapeProperty(key)] = unescapeProperty(value)
+	}
+	return result, nil
+}
+
+// continuesOnNextLine returns true if the line ends with an odd number of backslashes.
+func continuesOnNextLine(line string) bool {
+	backslashes := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		backslashes++
+	}
+	return backslashes%2 == 1
+}
+
+// splitProperty splits a line at the first unescaped separator.
+func splitProperty(line string) (string, string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '\\':
+			i++
+		case '=', ':':
+			return line[:i], strings.TrimLeft(line[i+1:], " \t\f")
+		case ' ', '\t', '\f':
+			value := strings.TrimLeft(line[i:], " \t\f")
+			if value != "" && (value[0] == '=' || value[0] == ':') {
+				value = strings.TrimLeft(value[1:], " \t\f")
+			}
+			return line[:i], value
+		}
+	}
+	return line, ""
+}
+
+// unescapeProperty replaces the escape sequences of a key or value.
+func unescapeProperty(text string) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+	var builder strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 == len(text) {
+			builder.WriteByte(text[i])
+			continue
+		}
+		i++
+		switch text[i] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		default:
+			builder.WriteByte(text[i])
+		}
+	}
+	return builder.String()
+}
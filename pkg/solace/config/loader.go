@@ -0,0 +1,457 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Source is a source of configuration properties that can be layered with other sources when
+// loading a property map, for example with LoadServiceProperties. Sources passed later to a
+// loader take precedence over sources passed earlier.
+type Source interface {
+	// Load returns the properties of the source keyed by property name, for example
+	// "solace.messaging.transport.host". The names of the properties known to the map being loaded
+	// are passed so that sources with a different naming scheme, such as environment variables,
+	// can map their keys. Properties that are not known to the map being loaded are ignored.
+	Load(known []string) (map[string]interface{}, error)
+}
+
+// DefaultEnvironmentPrefix is the prefix of the environment variables read by EnvironmentSource.
+const DefaultEnvironmentPrefix = "SOLACE_"
+
+// environmentFileSuffix is the suffix of an environment variable naming a file containing the value.
+const environmentFileSuffix = "_FILE"
+
+// messagingPropertyPrefix is the common prefix of all property names omitted from environment variables.
+const messagingPropertyPrefix = "solace.messaging."
+
+// LoadServiceProperties loads a ServicePropertyMap from the given sources. Sources passed later
+// take precedence over sources passed earlier, for example:
+//
+//	properties, err := config.LoadServiceProperties(
+//		config.YAMLFileSource("solace.yaml"),
+//		config.EnvironmentSource(),
+//	)
+//
+// Text values are converted to the type expected by each property. An error is returned if a
+// source cannot be read or a value cannot be converted.
+func LoadServiceProperties(sources ...Source) (ServicePropertyMap, error) {
+	loaded, err := loadProperties(servicePropertyKinds, sources)
+	if err != nil {
+		return nil, err
+	}
+	properties := make(ServicePropertyMap, len(loaded))
+	for key, value := range loaded {
+		properties[ServiceProperty(key)] = value
+	}
+	return properties, nil
+}
+
+// LoadReceiverProperties loads a ReceiverPropertyMap from the given sources. Sources passed later
+// take precedence over sources passed earlier. See LoadServiceProperties.
+func LoadReceiverProperties(sources ...Source) (ReceiverPropertyMap, error) {
+	loaded, err := loadProperties(receiverPropertyKinds, sources)
+	if err != nil {
+		return nil, err
+	}
+	properties := make(ReceiverPropertyMap, len(loaded))
+	for key, value := range loaded {
+		properties[ReceiverProperty(key)] = value
+	}
+	return properties, nil
+}
+
+// LoadPublisherProperties loads a PublisherPropertyMap from the given sources. Sources passed later
+// take precedence over sources passed earlier. See LoadServiceProperties.
+func LoadPublisherProperties(sources ...Source) (PublisherPropertyMap, error) {
+	loaded, err := loadProperties(publisherPropertyKinds, sources)
+	if err != nil {
+		return nil, err
+	}
+	properties := make(PublisherPropertyMap, len(loaded))
+	for key, value := range loaded {
+		properties[PublisherProperty(key)] = value
+	}
+	return properties, nil
+}
+
+// LoadEndpointProperties loads an EndpointPropertyMap from the given sources. Sources passed later
+// take precedence over sources passed earlier. See LoadServiceProperties.
+func LoadEndpointProperties(sources ...Source) (EndpointPropertyMap, error) {
+	loaded, err := loadProperties(endpointPropertyKinds, sources)
+	if err != nil {
+		return nil, err
+	}
+	properties := make(EndpointPropertyMap, len(loaded))
+	for key, value := range loaded {
+		properties[EndpointProperty(key)] = value
+	}
+	return properties, nil
+}
+
+// EnvironmentSource returns a Source reading properties from environment variables prefixed
+// with DefaultEnvironmentPrefix. See EnvironmentSourceWithPrefix.
+func EnvironmentSource() Source {
+	return EnvironmentSourceWithPrefix(DefaultEnvironmentPrefix)
+}
+
+// EnvironmentSourceWithPrefix returns a Source reading properties from environment variables.
+// The name of the variable for a property is the prefix followed by the property name without
+// "solace.messaging.", in upper case and with '.' and '-' replaced by '_'. For example with the
+// prefix "SOLACE_", the property "solace.messaging.transport.host" is read from SOLACE_TRANSPORT_HOST.
+//
+// If the variable is not set but the variable with the suffix "_FILE" is, the value is read from
+// the named file with trailing line breaks removed, for example SOLACE_AUTHENTICATION_BASIC_PASSWORD_FILE
+// may name a password mounted from a Kubernetes secret.
+func EnvironmentSourceWithPrefix(prefix string) Source {
+	return &environmentSource{prefix: prefix}
+}
+
+// YAMLFileSource returns a Source reading properties from a YAML file. Properties can be nested
+// by their dotted names, for example:
+//
+//	solace:
+//	  messaging:
+//	    transport:
+//	      host: tcp://localhost:55555
+//
+// or written with their full names such as "solace.messaging.transport.host: tcp://localhost:55555".
+// Only block mappings of scalars are supported, block sequences of scalars are joined with commas.
+func YAMLFileSource(path string) Source {
+	return &fileSource{path: path, parse: parseYAML}
+}
+
+// PropertiesFileSource returns a Source reading properties from a Java style .properties file
+// containing lines such as "solace.messaging.transport.host=tcp://localhost:55555".
+func PropertiesFileSource(path string) Source {
+	return &fileSource{path: path, parse: parseProperties}
+}
+
+// SecretFileSource returns a Source reading the value of a single property from a file with
+// trailing line breaks removed, for example a password mounted from a Kubernetes secret.
+func SecretFileSource(property string, path string) Source {
+	return &secretFileSource{property: property, path: path}
+}
+
+// SecretDirectorySource returns a Source reading property values from the files in a directory,
+// such as a Kubernetes secret mounted as a volume. Each file is named after a property, either
+// with the property name such as "solace.messaging.authentication.basic.password" or with the
+// name of its environment variable such as "SOLACE_AUTHENTICATION_BASIC_PASSWORD" or
+// "AUTHENTICATION_BASIC_PASSWORD". Trailing line breaks are removed from the values and files
+// not named after a known property are ignored.
+func SecretDirectorySource(dir string) Source {
+	return &secretDirectorySource{dir: dir}
+}
+
+// MapSource returns a Source containing the given properties, for example to provide defaults
+// that are overridden by later sources. Values that are not strings are used as they are.
+func MapSource(properties map[string]interface{}) Source {
+	copied := make(map[string]interface{}, len(properties))
+	for key, value := range properties {
+		copied[key] = value
+	}
+	return mapSource(copied)
+}
+
+type environmentSource struct {
+	prefix string
+}
+
+func (source *environmentSource) Load(known []string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for _, key := range known {
+		name := source.prefix + environmentName(key)
+		if value, ok := os.LookupEnv(name); ok {
+			result[key] = value
+		} else if path, ok := os.LookupEnv(name + environmentFileSuffix); ok {
+			value, err := readSecret(path)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read %s from %s: %w", key, name+environmentFileSuffix, err)
+			}
+			result[key] = value
+		}
+	}
+	return result, nil
+}
+
+type fileSource struct {
+	path  string
+	parse func(data []byte) (map[string]interface{}, error)
+}
+
+func (source *fileSource) Load(known []string) (map[string]interface{}, error) {
+	data, err := os.ReadFile(source.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	result, err := source.parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse configuration file %s: %w", source.path, err)
+	}
+	return result, nil
+}
+
+type secretFileSource struct {
+	property, path string
+}
+
+func (source *secretFileSource) Load(known []string) (map[string]interface{}, error) {
+	value, err := readSecret(source.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", source.property, err)
+	}
+	return map[string]interface{}{source.property: value}, nil
+}
+
+type secretDirectorySource struct {
+	dir string
+}
+
+func (source *secretDirectorySource) Load(known []string) (map[string]interface{}, error) {
+	entries, err := os.ReadDir(source.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read secret directory: %w", err)
+	}
+	names := make(map[string]string, 3*len(known))
+	for _, key := range known {
+		names[key] = key
+		names[environmentName(key)] = key
+		names[DefaultEnvironmentPrefix+environmentName(key)] = key
+	}
+	result := make(map[string]interface{})
+	for _, entry := range entries {
+		// Kubernetes mounts secrets as symbolic links next to hidden data directories
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		key, ok := names[entry.Name()]
+		if !ok {
+			key, ok = names[strings.ToUpper(entry.Name())]
+		}
+		if !ok {
+			continue
+		}
+		path := filepath.Join(source.dir, entry.Name())
+		if info, err := os.Stat(path); err != nil || info.IsDir() {
+			continue
+		}
+		value, err := readSecret(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", key, err)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
+
+type mapSource map[string]interface{}
+
+func (source mapSource) Load(known []string) (map[string]interface{}, error) {
+	return source, nil
+}
+
+// environmentName returns the environment variable name of a property without a prefix.
+func environmentName(key string) string {
+	return strings.ToUpper(environmentNameReplacer.Replace(strings.TrimPrefix(key, messagingPropertyPrefix)))
+}
+
+var environmentNameReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// readSecret reads a value from a file removing trailing line breaks.
+func readSecret(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+// propertyKind is the type of value expected by a property, used to convert text values.
+type propertyKind int
+
+const (
+	stringProperty propertyKind = iota
+	integerProperty
+	booleanProperty
+	// durationProperty values are either integer milliseconds or durations such as "10s".
+	durationProperty
+	// timeProperty values are RFC 3339 times or used as they are.
+	timeProperty
+)
+
+func loadProperties(kinds map[string]propertyKind, sources []Source) (map[string]interface{}, error) {
+	known := make([]string, 0, len(kinds))
+	for key := range kinds {
+		known = append(known, key)
+	}
+	sort.Strings(known)
+	result := make(map[string]interface{})
+	for _, source := range sources {
+		loaded, err := source.Load(known)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range loaded {
+			kind, ok := kinds[key]
+			if !ok {
+				continue
+			}
+			converted, err := convertProperty(key, kind, value)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = converted
+		}
+	}
+	return result, nil
+}
+
+func convertProperty(key string, kind propertyKind, value interface{}) (interface{}, error) {
+	text, ok := value.(string)
+	if !ok {
+		return value, nil
+	}
+	text = strings.TrimSpace(text)
+	switch kind {
+	case integerProperty:
+		converted, err := strconv.Atoi(text)
+		if err != nil {
+			return nil, fmt.Errorf("expected integer value for %s, got %q", key, text)
+		}
+		return converted, nil
+	case booleanProperty:
+		converted, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, fmt.Errorf("expected boolean value for %s, got %q", key, text)
+		}
+		return converted, nil
+	case durationProperty:
+		if milliseconds, err := strconv.Atoi(text); err == nil {
+			return milliseconds, nil
+		}
+		converted, err := time.ParseDuration(text)
+		if err != nil {
+			return nil, fmt.Errorf("expected duration or integer milliseconds for %s, got %q", key, text)
+		}
+		return converted, nil
+	case timeProperty:
+		if converted, err := time.Parse(time.RFC3339, text); err == nil {
+			return converted, nil
+		}
+		return text, nil
+	}
+	// string values such as passwords are used exactly as they are
+	return value, nil
+}
+
+var servicePropertyKinds = map[string]propertyKind{
+	string(AuthenticationPropertyScheme):                                 stringProperty,
+	string(AuthenticationPropertySchemeBasicUserName):                    stringProperty,
+	string(AuthenticationPropertySchemeBasicPassword):                    stringProperty,
+	string(AuthenticationPropertySchemeSSLClientCertFile):                stringProperty,
+	string(AuthenticationPropertySchemeSSLClientPrivateKeyFile):          stringProperty,
+	string(AuthenticationPropertySchemeClientCertPrivateKeyFilePassword): stringProperty,
+	string(AuthenticationPropertySchemeClientCertUserName):               stringProperty,
+	string(AuthenticationPropertySchemeKerberosInstanceName):             stringProperty,
+	string(AuthenticationPropertySchemeKerberosUserName):                 stringProperty,
+	string(AuthenticationPropertySchemeOAuth2AccessToken):                stringProperty,
+	string(AuthenticationPropertySchemeOAuth2IssuerIdentifier):           stringProperty,
+	string(AuthenticationPropertySchemeOAuth2OIDCIDToken):                stringProperty,
+	string(ClientPropertyName):                                           stringProperty,
+	string(ClientPropertyApplicationDescription):                         stringProperty,
+	string(ServicePropertyVPNName):                                       stringProperty,
+	string(ServicePropertyGenerateSenderID):                              booleanProperty,
+	string(ServicePropertyGenerateSendTimestamps):                        booleanProperty,
+	string(ServicePropertyGenerateReceiveTimestamps):                     booleanProperty,
+	string(ServicePropertyReceiverDirectSubscriptionReapply):             booleanProperty,
+	string(ServicePropertyProvisionTimeoutMs):                            durationProperty,
+	string(ServicePropertyPayloadCompressionLevel):                       integerProperty,
+	string(ServicePropertyPublisherPersistentWindowSize):                 integerProperty,
+	string(ServicePropertyPublisherPersistentAckTimer):                   durationProperty,
+	string(ServicePropertyPublisherPersistentAckEventMode):               stringProperty,
+	string(TransportLayerPropertyHost):                                   stringProperty,
+	string(TransportLayerPropertyConnectionAttemptsTimeout):              durationProperty,
+	string(TransportLayerPropertyConnectionRetries):                      integerProperty,
+	string(TransportLayerPropertyConnectionRetriesPerHost):               integerProperty,
+	string(TransportLayerPropertyReconnectionAttempts):                   integerProperty,
+	string(TransportLayerPropertyReconnectionAttemptsWaitInterval):       durationProperty,
+	string(TransportLayerPropertyKeepAliveInterval):                      durationProperty,
+	string(TransportLayerPropertyKeepAliveWithoutResponseLimit):          integerProperty,
+	string(TransportLayerPropertySocketOutputBufferSize):                 integerProperty,
+	string(TransportLayerPropertySocketInputBufferSize):                  integerProperty,
+	string(TransportLayerPropertySocketTCPOptionNoDelay):                 booleanProperty,
+	string(TransportLayerPropertyCompressionLevel):                       integerProperty,
+	string(TransportLayerPropertyWebTransportProtocol):                   stringProperty,
+	string(TransportLayerPropertyWebTransportProtocolList):               stringProperty,
+	string(TransportLayerPropertyWebTransportProtocolDowngradeTimeout):   durationProperty,
+	string(TransportLayerPropertyGuaranteedWithWebTransport):             booleanProperty,
+	string(TransportLayerSecurityPropertyCertValidated):                  booleanProperty,
+	string(TransportLayerSecurityPropertyCertRejectExpired):              booleanProperty,
+	string(TransportLayerSecurityPropertyCertValidateServername):         booleanProperty,
+	string(TransportLayerSecurityPropertyExcludedProtocols):              stringProperty,
+	string(TransportLayerSecurityPropertyMinimumProtocol):                stringProperty,
+	string(TransportLayerSecurityPropertyMaximumProtocol):                stringProperty,
+	string(TransportLayerSecurityPropertyProtocolDowngradeTo):            stringProperty,
+	string(TransportLayerSecurityPropertyCipherSuites):                   stringProperty,
+	string(TransportLayerSecurityPropertyTrustStorePath):                 stringProperty,
+	string(TransportLayerSecurityPropertyTrustedCommonNameList):          stringProperty,
+}
+
+// ReceiverPropertyPersistentStateChangeListener is omitted as its value is a function.
+var receiverPropertyKinds = map[string]propertyKind{
+	string(ReceiverPropertyDirectBackPressureStrategy):                                      stringProperty,
+	string(ReceiverPropertyDirectBackPressureBufferCapacity):                                integerProperty,
+	string(ReceiverPropertyPersistentMissingResourceCreationStrategy):                       stringProperty,
+	string(ReceiverPropertyPersistentMessageSelectorQuery):                                  stringProperty,
+	string(ReceiverPropertyPersistentMessageAckStrategy):                                    stringProperty,
+	string(ReceiverPropertyPersistentMessageRequiredOutcomeSupport):                         stringProperty,
+	string(ReceiverPropertyPersistentMessageReplayStrategy):                                 stringProperty,
+	string(ReceiverPropertyPersistentMessageReplayStrategyTimeBasedStartTime):               timeProperty,
+	string(ReceiverPropertyPersistentMessageReplayStrategyIDBasedReplicationGroupMessageID): stringProperty,
+	string(ReceiverPropertyPersistentFlowWindowSize):                                        integerProperty,
+	string(ReceiverPropertyPersistentFlowMaxUnackedMessages):                                integerProperty,
+	string(ReceiverPropertyPersistentFlowAckThreshold):                                      integerProperty,
+	string(ReceiverPropertyPersistentFlowAckTimer):                                          durationProperty,
+	string(ReceiverPropertyPersistentFlowReconnectAttempts):                                 integerProperty,
+	string(ReceiverPropertyPersistentFlowBindTimeout):                                       durationProperty,
+	string(ReceiverPropertyQueueBrowserWindowSize):                                          integerProperty,
+	string(ReceiverPropertyConcurrentDispatchWorkers):                                       integerProperty,
+	string(ReceiverPropertyConcurrentDispatchOrdering):                                      stringProperty,
+}
+
+var publisherPropertyKinds = map[string]propertyKind{
+	string(PublisherPropertyBackPressureStrategy):       stringProperty,
+	string(PublisherPropertyBackPressureBufferCapacity): integerProperty,
+}
+
+var endpointPropertyKinds = map[string]propertyKind{
+	string(EndpointPropertyDurable):              booleanProperty,
+	string(EndpointPropertyExclusive):            booleanProperty,
+	string(EndpointPropertyNotifySender):         booleanProperty,
+	string(EndpointPropertyMaxMessageRedelivery): integerProperty,
+	string(EndpointPropertyMaxMessageSize):       integerProperty,
+	string(EndpointPropertyPermission):           stringProperty,
+	string(EndpointPropertyQuotaMB):              integerProperty,
+	string(EndpointPropertyRespectsTTL):          booleanProperty,
+}
@@ -0,0 +1,188 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace/config"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const yamlConfiguration = `
+# broker connection
+solace:
+  messaging:
+    transport:
+      host: "tcp://broker:55555"   # overridden by the environment
+      reconnection-attempts: 5
+      keep-alive-interval: 3s
+    authentication:
+      basic:
+        username: 'default'
+    service.vpn-name: default
+    tls:
+      excluded-protocols:
+        - tlsv1
+        - tlsv1.1
+solace.messaging.receiver.direct.back-pressure.buffer-capacity: 100
+`
+
+func TestLoadServicePropertiesFromYAML(t *testing.T) {
+	properties, err := config.LoadServiceProperties(config.YAMLFileSource(writeFile(t, "solace.yaml", yamlConfiguration)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := config.ServicePropertyMap{
+		config.TransportLayerPropertyHost:                      "tcp://broker:55555",
+		config.TransportLayerPropertyReconnectionAttempts:      5,
+		config.TransportLayerPropertyKeepAliveInterval:         3 * time.Second,
+		config.AuthenticationPropertySchemeBasicUserName:       "default",
+		config.ServicePropertyVPNName:                          "default",
+		config.TransportLayerSecurityPropertyExcludedProtocols: "tlsv1,tlsv1.1",
+	}
+	if len(properties) != len(expected) {
+		t.Errorf("expected %d properties, got %v", len(expected), properties)
+	}
+	for key, value := range expected {
+		if properties[key] != value {
+			t.Errorf("expected %s to be %v (%T), got %v (%T)", key, value, value, properties[key], properties[key])
+		}
+	}
+}
+
+func TestLoadServicePropertiesLayered(t *testing.T) {
+	propertiesFile := writeFile(t, "solace.properties", `
+# connection
+solace.messaging.transport.host = tcp://file:55555
+solace.messaging.service.vpn-name: file-vpn
+solace.messaging.authentication.basic.password=from\
+  file
+`)
+	passwordFile := writeFile(t, "password", "s3cr#t:\n")
+	t.Setenv("SOLACE_TRANSPORT_HOST", "tcp://env:55555")
+	t.Setenv("SOLACE_AUTHENTICATION_BASIC_PASSWORD_FILE", passwordFile)
+	t.Setenv("SOLACE_SERVICE_GENERATE_SENDER_ID", "true")
+	properties, err := config.LoadServiceProperties(
+		config.MapSource(map[string]interface{}{
+			string(config.TransportLayerPropertyHost):              "tcp://default:55555",
+			string(config.TransportLayerPropertyConnectionRetries): 3,
+		}),
+		config.PropertiesFileSource(propertiesFile),
+		config.EnvironmentSource(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := config.ServicePropertyMap{
+		config.TransportLayerPropertyHost:                "tcp://env:55555",
+		config.TransportLayerPropertyConnectionRetries:   3,
+		config.ServicePropertyVPNName:                    "file-vpn",
+		config.AuthenticationPropertySchemeBasicPassword: "s3cr#t:",
+		config.ServicePropertyGenerateSenderID:           true,
+	}
+	if len(properties) != len(expected) {
+		t.Errorf("expected %d properties, got %v", len(expected), properties)
+	}
+	for key, value := range expected {
+		if properties[key] != value {
+			t.Errorf("expected %s to be %v (%T), got %v (%T)", key, value, value, properties[key], properties[key])
+		}
+	}
+}
+
+func TestLoadServicePropertiesFromSecretDirectory(t *testing.T) {
+	dir := t.TempDir()
+	secrets := map[string]string{
+		"solace.messaging.authentication.basic.username": "user\n",
+		"AUTHENTICATION_BASIC_PASSWORD":                  "0123",
+		"unrelated":                                      "ignored",
+	}
+	for name, value := range secrets {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(value), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	properties, err := config.LoadServiceProperties(config.SecretDirectorySource(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(properties) != 2 ||
+		properties[config.AuthenticationPropertySchemeBasicUserName] != "user" ||
+		properties[config.AuthenticationPropertySchemeBasicPassword] != "0123" {
+		t.Errorf("unexpected properties %v", properties)
+	}
+}
+
+func TestLoadReceiverPublisherAndEndpointProperties(t *testing.T) {
+	t.Setenv("SOLACE_RECEIVER_PERSISTENT_FLOW_ACK_TIMER", "500")
+	t.Setenv("SOLACE_PUBLISHER_BACK_PRESSURE_BUFFER_CAPACITY", "64")
+	t.Setenv("SOLACE_ENDPOINT_PROPERTY_DURABLE", "false")
+	t.Setenv("SOLACE_ENDPOINT_PROPERTY_PERMISSION", string(config.EndpointPermissionConsume))
+	receiverProperties, err := config.LoadReceiverProperties(
+		config.YAMLFileSource(writeFile(t, "solace.yaml", yamlConfiguration)), config.EnvironmentSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(receiverProperties) != 2 ||
+		receiverProperties[config.ReceiverPropertyDirectBackPressureBufferCapacity] != 100 ||
+		receiverProperties[config.ReceiverPropertyPersistentFlowAckTimer] != 500 {
+		t.Errorf("unexpected receiver properties %v", receiverProperties)
+	}
+	publisherProperties, err := config.LoadPublisherProperties(config.EnvironmentSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(publisherProperties) != 1 || publisherProperties[config.PublisherPropertyBackPressureBufferCapacity] != 64 {
+		t.Errorf("unexpected publisher properties %v", publisherProperties)
+	}
+	endpointProperties, err := config.LoadEndpointProperties(config.EnvironmentSource())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(endpointProperties) != 2 ||
+		endpointProperties[config.EndpointPropertyDurable] != false ||
+		endpointProperties[config.EndpointPropertyPermission] != string(config.EndpointPermissionConsume) {
+		t.Errorf("unexpected endpoint properties %v", endpointProperties)
+	}
+}
+
+func TestLoadPropertiesErrors(t *testing.T) {
+	testCases := map[string]config.Source{
+		"missing file":     config.YAMLFileSource(filepath.Join(t.TempDir(), "missing.yaml")),
+		"invalid integer":  config.PropertiesFileSource(writeFile(t, "a.properties", "solace.messaging.transport.connection-retries=many")),
+		"invalid boolean":  config.PropertiesFileSource(writeFile(t, "b.properties", "solace.messaging.tls.cert-validated=maybe")),
+		"invalid duration": config.PropertiesFileSource(writeFile(t, "c.properties", "solace.messaging.transport.keep-alive-interval=soon")),
+		"invalid yaml":     config.YAMLFileSource(writeFile(t, "d.yaml", "solace:\n  transport\n")),
+		"missing secret":   config.SecretFileSource(string(config.AuthenticationPropertySchemeBasicPassword), filepath.Join(t.TempDir(), "password")),
+	}
+	for name, source := range testCases {
+		if _, err := config.LoadServiceProperties(source); err == nil {
+			t.Errorf("expected error for %s", name)
+		}
+	}
+}
@@ -59,3 +59,23 @@ const (
 	// acknowledged with PersistentMessageReceiver::Ack.
 	PersistentReceiverClientAck = "CLIENT_ACK"
 )
+
+// OrderingPolicy represents the ordering guarantees of a receiver dispatching messages to its
+// message handler concurrently.
+type OrderingPolicy string
+
+const (
+	// OrderingPolicyUnordered dispatches each message to the next available worker. Messages may be
+	// handled in any order.
+	OrderingPolicyUnordered OrderingPolicy = "UNORDERED"
+	// OrderingPolicyPerTopic preserves the order of messages published to the same topic. Messages
+	// published to different topics may be handled concurrently.
+	OrderingPolicyPerTopic OrderingPolicy = "PER_TOPIC"
+	// OrderingPolicyPerPartitionKey preserves the order of messages with the same partition key,
+	// the QueuePartitionKey (JMSXGroupID) user property. Messages with different partition keys may be
+	// handled concurrently. Messages without a partition key are handled in order with respect to each other.
+	OrderingPolicyPerPartitionKey OrderingPolicy = "PER_PARTITION_KEY"
+	// OrderingPolicyPerGroupID is an alias of OrderingPolicyPerPartitionKey, as the partition key
+	// of a message is its JMSXGroupID user property.
+	OrderingPolicyPerGroupID = OrderingPolicyPerPartitionKey
+)
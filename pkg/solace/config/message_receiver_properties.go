@@ -97,4 +97,49 @@ const (
 
 	// ReceiverPropertyPersistentMessageReplayStrategyIDBasedReplicationGroupMessageID configures the ID based replay strategy with a specified  replication group message ID.
 	ReceiverPropertyPersistentMessageReplayStrategyIDBasedReplicationGroupMessageID ReceiverProperty = "solace.messaging.receiver.persistent.replay.replication-group-message-id"
+
+	// ReceiverPropertyPersistentFlowWindowSize specifies the maximum number of messages that can be in transit
+	// from the broker to the receiver before they are delivered to the application.
+	// The valid range is 1 to 255. The default is 255.
+	ReceiverPropertyPersistentFlowWindowSize ReceiverProperty = "solace.messaging.receiver.persistent.flow.window-size"
+
+	// ReceiverPropertyPersistentFlowMaxUnackedMessages specifies the maximum number of messages that may be
+	// delivered to the application without being acknowledged. This cannot increase the maximum configured
+	// on the endpoint by the broker. Valid values are -1 and greater than 0, where -1 (the default) uses the
+	// broker configured maximum.
+	ReceiverPropertyPersistentFlowMaxUnackedMessages ReceiverProperty = "solace.messaging.receiver.persistent.flow.max-unacked-messages"
+
+	// ReceiverPropertyPersistentFlowAckThreshold specifies the threshold for sending acknowledgements to the broker
+	// as a percentage of the flow window size. The valid range is 1 to 75. The default is 60.
+	ReceiverPropertyPersistentFlowAckThreshold ReceiverProperty = "solace.messaging.receiver.persistent.flow.ack-threshold"
+
+	// ReceiverPropertyPersistentFlowAckTimer specifies the maximum time acknowledgements are batched before being
+	// sent to the broker, in milliseconds. Accepts either an integer or a time.Duration.
+	// The valid range is 20 to 1500 milliseconds. The default is 1000 milliseconds.
+	ReceiverPropertyPersistentFlowAckTimer ReceiverProperty = "solace.messaging.receiver.persistent.flow.ack-timer"
+
+	// ReceiverPropertyPersistentFlowReconnectAttempts specifies the number of times the receiver attempts to rebind
+	// its flow when the broker unbinds it, for example when a replay is started or the endpoint becomes unavailable.
+	// Valid values are -1 and greater than or equal to 0, where -1 (the default) retries forever and 0 disables rebinding.
+	ReceiverPropertyPersistentFlowReconnectAttempts ReceiverProperty = "solace.messaging.receiver.persistent.flow.reconnect-attempts"
+
+	// ReceiverPropertyPersistentFlowBindTimeout specifies the time to wait for the broker to confirm the flow bind
+	// when the receiver is started, in milliseconds. Accepts either an integer or a time.Duration.
+	// The valid range is greater than 0. The default is 10000 milliseconds.
+	ReceiverPropertyPersistentFlowBindTimeout ReceiverProperty = "solace.messaging.receiver.persistent.flow.bind-timeout"
+
+	// ReceiverPropertyConcurrentDispatchWorkers specifies the number of workers calling the message handler
+	// registered with ReceiveAsync concurrently. The valid range is greater than 0. The default is 1, where
+	// messages are handled one at a time in the order they are received.
+	ReceiverPropertyConcurrentDispatchWorkers ReceiverProperty = "solace.messaging.receiver.concurrent-dispatch.workers"
+
+	// ReceiverPropertyConcurrentDispatchOrdering specifies the ordering preserved when the message handler
+	// is called concurrently. Valid values are of type OrderingPolicy, either UNORDERED, PER_TOPIC or
+	// PER_PARTITION_KEY. The default is UNORDERED. This property only has effect in conjunction with
+	// ReceiverPropertyConcurrentDispatchWorkers.
+	ReceiverPropertyConcurrentDispatchOrdering ReceiverProperty = "solace.messaging.receiver.concurrent-dispatch.ordering"
+
+	// ReceiverPropertyQueueBrowserWindowSize specifies the maximum number of messages a QueueBrowser
+	// requests from the broker at a time. The valid range is 1 to 255.
+	ReceiverPropertyQueueBrowserWindowSize ReceiverProperty = "solace.messaging.receiver.queue-browser.window-size"
 )
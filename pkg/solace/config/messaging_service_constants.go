@@ -28,6 +28,15 @@ const (
 	AuthenticationSchemeOAuth2 = "AUTHENTICATION_SCHEME_OAUTH2"
 )
 
+// The acknowledgement event modes available for use with ServicePropertyPublisherPersistentAckEventMode.
+const (
+	// PersistentPublisherAckEventModePerMessage configures the broker acknowledgements to cover a single message.
+	PersistentPublisherAckEventModePerMessage = "PER_MESSAGE"
+	// PersistentPublisherAckEventModeWindowed configures the broker acknowledgements to cover a message
+	// and all messages published before it.
+	PersistentPublisherAckEventModeWindowed = "WINDOWED"
+)
+
 // DowngradeToPlaintext can be used with TransportLayerSecurityPropertyProtocolDowngradeTo to configure
 // downgrading the TLS connection to the broker to plain-text after authenticating over TLS.
 const DowngradeToPlaintext = "PLAIN_TEXT"
@@ -194,6 +194,23 @@ const (
 	//
 	ServicePropertyPayloadCompressionLevel ServiceProperty = "solace.messaging.service.payload-compression-level"
 
+	// ServicePropertyPublisherPersistentWindowSize specifies the maximum number of persistent messages that can be
+	// published before an acknowledgement must be received from the broker. The window is shared by all
+	// persistent publishers created by the service. The valid range is 1 to 255. Default: 255
+	ServicePropertyPublisherPersistentWindowSize ServiceProperty = "solace.messaging.service.publishers.persistent.window-size"
+
+	// ServicePropertyPublisherPersistentAckTimer specifies the time to wait for an acknowledgement from the broker,
+	// in milliseconds, before unacknowledged persistent messages are retransmitted. Accepts either an integer or a
+	// time.Duration. The valid range is 20 to 60000 milliseconds. Default: 2000
+	ServicePropertyPublisherPersistentAckTimer ServiceProperty = "solace.messaging.service.publishers.persistent.ack-timer"
+
+	// ServicePropertyPublisherPersistentAckEventMode specifies how acknowledgements for persistent messages are
+	// reported by the broker. Valid values are PersistentPublisherAckEventModePerMessage (the default), where each
+	// message is acknowledged individually, and PersistentPublisherAckEventModeWindowed, where a single
+	// acknowledgement covers a message and all messages published before it. Publish receipts are delivered
+	// for every message in either mode. Rejected messages are always reported individually.
+	ServicePropertyPublisherPersistentAckEventMode ServiceProperty = "solace.messaging.service.publishers.persistent.ack-event-mode"
+
 	/* TransportLayerProperties */
 
 	// TransportLayerPropertyHost is IPv4 or IPv6 address or host name of the broker to which to connect.
@@ -256,6 +273,27 @@ const (
 	// using compression (compression level 0), or the compressed listen port if using compression (compression levels 1 to 9).
 	TransportLayerPropertyCompressionLevel ServiceProperty = "solace.messaging.transport.compression-level"
 
+	// TransportLayerPropertyWebTransportProtocol specifies the WebTransportProtocol to use when connecting over a web transport,
+	// such as with a TransportLayerPropertyHost of "ws://broker:80" or "wss://broker:443". The protocols following the given
+	// protocol in the default downgrade list are used if the connection cannot be established.
+	// The default is to use the best protocol available. This property cannot be used together with
+	// TransportLayerPropertyWebTransportProtocolList and should preferably be set with a WebTransportStrategy.
+	TransportLayerPropertyWebTransportProtocol ServiceProperty = "solace.messaging.transport.web.protocol"
+
+	// TransportLayerPropertyWebTransportProtocolList is a comma-separated list of WebTransportProtocol values to use in order
+	// when connecting over a web transport. The next protocol in the list is used if the connection cannot be established with
+	// the previous one. This property cannot be used together with TransportLayerPropertyWebTransportProtocol and should
+	// preferably be set with a WebTransportStrategy.
+	TransportLayerPropertyWebTransportProtocolList ServiceProperty = "solace.messaging.transport.web.protocol-list"
+
+	// TransportLayerPropertyWebTransportProtocolDowngradeTimeout specifies how long to wait for a login response before
+	// downgrading to the next web transport protocol, in milliseconds. The default is 3000 milliseconds.
+	TransportLayerPropertyWebTransportProtocolDowngradeTimeout ServiceProperty = "solace.messaging.transport.web.protocol-downgrade-timeout"
+
+	// TransportLayerPropertyGuaranteedWithWebTransport is a boolean value to enable guaranteed messaging over web transports.
+	// Only WebTransportProtocolWebSocketBinary supports guaranteed messaging. The default is false.
+	TransportLayerPropertyGuaranteedWithWebTransport ServiceProperty = "solace.messaging.transport.web.guaranteed-messaging"
+
 	/* TransportLayerSecurityProperty */
 
 	// TransportLayerSecurityPropertyCertValidated is a boolean property that will specify if server certificates should be validated.
@@ -149,6 +149,84 @@ func RetryStrategyParameterizedRetry(retries uint, retryInterval time.Duration)
 	return RetryStrategy{int(retries), retryInterval}
 }
 
+/* Web Transport Strategy */
+
+// WebTransportProtocol represents the protocols available to the API when connecting over a web transport.
+type WebTransportProtocol string
+
+const (
+	// WebTransportProtocolWebSocketBinary represents binary encoding over the WebSocket protocol.
+	// It is the only web transport protocol supporting guaranteed messaging.
+	WebTransportProtocolWebSocketBinary WebTransportProtocol = "WS_BINARY"
+	// WebTransportProtocolHTTPBinaryStreaming represents binary encoding over HTTP with responses received in streaming mode.
+	WebTransportProtocolHTTPBinaryStreaming WebTransportProtocol = "HTTP_BINARY_STREAMING"
+	// WebTransportProtocolHTTPBinary represents binary encoding over HTTP with responses received in COMET style.
+	WebTransportProtocolHTTPBinary WebTransportProtocol = "HTTP_BINARY"
+)
+
+// WebTransportStrategy represents the strategy to use when connecting to a broker over a web transport,
+// that is when TransportLayerPropertyHost uses the "ws://", "wss://", "http://" or "https://" scheme.
+// By default the best protocol available is used and downgraded to WebTransportProtocolHTTPBinaryStreaming
+// then WebTransportProtocolHTTPBinary if the connection cannot be established.
+type WebTransportStrategy struct {
+	config ServicePropertyMap
+}
+
+// NewWebTransportStrategy creates a web transport strategy with default configuration.
+// Properties can be overwritten by calling the various configuration functions on WebTransportStrategy.
+func NewWebTransportStrategy() WebTransportStrategy {
+	return WebTransportStrategy{
+		config: make(ServicePropertyMap),
+	}
+}
+
+// WithProtocol configures the protocol to connect with first. The protocols following the given protocol
+// in the default downgrade list are used if the connection cannot be established.
+// Overrides any protocols configured with WithProtocols.
+func (wts WebTransportStrategy) WithProtocol(protocol WebTransportProtocol) WebTransportStrategy {
+	delete(wts.config, TransportLayerPropertyWebTransportProtocolList)
+	wts.config[TransportLayerPropertyWebTransportProtocol] = string(protocol)
+	return wts
+}
+
+// WithProtocols configures the protocols to connect with in order. The next protocol is used if the
+// connection cannot be established with the previous one, and only the given protocols are used.
+// Overrides any protocol configured with WithProtocol. At least one protocol must be given, otherwise
+// building the messaging service fails with a solace/errors.*InvalidConfigurationError.
+func (wts WebTransportStrategy) WithProtocols(protocols ...WebTransportProtocol) WebTransportStrategy {
+	protocolsCS := ""
+	for _, protocol := range protocols {
+		if protocolsCS != "" {
+			protocolsCS += ","
+		}
+		protocolsCS += string(protocol)
+	}
+	delete(wts.config, TransportLayerPropertyWebTransportProtocol)
+	wts.config[TransportLayerPropertyWebTransportProtocolList] = protocolsCS
+	return wts
+}
+
+// WithDowngradeTimeout configures how long to wait for a login response before downgrading to the next protocol.
+func (wts WebTransportStrategy) WithDowngradeTimeout(timeout time.Duration) WebTransportStrategy {
+	wts.config[TransportLayerPropertyWebTransportProtocolDowngradeTimeout] = timeout
+	return wts
+}
+
+// WithGuaranteedMessaging enables guaranteed messaging over the web transport, allowing persistent publishers
+// and receivers to be used. Only WebTransportProtocolWebSocketBinary supports guaranteed messaging.
+func (wts WebTransportStrategy) WithGuaranteedMessaging() WebTransportStrategy {
+	wts.config[TransportLayerPropertyGuaranteedWithWebTransport] = true
+	return wts
+}
+
+// ToProperties returns the configuration in the form of a ServicePropertyMap.
+func (wts WebTransportStrategy) ToProperties() ServicePropertyMap {
+	if wts.config == nil {
+		return make(ServicePropertyMap)
+	}
+	return wts.config.GetConfiguration()
+}
+
 /* Transport Security Strategy */
 
 // TransportSecurityStrategy represents the strategy to use when connecting to a broker
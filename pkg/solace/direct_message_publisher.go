@@ -73,6 +73,20 @@ type DirectMessagePublisher interface {
 	// - solace/errors.*PublisherOverflowError - If messages are published faster than the publisher's I/O
 	//   capabilities allow. When publishing can be resumed, the registered  PublisherReadinessListeners are called.
 	PublishWithProperties(message message.OutboundMessage, destination *resource.Topic, properties config.MessagePropertiesConfigurationProvider) error
+
+	// PublishBatch publishes the specified messages of type OutboundMessage in order, sending
+	// as many messages as possible to the transport in each write. Either a single destination
+	// is given for all messages or one destination is given per message.
+	// When the batch is only partially published, each message that was not published is
+	// reported to the registered PublishFailureListener and the error is returned.
+	// Possible errors include:
+	// - solace/errors.*IllegalArgumentError - If the number of destinations does not match the messages.
+	// - solace/errors.*PubSubPlusClientError - If the messages could not be sent and all retry attempts failed.
+	// - solace/errors.*PublisherOverflowError - If messages are published faster than the publisher's I/O
+	//   capabilities allow. With the reject back pressure strategy, no message is published
+	//   when the buffer cannot hold the whole batch. When publishing can be resumed, the registered
+	//   PublisherReadinessListeners are called.
+	PublishBatch(messages []message.OutboundMessage, destinations ...*resource.Topic) error
 }
 
 // PublishFailureListener is a listener that can be registered for publish failure events.
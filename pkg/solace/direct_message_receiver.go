@@ -17,6 +17,7 @@
 package solace
 
 import (
+	"context"
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/config"
@@ -28,6 +29,8 @@ import (
 type DirectMessageReceiver interface {
 	MessageReceiver // Include all functionality of MessageReceiver.
 	ReceiverCacheRequests
+	PayloadDecoder
+	TopicRouter
 
 	// StartAsyncCallback starts the DirectMessageReceiver asynchronously.
 	// Calls the callback when started with an error if one occurred, otherwise nil
@@ -51,6 +54,12 @@ type DirectMessageReceiver interface {
 	// forever if the timeout specified is a negative value. If a timeout occurs, a solace.TimeoutError
 	// is returned.
 	ReceiveMessage(timeout time.Duration) (received message.InboundMessage, err error)
+
+	// ReceiveMessageWithContext receives a message synchronously from the receiver.
+	// Returns an error if the receiver is not started or already terminated.
+	// This function waits until a message is received or the specified context is done,
+	// in which case ctx.Err() is returned.
+	ReceiveMessageWithContext(ctx context.Context) (received message.InboundMessage, err error)
 }
 
 // DirectMessageReceiverBuilder allows for configuration of DirectMessageReceiver instances.
@@ -75,6 +84,18 @@ type DirectMessageReceiverBuilder interface {
 	// WithSubscriptions sets a list of TopicSubscriptions to subscribe
 	// to when starting the receiver. This function also accepts *resource.TopicSubscription subscriptions.
 	WithSubscriptions(topics ...resource.Subscription) DirectMessageReceiverBuilder
+	// WithCodec registers the specified codec to decode message payloads with DecodePayload.
+	// Codecs are matched by the HTTP content type or application message type of a message.
+	// Multiple codecs can be registered with different content types.
+	WithCodec(codec Codec) DirectMessageReceiverBuilder
+	// WithConcurrentDispatch configures the receiver to call the message handler registered with
+	// ReceiveAsync from the specified number of workers concurrently, so that a slow message handler
+	// does not stall the delivery of other messages. The ordering policy defines which messages are
+	// handled in the order they were received: config.OrderingPolicyUnordered, config.OrderingPolicyPerTopic
+	// or config.OrderingPolicyPerPartitionKey. Messages awaiting a worker count towards the receiver's
+	// back pressure, and Terminate waits for in-flight messages to be handled within the grace period.
+	// The value of workers must be greater than or equal to 1. The default is 1.
+	WithConcurrentDispatch(workers uint, ordering config.OrderingPolicy) DirectMessageReceiverBuilder
 	// FromConfigurationProvider configures the DirectMessageReceiver with the specified properties.
 	// The built-in ReceiverPropertiesConfigurationProvider implementations include:
 	// - ReceiverPropertyMap - A map of ReceiverProperty keys to values.
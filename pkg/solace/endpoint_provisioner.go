@@ -1,6 +1,8 @@
 package solace
 
 import (
+	"context"
+
 	"solace.dev/go/messaging/pkg/solace/config"
 )
 
@@ -16,6 +18,13 @@ type EndpointProvisioner interface {
 	// Blocks until the operation is finished on the broker, returns the provision outcome.
 	Provision(queueName string, ignoreExists bool) ProvisionOutcome
 
+	// ProvisionWithContext provisions a queue with the specified name on the broker bearing
+	// all the properties configured on the Provisioner.
+	// Blocks until the operation is finished on the broker or the specified context is done.
+	// If the context is done first, the returned outcome contains ctx.Err().
+	// For more information, see EndpointProvisioner.Provision.
+	ProvisionWithContext(ctx context.Context, queueName string, ignoreExists bool) ProvisionOutcome
+
 	// ProvisionAsync will asynchronously provision a queue with the specified name on
 	// the broker bearing all the properties configured on the Provisioner.
 	// Accepts a boolean parameter to ignore a specific error response from the broker which indicates
@@ -51,6 +60,12 @@ type EndpointProvisioner interface {
 	// Blocks until the operation is finished on the broker, returns the nil or an error
 	Deprovision(queueName string, ignoreMissing bool) error
 
+	// DeprovisionWithContext (deletes) the queue with the given name from the broker.
+	// Blocks until the operation is finished on the broker or the specified context is done.
+	// If the context is done first, ctx.Err() is returned.
+	// For more information, see EndpointProvisioner.Deprovision.
+	DeprovisionWithContext(ctx context.Context, queueName string, ignoreMissing bool) error
+
 	// DeprovisionAsync will asynchronously deprovision (deletes) the queue with the given
 	// name from the broker. Returns immediately.
 	// Ignores all queue properties accumulated in the EndpointProvisioner.
@@ -70,6 +85,62 @@ type EndpointProvisioner interface {
 	// Please note that the callback may not be executed in network order from the broker
 	DeprovisionAsyncWithCallback(queueName string, ignoreMissing bool, callback func(err error))
 
+	// ProvisionTopicEndpoint provisions a topic endpoint with the specified name on the broker bearing
+	// all the properties configured on the Provisioner. Topic endpoints are always exclusive,
+	// so the access type configured with WithExclusiveAccess is ignored.
+	// Accepts a boolean parameter to ignore a specific error response from the broker which indicates
+	// that a topic endpoint with the same name and properties already exists.
+	// Blocks until the operation is finished on the broker, returns the provision outcome.
+	ProvisionTopicEndpoint(topicEndpointName string, ignoreExists bool) ProvisionOutcome
+
+	// ProvisionTopicEndpointWithContext provisions a topic endpoint with the specified name on the broker
+	// bearing all the properties configured on the Provisioner.
+	// Blocks until the operation is finished on the broker or the specified context is done.
+	// If the context is done first, the returned outcome contains ctx.Err().
+	// For more information, see EndpointProvisioner.ProvisionTopicEndpoint.
+	ProvisionTopicEndpointWithContext(ctx context.Context, topicEndpointName string, ignoreExists bool) ProvisionOutcome
+
+	// ProvisionTopicEndpointAsync will asynchronously provision a topic endpoint with the specified name on
+	// the broker bearing all the properties configured on the Provisioner.
+	// For more information, see EndpointProvisioner.ProvisionTopicEndpoint.
+	// Returns a channel immediately that receives the endpoint provision outcome when completed.
+	ProvisionTopicEndpointAsync(topicEndpointName string, ignoreExists bool) <-chan ProvisionOutcome
+
+	// ProvisionTopicEndpointAsyncWithCallback will asynchronously provision a topic endpoint with the
+	// specified name on the broker bearing all the properties configured on the Provisioner.
+	// For more information, see EndpointProvisioner.ProvisionTopicEndpoint.
+	// Returns immediately and registers a callback that will receive an
+	// outcome for the endpoint provision.
+	// Please note that the callback may not be executed in network order from the broker
+	ProvisionTopicEndpointAsyncWithCallback(topicEndpointName string, ignoreExists bool, callback func(ProvisionOutcome))
+
+	// DeprovisionTopicEndpoint (deletes) the topic endpoint with the given name from the broker.
+	// Ignores all properties accumulated in the EndpointProvisioner.
+	// Accepts the ignoreMissing boolean property, which, if set to true,
+	// turns the "no such topic endpoint" error into nil.
+	// Blocks until the operation is finished on the broker, returns the nil or an error
+	DeprovisionTopicEndpoint(topicEndpointName string, ignoreMissing bool) error
+
+	// DeprovisionTopicEndpointWithContext (deletes) the topic endpoint with the given name from the broker.
+	// Blocks until the operation is finished on the broker or the specified context is done.
+	// If the context is done first, ctx.Err() is returned.
+	// For more information, see EndpointProvisioner.DeprovisionTopicEndpoint.
+	DeprovisionTopicEndpointWithContext(ctx context.Context, topicEndpointName string, ignoreMissing bool) error
+
+	// DeprovisionTopicEndpointAsync will asynchronously deprovision (deletes) the topic endpoint
+	// with the given name from the broker.
+	// For more information, see EndpointProvisioner.DeprovisionTopicEndpoint.
+	// Returns a channel immediately that receives nil or an error.
+	DeprovisionTopicEndpointAsync(topicEndpointName string, ignoreMissing bool) <-chan error
+
+	// DeprovisionTopicEndpointAsyncWithCallback will asynchronously deprovision (deletes) the topic
+	// endpoint with the given name on the broker.
+	// For more information, see EndpointProvisioner.DeprovisionTopicEndpoint.
+	// Returns immediately and registers a callback that will receive an
+	// error if deprovision on the broker fails.
+	// Please note that the callback may not be executed in network order from the broker
+	DeprovisionTopicEndpointAsyncWithCallback(topicEndpointName string, ignoreMissing bool, callback func(err error))
+
 	// FromConfigurationProvider sets the configuration based on the specified configuration provider.
 	// The following are built in configuration providers:
 	// - EndpointPropertyMap - This can be used to set an EndpointProperty to a value programatically.
@@ -16,7 +16,10 @@
 
 package solace
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // LifecycleControl contains lifecycle functionality common to
 // various messaging  services such as publishers and receivers.
@@ -50,6 +53,13 @@ type LifecycleControl interface {
 	// If gracePeriod is set to less than 0, the function waits indefinitely.
 	Terminate(gracePeriod time.Duration) error
 
+	// TerminateWithContext terminates the messaging service gracefully and synchronously.
+	// A graceful shutdown is attempted until the specified context is done, at which point
+	// unfinished tasks or in-flight messages are ignored as when the grace period of Terminate elapses.
+	// A context that is never done implies waiting indefinitely.
+	// For more information, see LifecycleControl.Terminate.
+	TerminateWithContext(ctx context.Context) error
+
 	// TerminateAsync terminates the messaging service asynchronously.
 	// This function is idempotent. The only way to resume operation
 	// after this function is called is to create another instance.
@@ -14,13 +14,16 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// Package logging allows for configuration of the API's logging levels.
+// Package logging allows for configuration of the API's logging levels and output.
+// By default the API writes formatted log lines to os.Stderr. The output can be redirected
+// to an io.Writer with SetLogOutput, or structured records can be passed to a Logger with
+// SetLogger, including the records logged by the native library.
 package logging
 
 import (
 	"io"
+	"time"
 
-	"solace.dev/go/messaging/internal/impl/core"
 	"solace.dev/go/messaging/internal/impl/logging"
 )
 
@@ -61,7 +64,7 @@ const (
 
 // SetLogLevel sets the global logging-level used for API logging.
 func SetLogLevel(level LogLevel) {
-	core.SetLogLevel(logging.LogLevel(level))
+	logging.Default.SetLevel(logging.LogLevel(level))
 }
 
 // SetLogOutput sets the global logging output redirection for API logging.
@@ -69,3 +72,82 @@ func SetLogLevel(level LogLevel) {
 func SetLogOutput(writer io.Writer) {
 	logging.Default.SetOutput(writer)
 }
+
+// Attribute keys set on the records passed to a Logger. Attributes are only set when known,
+// for example the flow ID is only set on records logged for a guaranteed message flow.
+const (
+	// AttributeComponent identifies the part of the API that logged a record, for example
+	// "persistentMessageReceiverImpl", or "ccsmp" for records logged by the native library.
+	AttributeComponent = logging.AttributeComponent
+	// AttributeClientName carries the client name of the MessagingService.
+	AttributeClientName = logging.AttributeClientName
+	// AttributeFlowID carries the client side identifier of a guaranteed message flow.
+	AttributeFlowID = logging.AttributeFlowID
+	// AttributeDestination carries the name of the queue or topic a publisher or receiver is bound to.
+	AttributeDestination = logging.AttributeDestination
+	// AttributeSubcode carries the subcode of a native error.
+	AttributeSubcode = logging.AttributeSubcode
+)
+
+// Attribute is a key value pair attached to a Record.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Record is a structured log record of the API.
+type Record struct {
+	// Time is the time the record was logged.
+	Time time.Time
+	// Level is the logging-level of the record.
+	Level LogLevel
+	// Message is the log message without any attributes.
+	Message string
+	// Attributes holds the attributes of the record, such as AttributeComponent.
+	Attributes []Attribute
+}
+
+// Logger receives the structured log records of the API. A Logger must be safe for concurrent use,
+// and should not block as records may be logged from the API's internal goroutines.
+type Logger interface {
+	// Log is called with every record at or above the logging-level set with SetLogLevel.
+	Log(record Record)
+}
+
+// LoggerFunc is an adapter to allow the use of ordinary functions as a Logger.
+type LoggerFunc func(record Record)
+
+// Log calls loggerFunc(record).
+func (loggerFunc LoggerFunc) Log(record Record) {
+	loggerFunc(record)
+}
+
+// SetLogger sets the global Logger receiving all API logs, including the logs of the native library.
+// While a Logger is set, nothing is written to the output set with SetLogOutput.
+// Passing nil restores the formatted output. A MessagingService built with
+// solace.MessagingServiceBuilder.WithLogger logs to its own Logger instead.
+func SetLogger(logger Logger) {
+	if logger == nil {
+		logging.Default.SetHandler(nil)
+		return
+	}
+	logging.Default.SetHandler(HandlerFor(logger))
+}
+
+// HandlerFor returns a handler converting the records of the API's internal logger and passing them
+// to the given Logger. It is used by the API to set the Logger of a MessagingService and is not
+// intended to be called by applications.
+func HandlerFor(logger Logger) logging.Handler {
+	return func(record logging.Record) {
+		attributes := make([]Attribute, len(record.Attributes))
+		for i, attribute := range record.Attributes {
+			attributes[i] = Attribute(attribute)
+		}
+		logger.Log(Record{
+			Time:       record.Time,
+			Level:      LogLevel(record.Level),
+			Message:    record.Message,
+			Attributes: attributes,
+		})
+	}
+}
@@ -0,0 +1,48 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/logging"
+)
+
+func TestHandlerFor(t *testing.T) {
+	var records []Record
+	handler := HandlerFor(LoggerFunc(func(record Record) {
+		records = append(records, record)
+	}))
+	now := time.Now()
+	handler(logging.Record{
+		Time:       now,
+		Level:      logging.Error,
+		Message:    "flow down",
+		Attributes: []logging.Attribute{{Key: AttributeFlowID, Value: uint64(1)}},
+	})
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	record := records[0]
+	if !record.Time.Equal(now) || record.Level != LogLevelError || record.Message != "flow down" {
+		t.Errorf("unexpected record %+v", record)
+	}
+	if len(record.Attributes) != 1 || record.Attributes[0] != (Attribute{Key: AttributeFlowID, Value: uint64(1)}) {
+		t.Errorf("unexpected attributes %v", record.Attributes)
+	}
+}
@@ -0,0 +1,79 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+// +build go1.21
+
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// SlogLevelCritical is the slog.Level of records logged at LogLevelCritical.
+const SlogLevelCritical = slog.LevelError + 4
+
+// SlogLevel returns the slog.Level corresponding to the logging-level.
+func (level LogLevel) SlogLevel() slog.Level {
+	switch level {
+	case LogLevelCritical:
+		return SlogLevelCritical
+	case LogLevelError:
+		return slog.LevelError
+	case LogLevelWarning:
+		return slog.LevelWarn
+	case LogLevelInfo:
+		return slog.LevelInfo
+	default:
+		return slog.LevelDebug
+	}
+}
+
+// NewSlogLogger returns a Logger passing records to the handler of the given *slog.Logger.
+// Records are logged with the level returned by LogLevel.SlogLevel and with their attributes,
+// in addition to any attributes of the given logger. Records are dropped when the handler
+// is not enabled for their level.
+func NewSlogLogger(logger *slog.Logger) Logger {
+	return &slogLogger{handler: logger.Handler()}
+}
+
+// SetSlogLogger sets the global Logger to a Logger passing records to the given *slog.Logger.
+// It is equivalent to SetLogger(NewSlogLogger(logger)), and passing nil restores the formatted output.
+func SetSlogLogger(logger *slog.Logger) {
+	if logger == nil {
+		SetLogger(nil)
+		return
+	}
+	SetLogger(NewSlogLogger(logger))
+}
+
+type slogLogger struct {
+	handler slog.Handler
+}
+
+func (logger *slogLogger) Log(record Record) {
+	ctx := context.Background()
+	level := record.Level.SlogLevel()
+	if !logger.handler.Enabled(ctx, level) {
+		return
+	}
+	slogRecord := slog.NewRecord(record.Time, level, record.Message, 0)
+	for _, attribute := range record.Attributes {
+		slogRecord.AddAttrs(slog.Any(attribute.Key, attribute.Value))
+	}
+	logger.handler.Handle(ctx, slogRecord)
+}
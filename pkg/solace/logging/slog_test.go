@@ -0,0 +1,61 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.21
+// +build go1.21
+
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestSlogLogger(t *testing.T) {
+	buffer := &bytes.Buffer{}
+	logger := NewSlogLogger(slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{Level: slog.LevelInfo})))
+	logger.Log(Record{Time: time.Now(), Level: LogLevelDebug, Message: "filtered"})
+	if buffer.Len() != 0 {
+		t.Errorf("expected debug record to be filtered, got %s", buffer.String())
+	}
+	logger.Log(Record{
+		Time:    time.Now(),
+		Level:   LogLevelCritical,
+		Message: "session down",
+		Attributes: []Attribute{
+			{Key: AttributeComponent, Value: "ccsmp"},
+			{Key: AttributeClientName, Value: "client"},
+		},
+	})
+	var entry map[string]interface{}
+	if err := json.Unmarshal(buffer.Bytes(), &entry); err != nil {
+		t.Fatalf("expected a JSON log entry, got %s", buffer.String())
+	}
+	expected := map[string]interface{}{
+		"level":             SlogLevelCritical.String(),
+		"msg":               "session down",
+		AttributeComponent:  "ccsmp",
+		AttributeClientName: "client",
+	}
+	for key, value := range expected {
+		if entry[key] != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, entry[key])
+		}
+	}
+}
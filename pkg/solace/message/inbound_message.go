@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/message/rgmid"
+	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
 // CacheStatus indicates whether or not a message was received as a part of a cache response
@@ -52,6 +53,13 @@ type InboundMessage interface {
 	// An empty string is returned if the information is not available.
 	GetDestinationName() string
 
+	// GetReplyToDestination retrieves the reply to destination of the message, if one is set.
+	// The returned destination is either a *resource.Topic or a *resource.Queue. The
+	// exclusivity of a queue is not carried on the message, so a returned queue only reports
+	// its name and durability reliably.
+	// Returns the destination and true if a reply to destination is set, otherwise nil and false.
+	GetReplyToDestination() (destination resource.Destination, ok bool)
+
 	// GetTimeStamp retrieves the timestamp as time.Time.
 	// This timestamp represents the time that the message was received by the API.
 	// This may differ from the time that the message is received by the MessageReceiver.
@@ -114,6 +114,40 @@ type Message interface {
 	//  2 | COS_3
 	GetClassOfService() (cos int)
 
+	// GetCreationTraceContext returns the W3C trace context metadata that was attached to the message
+	// when it was created, used for distributed message tracing across service boundaries.
+	// The creation context allows correlating the producer with the consumers of a message regardless
+	// of any intermediaries, and must not be altered by intermediaries.
+	// Returns the trace ID, span ID, sampled flag and trace state, otherwise ok is false if the
+	// creation context is not accessible.
+	GetCreationTraceContext() (traceID [16]byte, spanID [8]byte, sampled bool, traceState string, ok bool)
+
+	// SetCreationTraceContext sets the W3C trace context metadata describing the creation of the message.
+	// The creation context is considered immutable once the message is published, and should not be set
+	// multiple times. The traceState argument can be nil to not set a trace state.
+	// Returns false if the context could not be set on the message.
+	SetCreationTraceContext(traceID [16]byte, spanID [8]byte, sampled bool, traceState *string) (ok bool)
+
+	// GetTransportTraceContext returns the W3C trace context metadata used to correlate the producer and
+	// consumers of a message with any intermediaries. When no transport context is present, the creation
+	// context may be returned as the initial transport context.
+	// Returns the trace ID, span ID, sampled flag and trace state, otherwise ok is false if the
+	// transport context is not accessible.
+	GetTransportTraceContext() (traceID [16]byte, spanID [8]byte, sampled bool, traceState string, ok bool)
+
+	// SetTransportTraceContext sets the W3C trace context metadata describing the current hop of the message.
+	// The traceState argument can be nil to not set a trace state.
+	// Returns false if the context could not be set on the message.
+	SetTransportTraceContext(traceID [16]byte, spanID [8]byte, sampled bool, traceState *string) (ok bool)
+
+	// GetBaggage returns the W3C baggage string carried by the message. The baggage is expected to be
+	// UTF-8 encoded. Returns an empty string and ok is false if the baggage is not accessible.
+	GetBaggage() (baggage string, ok bool)
+
+	// SetBaggage sets the W3C baggage string carried by the message. The baggage is expected to be
+	// UTF-8 encoded. Returns an error if the baggage could not be set on the message.
+	SetBaggage(baggage string) error
+
 	// String implements fmt.Stringer. Prints the message as a string. A truncated response
 	// may be returned when large payloads or properties are attached.
 	String() string
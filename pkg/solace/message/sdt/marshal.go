@@ -0,0 +1,453 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdt
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// tagName is the struct tag read by Marshal and Unmarshal
+const tagName = "sdt"
+
+var (
+	wcharType       = reflect.TypeOf(WChar(0))
+	mapType         = reflect.TypeOf(Map{})
+	streamType      = reflect.TypeOf(Stream{})
+	byteArrayType   = reflect.TypeOf([]byte{})
+	topicType       = reflect.TypeOf(&resource.Topic{})
+	queueType       = reflect.TypeOf(&resource.Queue{})
+	destinationType = reflect.TypeOf((*resource.Destination)(nil)).Elem()
+)
+
+// Marshal converts the specified struct, or pointer to a struct, into an sdt.Map. Each exported
+// field is stored under its name, or the name given by its "sdt" struct tag, for example:
+//
+//	type Order struct {
+//		ID       int64             `sdt:"id"`
+//		Customer Customer          `sdt:"customer"`
+//		Lines    []Line            `sdt:"lines,omitempty"`
+//		ReplyTo  *resource.Topic   `sdt:"replyTo"`
+//		Internal string            `sdt:"-"`
+//	}
+//
+// A tag of "-" skips the field, and the omitempty option skips the field when it holds a zero value.
+// The fields of embedded structs without a tag are stored as if they were fields of the outer struct.
+// Nested structs are stored as sub-maps, maps with string keys as sdt.Map, slices and arrays as
+// sdt.Stream, and nil pointers, slices and maps as nil. []byte, sdt.WChar, *resource.Topic,
+// *resource.Queue, sdt.Map and sdt.Stream values are stored as is, and the remaining values are
+// stored as their underlying bool, string, float or sized integer type, with int stored as int64
+// and uint stored as uint64. Returns an sdt.IllegalTypeError if a field holds a value that cannot
+// be converted to sdt.Data, such as a channel or function, or that refers back to itself through
+// pointers, maps or slices, or an sdt.FormatConversionError if the specified value is not a struct.
+func Marshal(v interface{}) (Map, error) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, defaultFormatConversionError(v, "sdt.Map")
+	}
+	return (&marshaler{}).marshalStruct(value)
+}
+
+// Unmarshal stores the entries of the specified sdt.Map in the struct pointed to by v, matching
+// keys to fields as described by Marshal. Entries are converted to the type of their field using
+// the same rules as the getters of sdt.Map, as described on sdt.Data, such that for example an
+// int8 entry can be stored in an int64 field, and an int64 entry in an int8 field if it is within
+// range. Sub-maps are stored in struct and map fields, and streams in slice and array fields.
+// Pointers are allocated as needed, and nil entries set pointer, slice, map and interface fields
+// to nil. Fields without an entry, and entries without a field, are left untouched.
+// Returns an sdt.FormatConversionError if an entry cannot be converted to the type of its field,
+// or if v is not a non-nil pointer to a struct.
+func Unmarshal(sdtMap Map, v interface{}) error {
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return &FormatConversionError{
+			Message: fmt.Sprintf("cannot unmarshal into %T, expected a non-nil pointer to a struct", v),
+			Data:    v,
+		}
+	}
+	return unmarshalStruct(sdtMap, value.Elem(), "")
+}
+
+// structField is an exported field of a struct as read by Marshal and Unmarshal
+type structField struct {
+	name      string
+	index     []int
+	omitEmpty bool
+}
+
+// structFields returns the fields of the given struct type, including the fields of untagged embedded structs
+func structFields(structType reflect.Type) []structField {
+	var fields []structField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		tag := field.Tag.Get(tagName)
+		if tag == "-" {
+			continue
+		}
+		name, options := tag, ""
+		if comma := strings.IndexByte(tag, ','); comma >= 0 {
+			name, options = tag[:comma], tag[comma+1:]
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for _, embedded := range structFields(field.Type) {
+				embedded.index = append([]int{i}, embedded.index...)
+				fields = append(fields, embedded)
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			// unexported field
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, structField{
+			name:      name,
+			index:     []int{i},
+			omitEmpty: strings.Contains(","+options+",", ",omitempty,"),
+		})
+	}
+	return fields
+}
+
+// marshaler holds the state of a call to Marshal
+type marshaler struct {
+	// visiting holds the pointers, maps and slices currently being marshalled, to detect cycles
+	visiting map[visitKey]struct{}
+}
+
+// visitKey identifies a pointer, map or slice, where slices sharing an array are told apart by their length
+type visitKey struct {
+	pointer   uintptr
+	valueType reflect.Type
+	length    int
+}
+
+// enter marks the given pointer, map or slice as being marshalled, returning an IllegalTypeError if it already is
+func (m *marshaler) enter(value reflect.Value) (visitKey, error) {
+	key := visitKey{pointer: value.Pointer(), valueType: value.Type()}
+	if value.Kind() == reflect.Slice {
+		key.length = value.Len()
+	}
+	if _, ok := m.visiting[key]; ok {
+		return key, &IllegalTypeError{Data: value.Interface()}
+	}
+	if m.visiting == nil {
+		m.visiting = make(map[visitKey]struct{})
+	}
+	m.visiting[key] = struct{}{}
+	return key, nil
+}
+
+// leave marks the given pointer, map or slice as marshalled, such that it may be referred to again outside of a cycle
+func (m *marshaler) leave(key visitKey) {
+	delete(m.visiting, key)
+}
+
+func (m *marshaler) marshalStruct(value reflect.Value) (Map, error) {
+	fields := structFields(value.Type())
+	sdtMap := make(Map, len(fields))
+	for _, field := range fields {
+		fieldValue := value.FieldByIndex(field.index)
+		if field.omitEmpty && fieldValue.IsZero() {
+			continue
+		}
+		data, err := m.marshalValue(fieldValue)
+		if err != nil {
+			return nil, err
+		}
+		sdtMap[field.name] = data
+	}
+	return sdtMap, nil
+}
+
+func (m *marshaler) marshalValue(value reflect.Value) (Data, error) {
+	switch value.Type() {
+	case wcharType:
+		return WChar(value.Uint()), nil
+	case mapType, streamType, topicType, queueType:
+		if value.IsNil() {
+			return nil, nil
+		}
+		return value.Interface(), nil
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		return value.Bool(), nil
+	case reflect.Int, reflect.Int64:
+		return value.Int(), nil
+	case reflect.Int8:
+		return int8(value.Int()), nil
+	case reflect.Int16:
+		return int16(value.Int()), nil
+	case reflect.Int32:
+		return int32(value.Int()), nil
+	case reflect.Uint, reflect.Uint64:
+		return value.Uint(), nil
+	case reflect.Uint8:
+		return uint8(value.Uint()), nil
+	case reflect.Uint16:
+		return uint16(value.Uint()), nil
+	case reflect.Uint32:
+		return uint32(value.Uint()), nil
+	case reflect.Float32:
+		return float32(value.Float()), nil
+	case reflect.Float64:
+		return value.Float(), nil
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Struct:
+		return m.marshalStruct(value)
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil, nil
+		}
+		return m.marshalValue(value.Elem())
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil, nil
+		}
+		key, err := m.enter(value)
+		if err != nil {
+			return nil, err
+		}
+		defer m.leave(key)
+		return m.marshalValue(value.Elem())
+	case reflect.Slice:
+		if value.IsNil() {
+			return nil, nil
+		}
+		if value.Type().Elem().Kind() == reflect.Uint8 && value.Type().ConvertibleTo(byteArrayType) {
+			return value.Convert(byteArrayType).Interface(), nil
+		}
+		key, err := m.enter(value)
+		if err != nil {
+			return nil, err
+		}
+		defer m.leave(key)
+		return m.marshalStream(value)
+	case reflect.Array:
+		return m.marshalStream(value)
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			break
+		}
+		if value.IsNil() {
+			return nil, nil
+		}
+		key, err := m.enter(value)
+		if err != nil {
+			return nil, err
+		}
+		defer m.leave(key)
+		sdtMap := make(Map, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			data, err := m.marshalValue(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+			sdtMap[iter.Key().String()] = data
+		}
+		return sdtMap, nil
+	}
+	return nil, &IllegalTypeError{Data: value.Interface()}
+}
+
+func (m *marshaler) marshalStream(value reflect.Value) (Stream, error) {
+	stream := make(Stream, value.Len())
+	for i := range stream {
+		data, err := m.marshalValue(value.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		stream[i] = data
+	}
+	return stream, nil
+}
+
+func unmarshalStruct(sdtMap Map, value reflect.Value, path string) error {
+	for _, field := range structFields(value.Type()) {
+		data, ok := sdtMap[field.name]
+		if !ok {
+			continue
+		}
+		if err := unmarshalValue(data, value.FieldByIndex(field.index), path+field.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalValue stores the given data in the given settable value, the path names the value in errors
+func unmarshalValue(data Data, value reflect.Value, path string) error {
+	if data == nil {
+		switch value.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+			value.Set(reflect.Zero(value.Type()))
+		}
+		return nil
+	}
+	var converted interface{}
+	var err error
+	switch value.Type() {
+	case wcharType:
+		converted, err = getWChar(data)
+	case mapType:
+		converted, err = getMap(data)
+	case streamType:
+		converted, err = getStream(data)
+	case byteArrayType:
+		converted, err = getByteArray(data)
+	case topicType:
+		converted, err = getTopic(data)
+	case queueType:
+		converted, err = getQueue(data)
+	case destinationType:
+		converted, err = getDestination(data)
+	}
+	if converted != nil || err != nil {
+		if err != nil {
+			return unmarshalError(path, data, err)
+		}
+		value.Set(reflect.ValueOf(converted))
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.Bool:
+		var b bool
+		if b, err = getBool(data); err == nil {
+			value.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = parseInt(data, value.Type().Bits()); err == nil {
+			value.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if u, err = parseUint(data, value.Type().Bits()); err == nil {
+			value.SetUint(u)
+		}
+	case reflect.Float32:
+		var f float32
+		if f, err = getFloat32(data); err == nil {
+			value.SetFloat(float64(f))
+		}
+	case reflect.Float64:
+		var f float64
+		if f, err = getFloat64(data); err == nil {
+			value.SetFloat(f)
+		}
+	case reflect.String:
+		var s string
+		if s, err = getString(data); err == nil {
+			value.SetString(s)
+		}
+	case reflect.Struct:
+		var sdtMap Map
+		if sdtMap, err = getMap(data); err == nil {
+			return unmarshalStruct(sdtMap, value, path+".")
+		}
+	case reflect.Ptr:
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		return unmarshalValue(data, value.Elem(), path)
+	case reflect.Interface:
+		if reflect.TypeOf(data).AssignableTo(value.Type()) {
+			value.Set(reflect.ValueOf(data))
+			return nil
+		}
+		err = defaultFormatConversionError(data, value.Type().String())
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 && byteArrayType.ConvertibleTo(value.Type()) {
+			var b []byte
+			if b, err = getByteArray(data); err == nil {
+				value.Set(reflect.ValueOf(b).Convert(value.Type()))
+			}
+			break
+		}
+		var stream Stream
+		if stream, err = getStream(data); err == nil {
+			slice := reflect.MakeSlice(value.Type(), len(stream), len(stream))
+			if err := unmarshalStream(stream, slice, path); err != nil {
+				return err
+			}
+			value.Set(slice)
+			return nil
+		}
+	case reflect.Array:
+		var stream Stream
+		if stream, err = getStream(data); err == nil {
+			value.Set(reflect.Zero(value.Type()))
+			if len(stream) > value.Len() {
+				stream = stream[:value.Len()]
+			}
+			return unmarshalStream(stream, value, path)
+		}
+	case reflect.Map:
+		var sdtMap Map
+		if sdtMap, err = getMap(data); err == nil && value.Type().Key().Kind() == reflect.String {
+			converted := reflect.MakeMapWithSize(value.Type(), len(sdtMap))
+			for key, entry := range sdtMap {
+				elem := reflect.New(value.Type().Elem()).Elem()
+				if err := unmarshalValue(entry, elem, path+"."+key); err != nil {
+					return err
+				}
+				converted.SetMapIndex(reflect.ValueOf(key).Convert(value.Type().Key()), elem)
+			}
+			value.Set(converted)
+			return nil
+		} else if err == nil {
+			err = defaultFormatConversionError(data, value.Type().String())
+		}
+	default:
+		err = defaultFormatConversionError(data, value.Type().String())
+	}
+	if err != nil {
+		return unmarshalError(path, data, err)
+	}
+	return nil
+}
+
+func unmarshalStream(stream Stream, value reflect.Value, path string) error {
+	for i, entry := range stream {
+		if err := unmarshalValue(entry, value.Index(i), fmt.Sprintf("%s[%d]", path, i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalError names the value that could not be unmarshalled in the given conversion error
+func unmarshalError(path string, data Data, err error) error {
+	if _, ok := err.(*FormatConversionError); !ok {
+		return err
+	}
+	return &FormatConversionError{
+		Message: fmt.Sprintf("cannot unmarshal %s: %s", path, err.Error()),
+		Data:    data,
+	}
+}
@@ -0,0 +1,217 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sdt_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"solace.dev/go/messaging/pkg/solace/message/sdt"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+type marshalAddress struct {
+	City string `sdt:"city"`
+	Zip  int32  `sdt:"zip"`
+}
+
+type marshalAudit struct {
+	Version uint16 `sdt:"version"`
+}
+
+type marshalOrder struct {
+	marshalAudit
+	ID        int64             `sdt:"id"`
+	Count     int               `sdt:"count"`
+	Price     float64           `sdt:"price"`
+	Paid      bool              `sdt:"paid"`
+	Grade     sdt.WChar         `sdt:"grade"`
+	Signature []byte            `sdt:"signature"`
+	Address   marshalAddress    `sdt:"address"`
+	Previous  *marshalAddress   `sdt:"previous"`
+	Lines     []marshalAddress  `sdt:"lines"`
+	Tags      []string          `sdt:"tags"`
+	Labels    map[string]string `sdt:"labels"`
+	ReplyTo   *resource.Topic   `sdt:"replyTo"`
+	DeadQueue *resource.Queue   `sdt:"deadQueue"`
+	Note      string            `sdt:"note,omitempty"`
+	Untagged  string
+	Skipped   string `sdt:"-"`
+	internal  string
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	order := marshalOrder{
+		marshalAudit: marshalAudit{Version: 3},
+		ID:           42,
+		Count:        7,
+		Price:        9.5,
+		Paid:         true,
+		Grade:        sdt.WChar('A'),
+		Signature:    []byte{1, 2, 3},
+		Address:      marshalAddress{City: "Ottawa", Zip: 12345},
+		Previous:     &marshalAddress{City: "Kanata"},
+		Lines:        []marshalAddress{{City: "a"}, {City: "b"}},
+		Tags:         []string{"x", "y"},
+		Labels:       map[string]string{"k": "v"},
+		ReplyTo:      resource.TopicOf("reply/topic"),
+		DeadQueue:    resource.QueueDurableExclusive("dmq"),
+		Untagged:     "untagged",
+		Skipped:      "skipped",
+		internal:     "internal",
+	}
+	sdtMap, err := sdt.Marshal(&order)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := sdt.Map{
+		"version":   uint16(3),
+		"id":        int64(42),
+		"count":     int64(7),
+		"price":     9.5,
+		"paid":      true,
+		"grade":     sdt.WChar('A'),
+		"signature": []byte{1, 2, 3},
+		"address":   sdt.Map{"city": "Ottawa", "zip": int32(12345)},
+		"previous":  sdt.Map{"city": "Kanata", "zip": int32(0)},
+		"lines":     sdt.Stream{sdt.Map{"city": "a", "zip": int32(0)}, sdt.Map{"city": "b", "zip": int32(0)}},
+		"tags":      sdt.Stream{"x", "y"},
+		"labels":    sdt.Map{"k": "v"},
+		"replyTo":   order.ReplyTo,
+		"deadQueue": order.DeadQueue,
+		"Untagged":  "untagged",
+	}
+	if !reflect.DeepEqual(sdtMap, expected) {
+		t.Errorf("expected %v, got %v", expected, sdtMap)
+	}
+
+	var decoded marshalOrder
+	if err := sdt.Unmarshal(sdtMap, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	order.Skipped = ""
+	order.internal = ""
+	if !reflect.DeepEqual(decoded, order) {
+		t.Errorf("expected %+v, got %+v", order, decoded)
+	}
+}
+
+func TestUnmarshalConversions(t *testing.T) {
+	var target struct {
+		Small  int8      `sdt:"small"`
+		Wide   int64     `sdt:"wide"`
+		Parsed uint32    `sdt:"parsed"`
+		Flag   bool      `sdt:"flag"`
+		Char   sdt.WChar `sdt:"char"`
+		Ptr    *int      `sdt:"ptr"`
+		Any    interface{}
+	}
+	target.Ptr = new(int)
+	err := sdt.Unmarshal(sdt.Map{
+		"small":  int64(-5),
+		"wide":   uint8(200),
+		"parsed": "123",
+		"flag":   int32(1),
+		"char":   "z",
+		"ptr":    nil,
+		"Any":    sdt.Stream{int8(1)},
+	}, &target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Small != -5 || target.Wide != 200 || target.Parsed != 123 || !target.Flag || target.Char != 'z' || target.Ptr != nil {
+		t.Errorf("unexpected conversion result %+v", target)
+	}
+	if !reflect.DeepEqual(target.Any, sdt.Stream{int8(1)}) {
+		t.Errorf("expected interface field to hold the stream, got %v", target.Any)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var target struct {
+		Small int8 `sdt:"small"`
+		Lines []struct {
+			Zip int16 `sdt:"zip"`
+		} `sdt:"lines"`
+	}
+	testCases := []struct {
+		sdtMap sdt.Map
+		path   string
+	}{
+		{sdt.Map{"small": int64(1000)}, "small"},
+		{sdt.Map{"small": 1.5}, "small"},
+		{sdt.Map{"lines": "not a stream"}, "lines"},
+		{sdt.Map{"lines": sdt.Stream{sdt.Map{"zip": int32(1)}, sdt.Map{"zip": "x"}}}, "lines[1].zip"},
+	}
+	for _, testCase := range testCases {
+		err := sdt.Unmarshal(testCase.sdtMap, &target)
+		var conversionErr *sdt.FormatConversionError
+		if !errors.As(err, &conversionErr) {
+			t.Errorf("expected format conversion error for %v, got %v", testCase.sdtMap, err)
+			continue
+		}
+		if !strings.Contains(conversionErr.Error(), testCase.path+":") {
+			t.Errorf("expected error to name %s, got %s", testCase.path, conversionErr.Error())
+		}
+	}
+	if err := sdt.Unmarshal(sdt.Map{}, target); !errors.As(err, new(*sdt.FormatConversionError)) {
+		t.Errorf("expected format conversion error unmarshalling into a non-pointer, got %v", err)
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	if _, err := sdt.Marshal(42); !errors.As(err, new(*sdt.FormatConversionError)) {
+		t.Errorf("expected format conversion error marshalling a non-struct, got %v", err)
+	}
+	if _, err := sdt.Marshal(struct{ C chan int }{make(chan int)}); !errors.As(err, new(*sdt.IllegalTypeError)) {
+		t.Errorf("expected illegal type error marshalling a channel, got %v", err)
+	}
+}
+
+type marshalNode struct {
+	Name string
+	Next *marshalNode
+}
+
+func TestMarshalCycles(t *testing.T) {
+	node := &marshalNode{Name: "node"}
+	node.Next = node
+	if _, err := sdt.Marshal(node); !errors.As(err, new(*sdt.IllegalTypeError)) {
+		t.Errorf("expected illegal type error marshalling a pointer cycle, got %v", err)
+	}
+	cyclicMap := map[string]interface{}{}
+	cyclicMap["self"] = cyclicMap
+	if _, err := sdt.Marshal(struct{ M map[string]interface{} }{cyclicMap}); !errors.As(err, new(*sdt.IllegalTypeError)) {
+		t.Errorf("expected illegal type error marshalling a map cycle, got %v", err)
+	}
+	cyclicSlice := []interface{}{nil}
+	cyclicSlice[0] = cyclicSlice
+	if _, err := sdt.Marshal(struct{ S []interface{} }{cyclicSlice}); !errors.As(err, new(*sdt.IllegalTypeError)) {
+		t.Errorf("expected illegal type error marshalling a slice cycle, got %v", err)
+	}
+	// a value referred to twice without a cycle is marshalled twice
+	shared := &marshalNode{Name: "shared"}
+	sdtMap, err := sdt.Marshal(struct{ A, B *marshalNode }{shared, shared})
+	if err != nil {
+		t.Fatalf("expected no error marshalling a shared pointer, got %v", err)
+	}
+	if a, b := sdtMap["A"].(sdt.Map), sdtMap["B"].(sdt.Map); a["Name"] != "shared" || b["Name"] != "shared" {
+		t.Errorf("expected the shared pointer to be marshalled for both fields, got %v", sdtMap)
+	}
+}
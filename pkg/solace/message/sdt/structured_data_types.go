@@ -19,7 +19,8 @@
 // used as normal Golang maps and slices as well as can be passed as a payload on a message.
 // When retrieved, a map will contain the converted data converted as per the table on sdt.Data.
 // Furthermore, data types can be converted using the various getters such as GetBool which will
-// attempt to convert the specified data into a builtin.bool.
+// attempt to convert the specified data into a builtin.bool. Structs can be converted to and from
+// an sdt.Map with Marshal and Unmarshal, driven by "sdt" struct tags.
 package sdt
 
 import (
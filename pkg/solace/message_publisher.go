@@ -16,10 +16,17 @@
 
 package solace
 
+import "solace.dev/go/messaging/pkg/solace/metrics"
+
 // MessagePublisher represents the shared functionality between all publisher instances.
 type MessagePublisher interface {
 	// Extend LifecycleControl for various lifecycle management functionality.
 	LifecycleControl
+
+	// Metrics returns the metrics of this publisher, such as the number of messages sent and
+	// the number of back-pressure events. The metrics are maintained alongside the APIMetrics
+	// of the MessagingService the publisher was built from.
+	Metrics() metrics.PublisherMetrics
 }
 
 // MessagePublisherHealthCheck allows applications to check and listen for events
@@ -18,6 +18,7 @@ package solace
 
 import (
 	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
 	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
@@ -56,6 +57,11 @@ type MessageReceiver interface {
 	// Returns a solace/errors.*IllegalArgumentError if unsupported Subscription type is passed.
 	// Returns nil if successful.
 	RemoveSubscriptionAsync(subscription resource.Subscription, listener SubscriptionChangeListener) error
+
+	// Metrics returns the metrics of this receiver, such as the number of messages received and
+	// the number of messages discarded. The metrics are maintained alongside the APIMetrics
+	// of the MessagingService the receiver was built from.
+	Metrics() metrics.ReceiverMetrics
 }
 
 // SubscriptionOperation represents the operation that triggered a SubscriptionChangeListener callback
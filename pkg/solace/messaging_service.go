@@ -17,9 +17,11 @@
 package solace
 
 import (
+	"context"
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/logging"
 	"solace.dev/go/messaging/pkg/solace/metrics"
 )
 
@@ -33,6 +35,13 @@ type MessagingService interface {
 	// - solace/errors.*IllegalStateError - If MessagingService has already been terminated.
 	Connect() error
 
+	// ConnectWithContext connects the messaging service.
+	// This function blocks until the connection attempt is completed or the specified context is done.
+	// If the context is done first, the function waits for the connection attempt to complete and
+	// disconnects the service if it succeeded, then returns ctx.Err(). A disconnected service may not
+	// be reconnected. For more information, see MessagingService.Connect.
+	ConnectWithContext(ctx context.Context) error
+
 	// ConnectAsync connects the messaging service asynchronously.
 	// Returns a channel that receives an event when completed.
 	// Channel (chan) receives nil if successful, otherwise an error containing failure details.
@@ -61,6 +70,15 @@ type MessagingService interface {
 	// that can be used to configure persistent message receiver instances.
 	CreatePersistentMessageReceiverBuilder() PersistentMessageReceiverBuilder
 
+	// CreateQueueBrowserBuilder creates a QueueBrowserBuilder
+	// that can be used to configure queue browser instances.
+	CreateQueueBrowserBuilder() QueueBrowserBuilder
+
+	// CreateTransactionalMessagingServiceBuilder creates a TransactionalMessagingServiceBuilder
+	// that can be used to configure transactional messaging service instances sharing the
+	// connection of this MessagingService.
+	CreateTransactionalMessagingServiceBuilder() TransactionalMessagingServiceBuilder
+
 	// MessageBuilder creates an OutboundMessageBuilder that can be
 	// used to build messages to send via a message publisher.
 	MessageBuilder() OutboundMessageBuilder
@@ -80,6 +98,12 @@ type MessagingService interface {
 	// Returns solace/errors.*IllegalStateError if it is not yet connected.
 	Disconnect() error
 
+	// DisconnectWithContext disconnects the messaging service, blocking until the disconnection
+	// attempt is completed or the specified context is done. If the context is done first,
+	// ctx.Err() is returned and the disconnection continues in the background.
+	// For more information, see MessagingService.Disconnect.
+	DisconnectWithContext(ctx context.Context) error
+
 	// DisconnectAsync disconnects the messaging service asynchronously.
 	// Returns a channel (chan) that receives an event when completed.
 	// The channel receives nil if successful, otherwise an error containing the failure details
@@ -123,9 +147,23 @@ type MessagingService interface {
 	// GetApplicationID retrieves the application identifier.
 	GetApplicationID() string
 
+	// GetClientName retrieves the client name of the session connecting to the broker, that is
+	// the name configured with config.ClientPropertyName or BuildWithApplicationID, or the
+	// name generated by the API if none was configured.
+	GetClientName() string
+
+	// GetWebTransportProtocolInUse retrieves the web transport protocol negotiated with the broker,
+	// which may differ from the protocol configured with a config.WebTransportStrategy after a downgrade.
+	// Returns an empty string if the MessagingService is not connected over a web transport.
+	GetWebTransportProtocolInUse() config.WebTransportProtocol
+
 	// Metrics returns the metrics for this MessagingService instance.
 	Metrics() metrics.APIMetrics
 
+	// LatencyMetrics returns the latency distributions recorded for this MessagingService instance,
+	// such as the time taken for guaranteed messages to be acknowledged by the broker.
+	LatencyMetrics() metrics.LatencyMetrics
+
 	// Info returns the API Info for this MessagingService instance.
 	Info() metrics.APIInfo
 
@@ -212,8 +250,25 @@ type MessagingServiceBuilder interface {
 	// with the specified transport security strategy.
 	WithTransportSecurityStrategy(transportSecurityStrategy config.TransportSecurityStrategy) MessagingServiceBuilder
 
+	// WithWebTransportStrategy configures the resulting messaging service
+	// with the specified web transport strategy, used when connecting to a "ws://", "wss://",
+	// "http://" or "https://" host. The protocols configured by the strategy replace any
+	// protocols configured before, for example with FromConfigurationProvider.
+	WithWebTransportStrategy(webTransportStrategy config.WebTransportStrategy) MessagingServiceBuilder
+
 	// WithProvisionTimeoutMs configures the timeout for provision and deprovision operations, in milliseconds.
 	WithProvisionTimeoutMs(timeout time.Duration) MessagingServiceBuilder
+
+	// WithLogger configures the resulting messaging service to pass its logs to the given Logger instead of
+	// the global logger. The Logger is inherited by all publishers, receivers, endpoint provisioners and cache
+	// requests of the messaging service. Logs of the native library are passed to the Logger when they can be
+	// attributed to the messaging service, that is when they mention its client name.
+	WithLogger(logger logging.Logger) MessagingServiceBuilder
+
+	// WithLogLevel configures the logging-level of the resulting messaging service independently of
+	// the global logging-level set with logging.SetLogLevel. The logging-level is inherited by all publishers,
+	// receivers, endpoint provisioners and cache requests of the messaging service.
+	WithLogLevel(level logging.LogLevel) MessagingServiceBuilder
 }
 
 // ReconnectionListener is a handler that can be registered to a MessagingService.
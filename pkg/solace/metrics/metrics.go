@@ -18,6 +18,8 @@
 // interface for retrieving the metrics.
 package metrics
 
+import "time"
+
 // Metric represents the various metrics retrievable from a MessagingService's APIMetrics instance.
 type Metric int
 
@@ -218,3 +220,162 @@ type APIMetrics interface {
 	// Reset resets all metrics.
 	Reset()
 }
+
+// ResetCounter is implemented by APIMetrics that count how many times they were reset, allowing
+// consumers to tell a reset apart from values that decreased and climbed back between two reads.
+type ResetCounter interface {
+	// GetResetCount will retrieve the number of times the metrics were reset.
+	GetResetCount() uint64
+}
+
+// PublisherMetric represents the metrics retrievable from the PublisherMetrics of a single publisher.
+type PublisherMetric int
+
+// The various publisher metrics available.
+const (
+	// PublisherMessagesSent is the number of messages successfully sent by the publisher.
+	PublisherMessagesSent PublisherMetric = iota
+
+	// PublisherBytesSent is the number of payload bytes successfully sent by the publisher.
+	PublisherBytesSent
+
+	// PublisherMessagesAcknowledged is the number of guaranteed messages published by the
+	// publisher and acknowledged by the broker.
+	PublisherMessagesAcknowledged
+
+	// PublisherMessagesFailed is the number of messages the publisher failed to send,
+	// or that were rejected by the broker.
+	PublisherMessagesFailed
+
+	// PublisherMessagesTerminationDiscarded is the number of messages discarded due to the publisher
+	// being terminated either by application initiated termination or failure event termination.
+	PublisherMessagesTerminationDiscarded
+
+	// PublisherBackpressureEvents is the number of times a publish was rejected or delayed due to
+	// the publisher or transport not having space to accept the message.
+	PublisherBackpressureEvents
+
+	// PublisherMetricCount is the number of publisher metrics defined by this package.
+	PublisherMetricCount int = iota
+)
+
+// ReceiverMetric represents the metrics retrievable from the ReceiverMetrics of a single receiver.
+type ReceiverMetric int
+
+// The various receiver metrics available.
+const (
+	// ReceiverMessagesReceived is the number of messages received by the receiver.
+	ReceiverMessagesReceived ReceiverMetric = iota
+
+	// ReceiverBytesReceived is the number of payload bytes received by the receiver.
+	ReceiverBytesReceived
+
+	// ReceiverMessagesTerminationDiscarded is the number of messages discarded due to the receiver
+	// being terminated either by application initiated termination or failure event termination.
+	ReceiverMessagesTerminationDiscarded
+
+	// ReceiverMessagesBackpressureDiscarded is the number of messages discarded due to the receiver
+	// not having buffer space to queue a message.
+	ReceiverMessagesBackpressureDiscarded
+
+	// ReceiverInternalDiscardNotifications is the number of messages received by the receiver
+	// with the internal discard notification set.
+	ReceiverInternalDiscardNotifications
+
+	// ReceiverMessagesAcknowledged is the number of guaranteed messages acknowledged by the receiver,
+	// including messages settled with the accepted outcome and messages acknowledged automatically.
+	ReceiverMessagesAcknowledged
+
+	// ReceiverMessagesFailed is the number of guaranteed messages settled by the receiver
+	// with the failed outcome.
+	ReceiverMessagesFailed
+
+	// ReceiverMessagesRejected is the number of guaranteed messages settled by the receiver
+	// with the rejected outcome.
+	ReceiverMessagesRejected
+
+	// ReceiverMessagesUnroutedDiscarded is the number of messages discarded by a direct receiver with
+	// topic routes due to matching no route while no handler is registered with ReceiveAsync.
+	ReceiverMessagesUnroutedDiscarded
+
+	// ReceiverMetricCount is the number of receiver metrics defined by this package.
+	ReceiverMetricCount int = iota
+)
+
+// PublisherMetrics allows for retrieval of the metrics of a single publisher. The metrics are
+// maintained alongside the APIMetrics of the MessagingService the publisher was built from.
+type PublisherMetrics interface {
+	// GetValue will retrieve the value/count of the specified PublisherMetric.
+	GetValue(metric PublisherMetric) uint64
+	// Reset resets all publisher metrics. The APIMetrics of the MessagingService are not reset.
+	Reset()
+}
+
+// ReceiverMetrics allows for retrieval of the metrics of a single receiver. The metrics are
+// maintained alongside the APIMetrics of the MessagingService the receiver was built from.
+type ReceiverMetrics interface {
+	// GetValue will retrieve the value/count of the specified ReceiverMetric.
+	GetValue(metric ReceiverMetric) uint64
+	// Reset resets all receiver metrics. The APIMetrics of the MessagingService are not reset.
+	Reset()
+}
+
+// LatencyMetric represents the latency distributions retrievable from a MessagingService's
+// LatencyMetrics instance.
+type LatencyMetric int
+
+// The various latency metrics available.
+const (
+	// PublishAcknowledgementLatency is the time between a guaranteed message being sent by a
+	// PersistentMessagePublisher and the broker acknowledging it. Only messages that are correlated
+	// with an acknowledgement are measured, that is messages published while a
+	// MessagePublishReceiptListener is set or with PublishAwaitAcknowledgement.
+	PublishAcknowledgementLatency LatencyMetric = iota
+
+	// RequestReplyLatency is the time between a request being sent by a RequestReplyMessagePublisher
+	// and its reply being received.
+	RequestReplyLatency
+
+	// EndToEndLatency is the time between a message being sent, as indicated by its sender timestamp,
+	// and the message being received. Only messages carrying a sender timestamp are measured, for example
+	// messages published by a MessagingService with config.ServicePropertyGenerateSendTimestamps enabled.
+	// The measurement relies on the clocks of the sending and receiving hosts being synchronized, and
+	// messages with a sender timestamp in the future are not measured.
+	EndToEndLatency
+
+	// LatencyMetricCount is the number of latency metrics defined by this package.
+	LatencyMetricCount int = iota
+)
+
+// LatencySnapshot holds a point-in-time summary of the distribution of a LatencyMetric.
+// Percentiles are estimated from a histogram with a relative error of at most 1/16 of the value.
+// All durations are zero if no latencies were recorded.
+type LatencySnapshot struct {
+	// Count is the number of latencies recorded.
+	Count uint64
+	// Min is the lowest latency recorded.
+	Min time.Duration
+	// Max is the highest latency recorded.
+	Max time.Duration
+	// Mean is the arithmetic mean of the latencies recorded.
+	Mean time.Duration
+	// P50 is the median latency.
+	P50 time.Duration
+	// P90 is the 90th percentile latency.
+	P90 time.Duration
+	// P95 is the 95th percentile latency.
+	P95 time.Duration
+	// P99 is the 99th percentile latency.
+	P99 time.Duration
+	// P999 is the 99.9th percentile latency.
+	P999 time.Duration
+}
+
+// LatencyMetrics allows for retrieval of the latency distributions recorded by the API.
+type LatencyMetrics interface {
+	// GetSnapshot will retrieve a summary of the latencies recorded for the specified LatencyMetric
+	// since the last call to Reset.
+	GetSnapshot(metric LatencyMetric) LatencySnapshot
+	// Reset resets all latency metrics. The APIMetrics are not reset.
+	Reset()
+}
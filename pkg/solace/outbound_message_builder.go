@@ -22,6 +22,7 @@ import (
 	"solace.dev/go/messaging/pkg/solace/config"
 	"solace.dev/go/messaging/pkg/solace/message"
 	"solace.dev/go/messaging/pkg/solace/message/sdt"
+	"solace.dev/go/messaging/pkg/solace/resource"
 )
 
 // OutboundMessageBuilder allows construction of messages to be sent.
@@ -58,6 +59,15 @@ type OutboundMessageBuilder interface {
 	// Returns a solace/errors.*IllegalArgumentError if an invalid payload is specified.
 	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
 	BuildWithStreamPayload(payload sdt.Stream, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message message.OutboundMessage, err error)
+	// BuildWithValue creates a message with the given value encoded as the payload by the codec
+	// registered with WithCodec. The HTTP content type of the message is set to the content type
+	// of the codec.
+	// Accepts additional configuration providers to apply only to the built message, with the
+	// last in the list taking precedence.
+	// Returns solace/errors.*IllegalStateError if no codec is registered on the builder.
+	// Returns solace/errors.*IllegalArgumentError if the value cannot be encoded by the codec.
+	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+	BuildWithValue(value interface{}, additionalConfiguration ...config.MessagePropertiesConfigurationProvider) (message message.OutboundMessage, err error)
 	// FromConfigurationProvider sets the given message properties to the resulting message.
 	// Both Solace defined config.MessageProperty keys as well as arbitrary user-defined
 	// property keys are accepted. If using custom defined properties, the date type can be
@@ -91,4 +101,12 @@ type OutboundMessageBuilder interface {
 	// for peer-to-peer message synchronization. In JMS applications, this field is carried as the JMSCorrelationID Message
 	// Header Field.
 	WithCorrelationID(correlationID string) OutboundMessageBuilder
+	// WithReplyTo sets the reply to destination for the message. The reply to destination can be
+	// either a *resource.Topic or a *resource.Queue, and is carried to the receiving application
+	// which can retrieve it with InboundMessage.GetReplyToDestination. Any other destination type
+	// results in a solace/errors.*IllegalArgumentError when the message is built. Passing nil
+	// clears a previously configured reply to destination.
+	WithReplyTo(replyTo resource.Destination) OutboundMessageBuilder
+	// WithCodec sets the codec used by BuildWithValue to encode values into message payloads.
+	WithCodec(codec Codec) OutboundMessageBuilder
 }
@@ -17,6 +17,7 @@
 package solace
 
 import (
+	"context"
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/config"
@@ -25,6 +26,8 @@ import (
 )
 
 // PersistentMessagePublisher allows for the publishing of persistent messages (guaranteed messages).
+// Messages can be published to a *resource.Topic or directly to a *resource.Queue. Any other
+// destination type results in a solace/errors.*IllegalArgumentError.
 type PersistentMessagePublisher interface {
 	MessagePublisher
 	MessagePublisherHealthCheck
@@ -41,30 +44,37 @@ type PersistentMessagePublisher interface {
 	// The listener does not receive events from PublishAwaitAcknowledgement calls.
 	SetMessagePublishReceiptListener(listener MessagePublishReceiptListener)
 
+	// GetMessagePublishReceiptListener returns the listener set with SetMessagePublishReceiptListener,
+	// or nil if no listener is set.
+	GetMessagePublishReceiptListener() MessagePublishReceiptListener
+
 	// TerminateAsyncCallback terminates the PersistentMessagePublisher asynchronously.
 	// Calls the callback when terminated with nil if successful, otherwise an error if
 	// one occurred. When gracePeriod is a value less than 0, the function waits indefinitely.
 	TerminateAsyncCallback(gracePeriod time.Duration, callback func(error))
 
 	// PublishBytes sends a message of type byte array to the specified destination.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
 	// Returns an error if one occurred while attempting to publish or if the publisher
 	// is not started/terminated. Possible errors include:
 	// - solace/errors.*PubSubPlusClientError - If the message could not be sent and all retry attempts failed.
 	// - solace/errors.*PublisherOverflowError - If messages are published faster than publisher's I/O
 	//   capabilities allow. When publishing can be resumed, the registered PublisherReadinessListeners
 	//   are called.
-	PublishBytes(message []byte, destination *resource.Topic) error
+	PublishBytes(message []byte, destination resource.Destination) error
 
 	// PublishString sends a message of type string to the specified destination.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
 	// Possible errors include:
 	// - solace/errors.*PubSubPlusClientError - If the message could not be sent and all retry attempts failed.
 	// - solace/errors.*PublisherOverflowError - If messages are published faster than publisher's I/O
 	//   capabilities allow. When publishing can be resumed, the registered PublisherReadinessListeners
 	//   are called.
-	PublishString(message string, destination *resource.Topic) error
+	PublishString(message string, destination resource.Destination) error
 
 	// Publish sends the specified  message of type OutboundMessage built by a
 	// OutboundMessageBuilder to the specified destination.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
 	// Optionally, you can provide properties in the form of OutboundMessageProperties to override
 	// any properties set on OutboundMessage. The properties argument can be nil to
 	// not set any properties.
@@ -75,10 +85,11 @@ type PersistentMessagePublisher interface {
 	// - solace/errors.*PublisherOverflowError - If messages are published faster than publisher's I/O
 	//   capabilities allow. When publishing can be resumed, the registered PublisherReadinessListeners
 	//   are called.
-	Publish(message message.OutboundMessage, destination *resource.Topic, properties config.MessagePropertiesConfigurationProvider, context interface{}) error
+	Publish(message message.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider, context interface{}) error
 
 	// PublishAwaitAcknowledgement sends the specified message of type OutboundMessage
-	// and awaits a publish acknowledgement.
+	// to the specified destination and awaits a publish acknowledgement.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
 	// Optionally, you can provide properties in the form of OutboundMessageProperties to override
 	// any properties set on OutboundMessage. The properties argument can be nil to
 	// not set any properties.
@@ -88,7 +99,30 @@ type PersistentMessagePublisher interface {
 	// - solace/errors.*PublisherOverflowError - If messages are published faster than publisher's I/O
 	//   capabilities allow. When publishing can be resumed, the registered PublisherReadinessListeners
 	//   are called.
-	PublishAwaitAcknowledgement(message message.OutboundMessage, destination *resource.Topic, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error
+	PublishAwaitAcknowledgement(message message.OutboundMessage, destination resource.Destination, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error
+
+	// PublishAwaitAcknowledgementWithContext sends the specified message of type OutboundMessage
+	// to the specified destination and awaits a publish acknowledgement until the specified
+	// context is done. If the context is done first, ctx.Err() is returned and the message
+	// is no longer tracked for acknowledgement.
+	// For more information, see PersistentMessagePublisher.PublishAwaitAcknowledgement.
+	PublishAwaitAcknowledgementWithContext(ctx context.Context, message message.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider) error
+
+	// PublishBatch sends the specified messages of type OutboundMessage in order, sending
+	// as many messages as possible to the transport in each write. Either a single destination
+	// is given for all messages or one destination is given per message. Each destination can be
+	// either a *resource.Topic or a *resource.Queue.
+	// Once the batch is accepted, a PublishReceipt is delivered to the listener registered with
+	// SetMessagePublishReceiptListener for every message in the batch. When the batch is only partially published, the receipt of each
+	// message that was not published carries the error and the error is returned.
+	// Possible errors include:
+	// - solace/errors.*IllegalArgumentError - If the number of destinations does not match the messages.
+	// - solace/errors.*PubSubPlusClientError - If the messages could not be sent and all retry attempts failed.
+	// - solace/errors.*PublisherOverflowError - If messages are published faster than publisher's I/O
+	//   capabilities allow. With the reject back pressure strategy, no message is published
+	//   when the buffer cannot hold the whole batch. When publishing can be resumed, the registered
+	//   PublisherReadinessListeners are called.
+	PublishBatch(messages []message.OutboundMessage, destinations ...resource.Destination) error
 }
 
 // MessagePublishReceiptListener is a listener that can be registered for the delivery receipt events.
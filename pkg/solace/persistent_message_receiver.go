@@ -17,6 +17,7 @@
 package solace
 
 import (
+	"context"
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/config"
@@ -27,6 +28,7 @@ import (
 // PersistentMessageReceiver allows for receiving persistent message (guaranteed messages).
 type PersistentMessageReceiver interface {
 	MessageReceiver // Include all functionality of MessageReceiver.
+	PayloadDecoder
 
 	// Ack acknowledges that a  message was received.
 	// This method is equivalent to calling the settle method with
@@ -64,6 +66,12 @@ type PersistentMessageReceiver interface {
 	// is returned.
 	ReceiveMessage(timeout time.Duration) (message.InboundMessage, error)
 
+	// ReceiveMessageWithContext receives a message synchronously from the receiver.
+	// Returns an error if the receiver is not started or already terminated.
+	// This function waits until a message is received or the specified context is done,
+	// in which case ctx.Err() is returned.
+	ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, error)
+
 	// Pause pauses the receiver's message delivery to asynchronous message handlers.
 	// Pausing an already paused receiver has no effect.
 	// Returns an IllegalStateError if the receiver has not started or has already terminated.
@@ -87,6 +95,16 @@ type PersistentMessageReceiverBuilder interface {
 	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
 	Build(queue *resource.Queue) (receiver PersistentMessageReceiver, err error)
 
+	// BuildWithTopicEndpoint creates a PersistentMessageReceiver bound to the specified topic endpoint
+	// with the specified properties. A topic endpoint attracts messages with exactly one topic subscription,
+	// which is given when the receiver binds. Rebinding a durable topic endpoint with a different subscription
+	// causes the broker to discard any messages spooled on the endpoint.
+	// Subscriptions cannot be added to or removed from the resulting receiver.
+	// Returns solace/errors.*IllegalArgumentError if the topic endpoint or subscription is nil, if a durable
+	// topic endpoint has no name, or if subscriptions were configured with WithSubscriptions.
+	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+	BuildWithTopicEndpoint(topicEndpoint *resource.TopicEndpoint, subscription *resource.TopicSubscription) (receiver PersistentMessageReceiver, err error)
+
 	// WithActivationPassivationSupport sets the listener to receiver broker notifications
 	// about state changes for the resulting receiver. This change can happen if there are
 	// multiple instances of the same receiver for high availability and activity is exchanged.
@@ -109,6 +127,35 @@ type PersistentMessageReceiverBuilder interface {
 	// If an empty string is provided, the filter is cleared.
 	WithMessageSelector(filterSelectorExpression string) PersistentMessageReceiverBuilder
 
+	// WithFlowWindowSize sets the maximum number of messages that can be in transit from the broker
+	// to the receiver before they are delivered to the application. Larger windows increase throughput
+	// for high-rate consumers. The valid range is 1 to 255. The default is 255.
+	WithFlowWindowSize(windowSize uint) PersistentMessageReceiverBuilder
+
+	// WithMaxUnackedMessages sets the maximum number of messages that may be delivered to the application
+	// without being acknowledged. Once the limit is reached, the broker stops delivering messages until
+	// some are acknowledged. This cannot increase the maximum configured on the endpoint by the broker.
+	// Valid values are -1 and greater than 0, where -1 (the default) uses the broker configured maximum.
+	WithMaxUnackedMessages(maxUnackedMessages int) PersistentMessageReceiverBuilder
+
+	// WithAckThreshold sets the threshold for sending acknowledgements to the broker as a percentage
+	// of the flow window size. The valid range is 1 to 75. The default is 60.
+	WithAckThreshold(percentage uint) PersistentMessageReceiverBuilder
+
+	// WithAckTimer sets the maximum time acknowledgements are batched before being sent to the broker.
+	// The valid range is 20 to 1500 milliseconds. The default is 1 second.
+	WithAckTimer(interval time.Duration) PersistentMessageReceiverBuilder
+
+	// WithFlowReconnectAttempts sets the number of times the receiver attempts to rebind when the broker
+	// unbinds it, for example when a replay is started or the endpoint becomes unavailable.
+	// Valid values are -1 and greater than or equal to 0, where -1 (the default) retries forever
+	// and 0 disables rebinding.
+	WithFlowReconnectAttempts(attempts int) PersistentMessageReceiverBuilder
+
+	// WithBindTimeout sets the time to wait for the broker to confirm the bind when the receiver is started.
+	// The timeout must be at least 1 millisecond. The default is 10 seconds.
+	WithBindTimeout(timeout time.Duration) PersistentMessageReceiverBuilder
+
 	// WithMissingResourcesCreationStrategy sets the missing resource creation strategy
 	// defining what actions the API may take when missing resources are detected.
 	WithMissingResourcesCreationStrategy(strategy config.MissingResourcesCreationStrategy) PersistentMessageReceiverBuilder
@@ -122,6 +169,31 @@ type PersistentMessageReceiverBuilder interface {
 	// WithSubscriptions sets a list of TopicSubscriptions to subscribe
 	// to when starting the receiver. Accepts *resource.TopicSubscription subscriptions.
 	WithSubscriptions(topics ...resource.Subscription) PersistentMessageReceiverBuilder
+	// WithCodec registers the specified codec to decode message payloads with DecodePayload.
+	// Codecs are matched by the HTTP content type or application message type of a message.
+	// Multiple codecs can be registered with different content types.
+	WithCodec(codec Codec) PersistentMessageReceiverBuilder
+
+	// WithConcurrentDispatch configures the receiver to call the message handler registered with
+	// ReceiveAsync from the specified number of workers concurrently, so that a slow message handler
+	// does not stall the delivery of other messages. The ordering policy defines which messages are
+	// handled in the order they were received: config.OrderingPolicyUnordered, config.OrderingPolicyPerTopic
+	// or config.OrderingPolicyPerPartitionKey. Messages awaiting a worker count towards the receiver's
+	// back pressure, and Terminate waits for in-flight messages to be handled within the grace period.
+	// When auto acknowledgement is configured, each message is acknowledged by the worker once it is handled.
+	// The value of workers must be greater than or equal to 1. The default is 1.
+	WithConcurrentDispatch(workers uint, ordering config.OrderingPolicy) PersistentMessageReceiverBuilder
+
+	// WithKeyedDispatch configures the receiver to call the message handler registered with ReceiveAsync
+	// from the specified number of workers, where messages with the same key are handled in order by the
+	// same worker while messages with different keys are handled in parallel. The key of a message is
+	// returned by the specified key function, or is the partition key of the message, the
+	// config.QueuePartitionKey (JMSXGroupID) user property, if the key function is nil.
+	// Messages are acknowledged by the worker once handled when auto acknowledgement is configured, and
+	// may otherwise be acknowledged or settled from the message handler as they would be without workers.
+	// The value of workers must be greater than or equal to 1. Only the last call to WithKeyedDispatch or
+	// WithConcurrentDispatch takes effect.
+	WithKeyedDispatch(workers uint, key MessageKeyFunction) PersistentMessageReceiverBuilder
 
 	// WithRequiredMessageOutcomeSupport configures the types of settlements the receiver can use.
 	// Any combination of PersistentReceiverAcceptedOutcome, PersistentReceiverFailedOutcome, and
@@ -152,6 +224,10 @@ const (
 // notified of changes in receiver state by the remote broker.
 type ReceiverStateChangeListener func(oldState ReceiverState, newState ReceiverState, timestamp time.Time)
 
+// MessageKeyFunction returns the key of a message used to order messages handled in parallel.
+// Messages with the same key are handled in order.
+type MessageKeyFunction func(message message.InboundMessage) string
+
 // PersistentReceiverInfo provides information about the receiver at runtime.
 type PersistentReceiverInfo interface {
 	// GetResourceInfo returns the remote endpoint (resource) information for the receiver.
@@ -0,0 +1,86 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solace
+
+import (
+	"context"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// QueueBrowser allows for browsing the messages spooled on a queue without removing them.
+// Messages are browsed from oldest to newest. Browsed messages remain available to other
+// consumers of the queue unless explicitly removed with Remove.
+type QueueBrowser interface {
+	// Extend LifecycleControl for various lifecycle management functionality.
+	LifecycleControl
+
+	// StartAsyncCallback starts the QueueBrowser asynchronously.
+	// Calls the callback when started with an error if one occurred, otherwise nil
+	// if successful.
+	StartAsyncCallback(callback func(QueueBrowser, error))
+
+	// TerminateAsyncCallback terminates the QueueBrowser asynchronously.
+	// Calls the callback when terminated with nil if successful, otherwise an error if
+	// one occurred. If gracePeriod is less than 0, the function waits indefinitely.
+	TerminateAsyncCallback(gracePeriod time.Duration, callback func(error))
+
+	// ReceiveMessage receives the next browsed message synchronously from the browser.
+	// Returns an error if the browser is not started or already terminated.
+	// This function waits until the specified timeout to receive a message or waits
+	// forever if timeout value is negative. If a timeout occurs, a solace.TimeoutError
+	// is returned, which typically indicates that all messages spooled on the queue
+	// matching the browser's selector have been browsed.
+	ReceiveMessage(timeout time.Duration) (message.InboundMessage, error)
+
+	// ReceiveMessageWithContext receives the next browsed message synchronously from the browser.
+	// Returns an error if the browser is not started or already terminated.
+	// This function waits until a message is received or the specified context is done,
+	// in which case ctx.Err() is returned.
+	ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, error)
+
+	// Remove removes the specified browsed message from the queue. Once removed, the message
+	// is no longer available for consumption by any consumer of the queue.
+	// Returns solace/errors.*IllegalStateError if the browser is not started or already terminated.
+	// Returns solace/errors.*IllegalArgumentError if the message was not received by a QueueBrowser.
+	Remove(message message.InboundMessage) error
+}
+
+// QueueBrowserBuilder is used for configuration of QueueBrowser instances.
+type QueueBrowserBuilder interface {
+	// Build creates a QueueBrowser with the specified properties that browses the given queue.
+	// Returns solace/errors.*IllegalArgumentError if the queue is nil or anonymous.
+	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+	Build(queue *resource.Queue) (browser QueueBrowser, err error)
+
+	// WithMessageSelector sets the message selector to the specified string.
+	// Only messages matching the selector are browsed.
+	// If an empty string is provided, the filter is cleared.
+	WithMessageSelector(filterSelectorExpression string) QueueBrowserBuilder
+
+	// WithQueueBrowserWindowSize sets the maximum number of messages that can be
+	// requested from the broker at a time. The valid range is 1 to 255.
+	WithQueueBrowserWindowSize(windowSize uint) QueueBrowserBuilder
+
+	// FromConfigurationProvider configures the queue browser with the specified properties.
+	// The built-in ReceiverPropertiesConfigurationProvider implementations include:
+	//   ReceiverPropertyMap, a map of ReceiverProperty keys to values
+	FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) QueueBrowserBuilder
+}
@@ -1,6 +1,7 @@
 package solace
 
 import (
+	"context"
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/config"
@@ -93,6 +94,13 @@ type RequestReplyMessagePublisher interface {
 	// will be called.
 	PublishAwaitResponse(requestMessage message.OutboundMessage, requestDestination *resource.Topic,
 		replyTimeout time.Duration, properties config.MessagePropertiesConfigurationProvider) (message.InboundMessage, error)
+
+	// PublishAwaitResponseWithContext will send a request for a reply blocking until a response is
+	// received or the specified context is done. If the context is done first, ctx.Err() is returned
+	// and the request is no longer correlated with any reply.
+	// For more information, see RequestReplyMessagePublisher.PublishAwaitResponse.
+	PublishAwaitResponseWithContext(ctx context.Context, requestMessage message.OutboundMessage, requestDestination *resource.Topic,
+		properties config.MessagePropertiesConfigurationProvider) (message.InboundMessage, error)
 }
 
 // ReplyMessageHandler is a callback to handle a reply message. The function will be
@@ -1,6 +1,7 @@
 package solace
 
 import (
+	"context"
 	"time"
 
 	"solace.dev/go/messaging/pkg/solace/config"
@@ -27,6 +28,7 @@ type RequestMessageHandler func(message message.InboundMessage, replier Replier)
 // with handling for sending reply messages.
 type RequestReplyMessageReceiver interface {
 	MessageReceiver
+	PayloadDecoder
 
 	// StartAsyncCallback will start the message receiver asynchronously.
 	// Before this function is called, the service is considered
@@ -66,6 +68,13 @@ type RequestReplyMessageReceiver interface {
 	// forever if timeout value is negative. If a timeout occurs, a solace.TimeoutError
 	// is returned.
 	ReceiveMessage(timeout time.Duration) (message.InboundMessage, Replier, error)
+
+	// ReceiveMessageWithContext receives a message and replier synchronously from the receiver.
+	// Returns a nil replier if the message can not be replied to.
+	// Returns an error if the receiver is not started or already terminated.
+	// This function waits until a message is received or the specified context is done,
+	// in which case ctx.Err() is returned.
+	ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, Replier, error)
 }
 
 // RequestReplyMessageReceiverBuilder allows for configuration of RequestReplyMessageReceiver instances
@@ -78,6 +87,10 @@ type RequestReplyMessageReceiverBuilder interface {
 	// BuildWithSharedSubscription will build a new RequestReplyMessageReceiver with
 	// the given properties using a shared topic subscription and the shared name.
 	BuildWithSharedSubscription(requestTopicSubscription resource.Subscription, shareName *resource.ShareName) (messageReceiver RequestReplyMessageReceiver, err error)
+	// WithCodec registers the specified codec to decode request payloads with DecodePayload.
+	// Codecs are matched by the HTTP content type or application message type of a message.
+	// Multiple codecs can be registered with different content types.
+	WithCodec(codec Codec) RequestReplyMessageReceiverBuilder
 	// OnBackPressureDropLatest configures the receiver with the specified buffer size. If the buffer
 	// is full and a message arrives, the incoming message is discarded.
 	// A buffer of the given size will be statically allocated when the receiver is built.
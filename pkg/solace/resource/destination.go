@@ -44,7 +44,8 @@ type Topic struct {
 }
 
 // TopicOf creates a new topic with the specified name.
-// Topic name must not be empty.
+// Topic name must not be empty. The name is not otherwise validated until the topic is published to,
+// use NewTopicBuilder to build a topic from its levels and validate it on creation.
 func TopicOf(expression string) *Topic {
 	return &Topic{expression}
 }
@@ -172,6 +173,46 @@ func QueueNonDurableExclusiveAnonymous() *Queue {
 	}
 }
 
+// TopicEndpoint represents a topic endpoint used for guaranteed messaging receivers.
+// A topic endpoint attracts messages published to the single topic subscription it is
+// bound with, as used by JMS durable topic subscribers.
+type TopicEndpoint struct {
+	name    string
+	durable bool
+}
+
+// GetName returns the name of the topic endpoint. Implements the Destination interface.
+func (te *TopicEndpoint) GetName() string {
+	return te.name
+}
+
+// IsDurable determines if the TopicEndpoint is durable. Durable topic endpoints are provisioned
+// objects on the broker that have a lifespan that is independent of any one client session.
+func (te *TopicEndpoint) IsDurable() bool {
+	return te.durable
+}
+
+func (te *TopicEndpoint) String() string {
+	return fmt.Sprintf("TopicEndpoint: %s, durable: %t", te.GetName(), te.IsDurable())
+}
+
+// TopicEndpointDurable creates a new durable topic endpoint with the specified name.
+func TopicEndpointDurable(topicEndpointName string) *TopicEndpoint {
+	return &TopicEndpoint{
+		name:    topicEndpointName,
+		durable: true,
+	}
+}
+
+// TopicEndpointNonDurable creates a non-durable topic endpoint with the specified name.
+// If the name is empty, the broker generates a name for the topic endpoint.
+func TopicEndpointNonDurable(topicEndpointName string) *TopicEndpoint {
+	return &TopicEndpoint{
+		name:    topicEndpointName,
+		durable: false,
+	}
+}
+
 // CachedMessageSubscriptionStrategy indicates how the API should pass received cached and live messages to the application. Refer to each
 // variant for details on what behaviour they configure.
 type CachedMessageSubscriptionStrategy int
@@ -55,6 +55,23 @@ func TestValidQueue(t *testing.T) {
 	}
 }
 
+func TestValidTopicEndpoint(t *testing.T) {
+	topicEndpointName := "mytopicendpoint"
+	topicEndpoint := resource.TopicEndpointDurable(topicEndpointName)
+	if topicEndpoint == nil {
+		t.Errorf("Expected topic endpoint to not be nil, got nil")
+	}
+	if topicEndpoint.GetName() != topicEndpointName {
+		t.Errorf("Expected topic endpoint name to equal %s, got %s", topicEndpointName, topicEndpoint.GetName())
+	}
+	if !topicEndpoint.IsDurable() {
+		t.Errorf("Expected topic endpoint to be durable")
+	}
+	if resource.TopicEndpointNonDurable(topicEndpointName).IsDurable() {
+		t.Errorf("Expected topic endpoint to be non-durable")
+	}
+}
+
 func TestNewCachedMessageSubscriptionRequest(t *testing.T) {
 	const cacheName = "test-cache"
 	const subscriptionString = "some-subscription"
@@ -0,0 +1,313 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"strings"
+	"sync"
+)
+
+const (
+	topicLevelSeparator      = "/"
+	singleLevelWildcard      = "*"
+	multiLevelWildcard       = ">"
+	sharedSubscriptionPrefix = "#share/"
+	noExportPrefix           = "#noexport/"
+	reservedTopicPrefix      = "#"
+)
+
+// subscriptionTopic returns the topic of the given subscription, removing any #noexport/ prefix
+// and any #share/<name>/ prefix
+func subscriptionTopic(subscription string) string {
+	topic := strings.TrimPrefix(subscription, noExportPrefix)
+	if strings.HasPrefix(topic, sharedSubscriptionPrefix) {
+		rest := topic[len(sharedSubscriptionPrefix):]
+		if separator := strings.Index(rest, topicLevelSeparator); separator > 0 {
+			return rest[separator+1:]
+		}
+	}
+	return topic
+}
+
+// levelMatches returns true if the given topic level matches the given subscription level of
+// the given index, where a subscription level ending with "*" matches any level with the same prefix
+func levelMatches(subscriptionLevel, topicLevel string, index int) bool {
+	if !strings.HasSuffix(subscriptionLevel, singleLevelWildcard) {
+		return subscriptionLevel == topicLevel
+	}
+	if index == 0 && subscriptionLevel == singleLevelWildcard && strings.HasPrefix(topicLevel, reservedTopicPrefix) {
+		return false
+	}
+	return strings.HasPrefix(topicLevel, subscriptionLevel[:len(subscriptionLevel)-1])
+}
+
+// Matches returns true if the specified topic matches the subscription following the semantics of
+// the broker. Topic levels are separated by "/". A subscription level of "*" matches any single
+// level, and a subscription level ending with "*", such as "b*", matches any level with the same
+// prefix. A "*" elsewhere in a level is not a wildcard. A last subscription level of ">" matches one
+// or more remaining levels, a ">" elsewhere is not a wildcard. A leading "*" or ">" does not match
+// reserved topics starting with "#". The "#noexport/" and "#share/<name>/" prefixes of a
+// subscription are ignored, matching on the topic of the subscription only.
+func (t *TopicSubscription) Matches(topic string) bool {
+	if topic == "" {
+		return false
+	}
+	subscriptionLevels := strings.Split(subscriptionTopic(t.topic), topicLevelSeparator)
+	topicLevels := strings.Split(topic, topicLevelSeparator)
+	for i, subscriptionLevel := range subscriptionLevels {
+		if subscriptionLevel == multiLevelWildcard && i == len(subscriptionLevels)-1 {
+			if i == 0 && strings.HasPrefix(topic, reservedTopicPrefix) {
+				return false
+			}
+			return len(topicLevels) > i
+		}
+		if i >= len(topicLevels) || !levelMatches(subscriptionLevel, topicLevels[i], i) {
+			return false
+		}
+	}
+	return len(subscriptionLevels) == len(topicLevels)
+}
+
+// SubscriptionSet is a set of topic subscriptions compiled into a trie of topic levels, finding
+// the subscriptions matching a topic without testing each subscription. Subscriptions are matched
+// with the semantics of TopicSubscription.Matches. Subscriptions are identified by their name such
+// that "a/b" and "#share/group/a/b" are different subscriptions matching the same topics.
+// A SubscriptionSet is safe for concurrent use.
+type SubscriptionSet struct {
+	lock sync.RWMutex
+	root *subscriptionNode
+	size int
+}
+
+// subscriptionNode is a node of the SubscriptionSet trie for a single topic level
+type subscriptionNode struct {
+	// literals are the children for subscription levels without a wildcard
+	literals map[string]*subscriptionNode
+	// prefixes are the children for subscription levels ending with "*", keyed by the prefix before "*"
+	prefixes map[string]*subscriptionNode
+	// terminal are the subscriptions ending at this level
+	terminal map[string]*TopicSubscription
+	// remainder are the subscriptions ending with ">" after this level
+	remainder map[string]*TopicSubscription
+}
+
+// NewSubscriptionSet creates a SubscriptionSet containing the specified subscriptions.
+func NewSubscriptionSet(subscriptions ...*TopicSubscription) *SubscriptionSet {
+	set := &SubscriptionSet{root: &subscriptionNode{}}
+	for _, subscription := range subscriptions {
+		set.Add(subscription)
+	}
+	return set
+}
+
+// Add adds the specified subscription to the set. Returns false if the subscription
+// is nil or already in the set.
+func (set *SubscriptionSet) Add(subscription *TopicSubscription) bool {
+	if subscription == nil {
+		return false
+	}
+	set.lock.Lock()
+	defer set.lock.Unlock()
+	node := set.root
+	levels := strings.Split(subscriptionTopic(subscription.topic), topicLevelSeparator)
+	for i, level := range levels {
+		if level == multiLevelWildcard && i == len(levels)-1 {
+			return set.addTo(&node.remainder, subscription)
+		}
+		var children *map[string]*subscriptionNode
+		key := level
+		if strings.HasSuffix(level, singleLevelWildcard) {
+			children = &node.prefixes
+			key = level[:len(level)-1]
+		} else {
+			children = &node.literals
+		}
+		if *children == nil {
+			*children = make(map[string]*subscriptionNode)
+		}
+		child, ok := (*children)[key]
+		if !ok {
+			child = &subscriptionNode{}
+			(*children)[key] = child
+		}
+		node = child
+	}
+	return set.addTo(&node.terminal, subscription)
+}
+
+func (set *SubscriptionSet) addTo(subscriptions *map[string]*TopicSubscription, subscription *TopicSubscription) bool {
+	if *subscriptions == nil {
+		*subscriptions = make(map[string]*TopicSubscription)
+	}
+	if _, ok := (*subscriptions)[subscription.topic]; ok {
+		return false
+	}
+	(*subscriptions)[subscription.topic] = subscription
+	set.size++
+	return true
+}
+
+// Remove removes the specified subscription from the set. Returns false if the
+// subscription is not in the set.
+func (set *SubscriptionSet) Remove(subscription *TopicSubscription) bool {
+	if subscription == nil {
+		return false
+	}
+	set.lock.Lock()
+	defer set.lock.Unlock()
+	levels := strings.Split(subscriptionTopic(subscription.topic), topicLevelSeparator)
+	if !set.root.remove(levels, subscription.topic) {
+		return false
+	}
+	set.size--
+	return true
+}
+
+// remove removes the subscription with the given name and remaining levels from the node,
+// pruning the nodes left empty. Returns true if the subscription was removed.
+func (node *subscriptionNode) remove(levels []string, name string) bool {
+	if len(levels) == 0 {
+		return removeFrom(node.terminal, name)
+	}
+	level := levels[0]
+	if level == multiLevelWildcard && len(levels) == 1 {
+		return removeFrom(node.remainder, name)
+	}
+	children, key := node.literals, level
+	if strings.HasSuffix(level, singleLevelWildcard) {
+		children, key = node.prefixes, level[:len(level)-1]
+	}
+	child, ok := children[key]
+	if !ok || !child.remove(levels[1:], name) {
+		return false
+	}
+	if child.isEmpty() {
+		delete(children, key)
+	}
+	return true
+}
+
+func removeFrom(subscriptions map[string]*TopicSubscription, name string) bool {
+	if _, ok := subscriptions[name]; !ok {
+		return false
+	}
+	delete(subscriptions, name)
+	return true
+}
+
+func (node *subscriptionNode) isEmpty() bool {
+	return len(node.literals) == 0 && len(node.prefixes) == 0 && len(node.terminal) == 0 && len(node.remainder) == 0
+}
+
+// Contains returns true if the specified subscription is in the set.
+func (set *SubscriptionSet) Contains(subscription *TopicSubscription) bool {
+	if subscription == nil {
+		return false
+	}
+	set.lock.RLock()
+	defer set.lock.RUnlock()
+	node := set.root
+	levels := strings.Split(subscriptionTopic(subscription.topic), topicLevelSeparator)
+	for i, level := range levels {
+		if level == multiLevelWildcard && i == len(levels)-1 {
+			_, ok := node.remainder[subscription.topic]
+			return ok
+		}
+		var ok bool
+		if strings.HasSuffix(level, singleLevelWildcard) {
+			node, ok = node.prefixes[level[:len(level)-1]]
+		} else {
+			node, ok = node.literals[level]
+		}
+		if !ok {
+			return false
+		}
+	}
+	_, ok := node.terminal[subscription.topic]
+	return ok
+}
+
+// Len returns the number of subscriptions in the set.
+func (set *SubscriptionSet) Len() int {
+	set.lock.RLock()
+	defer set.lock.RUnlock()
+	return set.size
+}
+
+// Matches returns true if any subscription in the set matches the specified topic.
+func (set *SubscriptionSet) Matches(topic string) bool {
+	matched := false
+	set.match(topic, func(*TopicSubscription) bool {
+		matched = true
+		return false
+	})
+	return matched
+}
+
+// Match returns the subscriptions in the set matching the specified topic, in no particular order.
+func (set *SubscriptionSet) Match(topic string) []*TopicSubscription {
+	var matches []*TopicSubscription
+	set.match(topic, func(subscription *TopicSubscription) bool {
+		matches = append(matches, subscription)
+		return true
+	})
+	return matches
+}
+
+// match calls the given function with each subscription matching the given topic until it returns false
+func (set *SubscriptionSet) match(topic string, fn func(*TopicSubscription) bool) {
+	if topic == "" {
+		return
+	}
+	set.lock.RLock()
+	defer set.lock.RUnlock()
+	set.root.match(strings.Split(topic, topicLevelSeparator), 0, fn)
+}
+
+// match visits the subscriptions of the node and its children matching the given topic levels from the
+// given index. Returns false if fn returned false, stopping the visit. Subscriptions are visited at most
+// once as each subscription is held by a single node and matches the topic through a single path.
+func (node *subscriptionNode) match(levels []string, index int, fn func(*TopicSubscription) bool) bool {
+	if index == len(levels) {
+		return visit(node.terminal, fn)
+	}
+	reserved := index == 0 && strings.HasPrefix(levels[0], reservedTopicPrefix)
+	if !reserved && !visit(node.remainder, fn) {
+		return false
+	}
+	if child, ok := node.literals[levels[index]]; ok && !child.match(levels, index+1, fn) {
+		return false
+	}
+	for prefix, child := range node.prefixes {
+		if prefix == "" && reserved {
+			continue
+		}
+		if strings.HasPrefix(levels[index], prefix) && !child.match(levels, index+1, fn) {
+			return false
+		}
+	}
+	return true
+}
+
+func visit(subscriptions map[string]*TopicSubscription, fn func(*TopicSubscription) bool) bool {
+	for _, subscription := range subscriptions {
+		if !fn(subscription) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,170 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"sort"
+	"testing"
+
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+var topicMatchesTestCases = []struct {
+	subscription, topic string
+	expected            bool
+}{
+	{"a/b/c", "a/b/c", true},
+	{"a/b/c", "a/b", false},
+	{"a/b", "a/b/c", false},
+	{"a/*/c", "a/b/c", true},
+	{"a/*/c", "a/b/d", false},
+	{"a/*/c", "a/b/x/c", false},
+	{"a/b*/c", "a/bcd/c", true},
+	{"a/b*/c", "a/b/c", true},
+	{"a/b*/c", "a/x/c", false},
+	{"a/b*", "a/bcd", true},
+	{"a/b*c/d", "a/bxc/d", false},
+	{"a/b*c/d", "a/b*c/d", true},
+	{"a/>", "a/b", true},
+	{"a/>", "a/b/c/d", true},
+	{"a/>", "a", false},
+	{">", "a", true},
+	{">", "a/b/c", true},
+	{"*", "a", true},
+	{"*", "a/b", false},
+	{"a/>/c", "a/>/c", true},
+	{"a/>/c", "a/b/c", false},
+	{"a/b>", "a/b>", true},
+	{"a/b>", "a/bc", false},
+	{"a/*/>", "a/b/c", true},
+	{"a/*/>", "a/b", false},
+	{">", "#P2P/v:router/client", false},
+	{"*/>", "#P2P/v:router/client", false},
+	{"#P2P/>", "#P2P/v:router/client", true},
+	{"#P*/>", "#P2P/v:router/client", true},
+	{"#share/group/a/*", "a/b", true},
+	{"#share/group/a/*", "#share/group/a/b", false},
+	{"#noexport/a/b", "a/b", true},
+	{"#noexport/#share/group/a/>", "a/b/c", true},
+	{"a/b", "", false},
+}
+
+func TestTopicSubscriptionMatches(t *testing.T) {
+	for _, testCase := range topicMatchesTestCases {
+		subscription := resource.TopicSubscriptionOf(testCase.subscription)
+		if actual := subscription.Matches(testCase.topic); actual != testCase.expected {
+			t.Errorf("expected %q Matches(%q) to be %t", testCase.subscription, testCase.topic, testCase.expected)
+		}
+	}
+}
+
+func TestSubscriptionSetMatchesLikeTopicSubscription(t *testing.T) {
+	set := resource.NewSubscriptionSet()
+	for _, testCase := range topicMatchesTestCases {
+		set.Add(resource.TopicSubscriptionOf(testCase.subscription))
+	}
+	for _, testCase := range topicMatchesTestCases {
+		expected := []string{}
+		for _, other := range topicMatchesTestCases {
+			subscription := resource.TopicSubscriptionOf(other.subscription)
+			if subscription.Matches(testCase.topic) && !contains(expected, other.subscription) {
+				expected = append(expected, other.subscription)
+			}
+		}
+		actual := []string{}
+		for _, subscription := range set.Match(testCase.topic) {
+			actual = append(actual, subscription.GetName())
+		}
+		sort.Strings(expected)
+		sort.Strings(actual)
+		if !equal(expected, actual) {
+			t.Errorf("expected subscriptions %v to match topic %q, got %v", expected, testCase.topic, actual)
+		}
+		if set.Matches(testCase.topic) != (len(expected) > 0) {
+			t.Errorf("expected set Matches(%q) to be %t", testCase.topic, len(expected) > 0)
+		}
+	}
+}
+
+func TestSubscriptionSetAddRemove(t *testing.T) {
+	ab := resource.TopicSubscriptionOf("a/b")
+	sharedAB := resource.TopicSubscriptionOf("#share/group/a/b")
+	aWildcard := resource.TopicSubscriptionOf("a/*")
+	aRemainder := resource.TopicSubscriptionOf("a/>")
+	set := resource.NewSubscriptionSet(ab, sharedAB, aWildcard)
+	if set.Len() != 3 {
+		t.Errorf("expected 3 subscriptions, got %d", set.Len())
+	}
+	if set.Add(resource.TopicSubscriptionOf("a/b")) {
+		t.Error("expected adding a duplicate subscription to return false")
+	}
+	if set.Add(nil) {
+		t.Error("expected adding a nil subscription to return false")
+	}
+	if !set.Add(aRemainder) {
+		t.Error("expected adding a new subscription to return true")
+	}
+	for _, subscription := range []*resource.TopicSubscription{ab, sharedAB, aWildcard, aRemainder} {
+		if !set.Contains(subscription) {
+			t.Errorf("expected set to contain %s", subscription)
+		}
+	}
+	if set.Contains(resource.TopicSubscriptionOf("a")) || set.Contains(resource.TopicSubscriptionOf("a/b/c")) {
+		t.Error("expected set to not contain subscriptions that were not added")
+	}
+	if matches := set.Match("a/b"); len(matches) != 4 {
+		t.Errorf("expected 4 subscriptions to match a/b, got %v", matches)
+	}
+	if !set.Remove(ab) || !set.Remove(aWildcard) {
+		t.Error("expected removing a subscription in the set to return true")
+	}
+	if set.Remove(ab) || set.Remove(resource.TopicSubscriptionOf("a/b/c")) || set.Remove(nil) {
+		t.Error("expected removing a subscription not in the set to return false")
+	}
+	if set.Len() != 2 {
+		t.Errorf("expected 2 subscriptions, got %d", set.Len())
+	}
+	if matches := set.Match("a/b"); len(matches) != 2 {
+		t.Errorf("expected 2 subscriptions to match a/b, got %v", matches)
+	}
+	set.Remove(sharedAB)
+	set.Remove(aRemainder)
+	if set.Len() != 0 || set.Matches("a/b") {
+		t.Error("expected empty set to match no topics")
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,157 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxTopicLength is the maximum length in bytes of a topic or topic subscription.
+	MaxTopicLength = 250
+	// MaxTopicLevels is the maximum number of levels of a topic or topic subscription.
+	MaxTopicLevels = 128
+)
+
+// topicLevelEscaper escapes the topic level separator, and the escape character itself, in a topic level
+var topicLevelEscaper = strings.NewReplacer("%", "%25", topicLevelSeparator, "%2F")
+
+// ValidateTopic returns an error if the specified topic cannot be published to. A topic must not be
+// empty, must not exceed MaxTopicLength bytes or MaxTopicLevels levels, must not contain a NUL
+// character and must not contain wildcards, that is levels of ">" or ending with "*".
+func ValidateTopic(topic string) error {
+	if err := validateTopicString("topic", topic); err != nil {
+		return err
+	}
+	// scan the levels in place rather than splitting the topic, as topics are validated on every publish
+	start := 0
+	for start <= len(topic) {
+		end := strings.Index(topic[start:], topicLevelSeparator)
+		if end < 0 {
+			end = len(topic)
+		} else {
+			end += start
+		}
+		if level := topic[start:end]; isWildcardLevel(level) {
+			return fmt.Errorf("topic '%s' must not contain wildcard level '%s'", topic, level)
+		}
+		start = end + len(topicLevelSeparator)
+	}
+	return nil
+}
+
+// ValidateTopicSubscription returns an error if the specified topic subscription cannot be subscribed to.
+// A topic subscription must not be empty, must not exceed MaxTopicLength bytes or MaxTopicLevels levels
+// and must not contain a NUL character.
+func ValidateTopicSubscription(subscription string) error {
+	return validateTopicString("topic subscription", subscription)
+}
+
+func validateTopicString(kind, value string) error {
+	if value == "" {
+		return fmt.Errorf("%s must not be empty", kind)
+	}
+	if len(value) > MaxTopicLength {
+		return fmt.Errorf("%s '%s' must not exceed %d bytes, got %d bytes", kind, value, MaxTopicLength, len(value))
+	}
+	if levels := strings.Count(value, topicLevelSeparator) + 1; levels > MaxTopicLevels {
+		return fmt.Errorf("%s '%s' must not exceed %d levels, got %d levels", kind, value, MaxTopicLevels, levels)
+	}
+	if strings.IndexByte(value, 0) >= 0 {
+		return fmt.Errorf("%s '%s' must not contain a NUL character", kind, value)
+	}
+	return nil
+}
+
+// isWildcardLevel returns true if the given level acts as a wildcard in a topic subscription
+func isWildcardLevel(level string) bool {
+	return level == multiLevelWildcard || strings.HasSuffix(level, singleLevelWildcard)
+}
+
+// TopicBuilder builds a Topic or TopicSubscription from its levels, validating the result.
+// Levels containing the topic level separator "/" are rejected, unless the builder escapes
+// separators with EscapeSeparators. Empty levels are rejected.
+type TopicBuilder struct {
+	levels           []string
+	escapeSeparators bool
+}
+
+// NewTopicBuilder creates a TopicBuilder starting with the specified levels.
+func NewTopicBuilder(levels ...string) *TopicBuilder {
+	return &TopicBuilder{levels: append([]string{}, levels...)}
+}
+
+// WithLevels appends the specified levels to the topic.
+func (builder *TopicBuilder) WithLevels(levels ...string) *TopicBuilder {
+	builder.levels = append(builder.levels, levels...)
+	return builder
+}
+
+// EscapeSeparators configures the builder to escape the topic level separator "/" in levels as "%2F",
+// and "%" as "%25", rather than rejecting levels containing the separator.
+func (builder *TopicBuilder) EscapeSeparators() *TopicBuilder {
+	builder.escapeSeparators = true
+	return builder
+}
+
+// Build creates a Topic from the levels of the builder. Returns an error if a level is empty or contains
+// the topic level separator, or if the topic is invalid as defined by ValidateTopic. Wildcards are rejected.
+func (builder *TopicBuilder) Build() (*Topic, error) {
+	topic, err := builder.join()
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateTopic(topic); err != nil {
+		return nil, err
+	}
+	return TopicOf(topic), nil
+}
+
+// BuildSubscription creates a TopicSubscription from the levels of the builder, where levels may be wildcards.
+// Returns an error if a level is empty or contains the topic level separator, or if the topic subscription is
+// invalid as defined by ValidateTopicSubscription.
+func (builder *TopicBuilder) BuildSubscription() (*TopicSubscription, error) {
+	subscription, err := builder.join()
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateTopicSubscription(subscription); err != nil {
+		return nil, err
+	}
+	return TopicSubscriptionOf(subscription), nil
+}
+
+func (builder *TopicBuilder) join() (string, error) {
+	if len(builder.levels) == 0 {
+		return "", errors.New("topic must have at least one level")
+	}
+	levels := make([]string, len(builder.levels))
+	for i, level := range builder.levels {
+		if level == "" {
+			return "", fmt.Errorf("topic level %d must not be empty", i)
+		}
+		if builder.escapeSeparators {
+			level = topicLevelEscaper.Replace(level)
+		} else if strings.Contains(level, topicLevelSeparator) {
+			return "", fmt.Errorf("topic level '%s' must not contain the topic level separator '%s'", level, topicLevelSeparator)
+		}
+		levels[i] = level
+	}
+	return strings.Join(levels, topicLevelSeparator), nil
+}
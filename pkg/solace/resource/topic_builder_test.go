@@ -0,0 +1,114 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"strings"
+	"testing"
+
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+func TestValidateTopic(t *testing.T) {
+	testCases := []struct {
+		topic                 string
+		validTopic, validSubs bool
+	}{
+		{"a/b/c", true, true},
+		{"a", true, true},
+		{"a/b*c/d", true, true},
+		{"a/b>", true, true},
+		{"#P2P/v:router/client", true, true},
+		{"", false, false},
+		{"a/*/c", false, true},
+		{"a/b*", false, true},
+		{"a/>", false, true},
+		{">", false, true},
+		{"a/b/>", false, true},
+		{"a/", true, true},
+		{"a/b\x00c", false, false},
+		{strings.Repeat("a", resource.MaxTopicLength), true, true},
+		{strings.Repeat("a", resource.MaxTopicLength+1), false, false},
+		{strings.Repeat("/", resource.MaxTopicLevels-1), true, true},
+		{strings.Repeat("/", resource.MaxTopicLevels), false, false},
+	}
+	for _, testCase := range testCases {
+		if err := resource.ValidateTopic(testCase.topic); (err == nil) != testCase.validTopic {
+			t.Errorf("expected ValidateTopic(%q) valid to be %t, got error %v", testCase.topic, testCase.validTopic, err)
+		}
+		if err := resource.ValidateTopicSubscription(testCase.topic); (err == nil) != testCase.validSubs {
+			t.Errorf("expected ValidateTopicSubscription(%q) valid to be %t, got error %v", testCase.topic, testCase.validSubs, err)
+		}
+	}
+}
+
+func TestValidateTopicDoesNotAllocate(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := resource.ValidateTopic("orders/eu/web/created/1234"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("expected ValidateTopic to not allocate, got %v allocations", allocs)
+	}
+}
+
+func TestTopicBuilder(t *testing.T) {
+	topic, err := resource.NewTopicBuilder("orders", "eu").WithLevels("1234", "created").Build()
+	if err != nil {
+		t.Fatalf("expected topic to build, got %s", err)
+	}
+	if topic.GetName() != "orders/eu/1234/created" {
+		t.Errorf("expected topic orders/eu/1234/created, got %s", topic.GetName())
+	}
+	subscription, err := resource.NewTopicBuilder("orders", "*", ">").BuildSubscription()
+	if err != nil {
+		t.Fatalf("expected subscription to build, got %s", err)
+	}
+	if subscription.GetName() != "orders/*/>" {
+		t.Errorf("expected subscription orders/*/>, got %s", subscription.GetName())
+	}
+}
+
+func TestTopicBuilderEscapesSeparators(t *testing.T) {
+	topic, err := resource.NewTopicBuilder("files", "a/b", "100%").EscapeSeparators().Build()
+	if err != nil {
+		t.Fatalf("expected topic to build, got %s", err)
+	}
+	if topic.GetName() != "files/a%2Fb/100%25" {
+		t.Errorf("expected topic files/a%%2Fb/100%%25, got %s", topic.GetName())
+	}
+}
+
+func TestTopicBuilderErrors(t *testing.T) {
+	testCases := map[string]*resource.TopicBuilder{
+		"no levels":          resource.NewTopicBuilder(),
+		"empty level":        resource.NewTopicBuilder("a", "", "c"),
+		"separator in level": resource.NewTopicBuilder("a", "b/c"),
+		"wildcard":           resource.NewTopicBuilder("a", "*"),
+		"multi-level":        resource.NewTopicBuilder("a", ">"),
+		"too long":           resource.NewTopicBuilder(strings.Repeat("a", resource.MaxTopicLength+1)),
+	}
+	for name, builder := range testCases {
+		if topic, err := builder.Build(); err == nil {
+			t.Errorf("%s: expected error building topic, got %s", name, topic.GetName())
+		}
+	}
+	if _, err := resource.NewTopicBuilder("a", "*", ">").BuildSubscription(); err != nil {
+		t.Errorf("expected wildcards to be permitted in subscriptions, got %s", err)
+	}
+}
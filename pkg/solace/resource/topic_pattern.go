@@ -0,0 +1,115 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TopicPattern is a topic subscription where levels may be named by enclosing the name in braces,
+// such as "orders/{region}/*/created/>". A named level matches any single level like the "*"
+// wildcard, and captures the value of that level in a matching topic under its name.
+type TopicPattern struct {
+	pattern      string
+	subscription string
+	// names and levels are the names and indices of the named levels of a matching topic
+	names  []string
+	levels []int
+}
+
+// ParseTopicPattern parses the specified topic pattern. Returns an error if a name is empty,
+// is used for more than one level or is not the entire level, or if the resulting topic
+// subscription is invalid as defined by ValidateTopicSubscription.
+func ParseTopicPattern(pattern string) (*TopicPattern, error) {
+	topic := subscriptionTopic(pattern)
+	prefix := pattern[:len(pattern)-len(topic)]
+	parsed := &TopicPattern{pattern: pattern}
+	levels := strings.Split(topic, topicLevelSeparator)
+	for i, level := range levels {
+		if !strings.ContainsAny(level, "{}") {
+			continue
+		}
+		if len(level) < 2 || level[0] != '{' || level[len(level)-1] != '}' || strings.ContainsAny(level[1:len(level)-1], "{}") {
+			return nil, fmt.Errorf("topic pattern '%s' level '%s' must be either a name enclosed in braces or contain no braces", pattern, level)
+		}
+		name := level[1 : len(level)-1]
+		if name == "" {
+			return nil, fmt.Errorf("topic pattern '%s' must not contain an empty level name", pattern)
+		}
+		for _, existing := range parsed.names {
+			if existing == name {
+				return nil, fmt.Errorf("topic pattern '%s' must not use level name '%s' more than once", pattern, name)
+			}
+		}
+		parsed.names = append(parsed.names, name)
+		parsed.levels = append(parsed.levels, i)
+		levels[i] = singleLevelWildcard
+	}
+	parsed.subscription = prefix + strings.Join(levels, topicLevelSeparator)
+	if err := ValidateTopicSubscription(parsed.subscription); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// GetName returns the topic pattern as specified to ParseTopicPattern.
+func (p *TopicPattern) GetName() string {
+	return p.pattern
+}
+
+// Subscription returns the topic subscription matching the topic pattern, where named levels are
+// replaced by the "*" wildcard.
+func (p *TopicPattern) Subscription() *TopicSubscription {
+	return TopicSubscriptionOf(p.subscription)
+}
+
+// Names returns the names of the named levels of the topic pattern in the order they appear.
+func (p *TopicPattern) Names() []string {
+	return append([]string{}, p.names...)
+}
+
+// Capture returns the values of the named levels in the specified topic, keyed by name. The topic
+// is assumed to match the topic pattern, for example because it was delivered to a subscription
+// to the topic pattern, and is not matched against it. Use Match to also match the topic.
+func (p *TopicPattern) Capture(topic string) map[string]string {
+	values := make(map[string]string, len(p.names))
+	if len(p.names) == 0 {
+		return values
+	}
+	levels := strings.Split(topic, topicLevelSeparator)
+	for i, name := range p.names {
+		if p.levels[i] < len(levels) {
+			values[name] = levels[p.levels[i]]
+		}
+	}
+	return values
+}
+
+// Match returns the values of the named levels in the specified topic, keyed by name, and true if
+// the topic matches the topic pattern. Returns nil and false otherwise.
+func (p *TopicPattern) Match(topic string) (map[string]string, bool) {
+	if !p.Subscription().Matches(topic) {
+		return nil, false
+	}
+	return p.Capture(topic), true
+}
+
+// String implements fmt.Stringer
+func (p *TopicPattern) String() string {
+	return fmt.Sprintf("TopicPattern: %s", p.GetName())
+}
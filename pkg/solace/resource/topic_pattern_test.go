@@ -0,0 +1,77 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resource_test
+
+import (
+	"reflect"
+	"testing"
+
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+func TestParseTopicPattern(t *testing.T) {
+	testCases := []struct {
+		pattern, subscription string
+		names                 []string
+	}{
+		{"orders/{region}/*/created/>", "orders/*/*/created/>", []string{"region"}},
+		{"{a}/b/{c}", "*/b/*", []string{"a", "c"}},
+		{"a/b", "a/b", []string{}},
+		{"#share/group/orders/{region}", "#share/group/orders/*", []string{"region"}},
+	}
+	for _, testCase := range testCases {
+		pattern, err := resource.ParseTopicPattern(testCase.pattern)
+		if err != nil {
+			t.Errorf("expected %s to parse, got %s", testCase.pattern, err)
+			continue
+		}
+		if pattern.Subscription().GetName() != testCase.subscription {
+			t.Errorf("expected %s to subscribe to %s, got %s", testCase.pattern, testCase.subscription, pattern.Subscription().GetName())
+		}
+		if !reflect.DeepEqual(pattern.Names(), testCase.names) {
+			t.Errorf("expected %s to have names %v, got %v", testCase.pattern, testCase.names, pattern.Names())
+		}
+	}
+}
+
+func TestParseTopicPatternErrors(t *testing.T) {
+	for _, pattern := range []string{"", "a/{}", "a/{b", "a/b}", "a/x{b}", "a/{b}c", "a/{{b}}", "{a}/{a}"} {
+		if _, err := resource.ParseTopicPattern(pattern); err == nil {
+			t.Errorf("expected error parsing %q", pattern)
+		}
+	}
+}
+
+func TestTopicPatternMatch(t *testing.T) {
+	pattern, err := resource.ParseTopicPattern("#share/group/orders/{region}/*/created/{id}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	levels, ok := pattern.Match("orders/eu/web/created/1234")
+	if !ok {
+		t.Fatal("expected topic to match")
+	}
+	if expected := map[string]string{"region": "eu", "id": "1234"}; !reflect.DeepEqual(levels, expected) {
+		t.Errorf("expected levels %v, got %v", expected, levels)
+	}
+	if levels, ok := pattern.Match("orders/eu/web/deleted/1234"); ok {
+		t.Errorf("expected topic not to match, got %v", levels)
+	}
+	if levels := pattern.Capture("orders/eu"); !reflect.DeepEqual(levels, map[string]string{"region": "eu"}) {
+		t.Errorf("expected only present levels to be captured, got %v", levels)
+	}
+}
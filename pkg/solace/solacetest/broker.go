@@ -0,0 +1,298 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/resource"
+	"solace.dev/go/messaging/pkg/solace/subcode"
+)
+
+const (
+	topicLevelSeparator   = "/"
+	sharedSubscriptionTag = "#share/"
+	noExportTag           = "#noexport/"
+)
+
+// Broker is an in-memory message router shared by the messaging services created from it.
+// Direct messages are delivered to every matching subscription, and to one receiver per
+// share name for shared subscriptions. Messages published to a queue, or to a topic matching
+// one of the subscriptions of a queue, are spooled on the queue until they are settled.
+type Broker struct {
+	lock          sync.Mutex
+	subscriptions []*topicSubscription
+	queues        map[string]*queue
+	shareCursors  map[string]int
+	nextID        uint64
+}
+
+// NewBroker creates a new in-memory broker without any queues.
+func NewBroker() *Broker {
+	return &Broker{
+		queues:       make(map[string]*queue),
+		shareCursors: make(map[string]int),
+	}
+}
+
+// directConsumer receives the direct messages matching one of its topic subscriptions
+type directConsumer interface {
+	// deliverDirect is called without the broker lock held. The message must not be modified.
+	deliverDirect(msg *message.OutboundMessageImpl)
+}
+
+// topicSubscription is a topic subscription of a direct consumer
+type topicSubscription struct {
+	topic     string
+	shareName string
+	consumer  directConsumer
+}
+
+// parseSubscription splits the share name from the given subscription, removing any #noexport prefix
+func parseSubscription(subscription string) (topic, shareName string) {
+	topic = strings.TrimPrefix(subscription, noExportTag)
+	if strings.HasPrefix(topic, sharedSubscriptionTag) {
+		rest := topic[len(sharedSubscriptionTag):]
+		if separator := strings.Index(rest, topicLevelSeparator); separator > 0 {
+			return rest[separator+1:], rest[:separator]
+		}
+	}
+	return topic, ""
+}
+
+// topicMatches returns true if the given topic matches the given subscription with the semantics
+// of the broker, see resource.TopicSubscription.Matches
+func topicMatches(subscription, topic string) bool {
+	return resource.TopicSubscriptionOf(subscription).Matches(topic)
+}
+
+// subscribe adds a topic subscription for the given consumer. The share name of the
+// subscription is either given or parsed from a "#share/<name>/" prefixed subscription.
+func (broker *Broker) subscribe(consumer directConsumer, subscription, shareName string) {
+	topic, parsedShareName := parseSubscription(subscription)
+	if shareName == "" {
+		shareName = parsedShareName
+	}
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	for _, existing := range broker.subscriptions {
+		if existing.consumer == consumer && existing.topic == topic && existing.shareName == shareName {
+			return
+		}
+	}
+	broker.subscriptions = append(broker.subscriptions, &topicSubscription{topic: topic, shareName: shareName, consumer: consumer})
+}
+
+// unsubscribe removes a topic subscription of the given consumer
+func (broker *Broker) unsubscribe(consumer directConsumer, subscription, shareName string) {
+	topic, parsedShareName := parseSubscription(subscription)
+	if shareName == "" {
+		shareName = parsedShareName
+	}
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	for i, existing := range broker.subscriptions {
+		if existing.consumer == consumer && existing.topic == topic && existing.shareName == shareName {
+			broker.subscriptions = append(broker.subscriptions[:i], broker.subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+// unsubscribeAll removes all topic subscriptions of the given consumer
+func (broker *Broker) unsubscribeAll(consumer directConsumer) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	remaining := broker.subscriptions[:0]
+	for _, existing := range broker.subscriptions {
+		if existing.consumer != consumer {
+			remaining = append(remaining, existing)
+		}
+	}
+	for i := len(remaining); i < len(broker.subscriptions); i++ {
+		broker.subscriptions[i] = nil
+	}
+	broker.subscriptions = remaining
+}
+
+// publishToTopic routes a message, with its destination already set, to all matching subscriptions and queues
+func (broker *Broker) publishToTopic(msg *message.OutboundMessageImpl, topic string) {
+	broker.lock.Lock()
+	var targets []directConsumer
+	seen := make(map[directConsumer]bool)
+	var shareNames []string
+	shareGroups := make(map[string][]directConsumer)
+	for _, subscription := range broker.subscriptions {
+		if !topicMatches(subscription.topic, topic) {
+			continue
+		}
+		if subscription.shareName == "" {
+			if !seen[subscription.consumer] {
+				seen[subscription.consumer] = true
+				targets = append(targets, subscription.consumer)
+			}
+			continue
+		}
+		group, ok := shareGroups[subscription.shareName]
+		if !ok {
+			shareNames = append(shareNames, subscription.shareName)
+		}
+		if !containsConsumer(group, subscription.consumer) {
+			shareGroups[subscription.shareName] = append(group, subscription.consumer)
+		}
+	}
+	for _, shareName := range shareNames {
+		group := shareGroups[shareName]
+		consumer := group[broker.shareCursors[shareName]%len(group)]
+		broker.shareCursors[shareName]++
+		if !seen[consumer] {
+			seen[consumer] = true
+			targets = append(targets, consumer)
+		}
+	}
+	for _, queue := range broker.queues {
+		if queue.matches(topic) {
+			queue.enqueue(msg)
+		}
+	}
+	broker.lock.Unlock()
+	for _, consumer := range targets {
+		consumer.deliverDirect(msg)
+	}
+}
+
+// publishToQueue spools a message, with its destination already set, on the queue with the given name
+func (broker *Broker) publishToQueue(msg *message.OutboundMessageImpl, queueName string) error {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	queue, ok := broker.queues[queueName]
+	if !ok {
+		return solace.NewNativeError(fmt.Sprintf("queue '%s' does not exist", queueName), subcode.QueueNotFound)
+	}
+	queue.enqueue(msg)
+	return nil
+}
+
+func containsConsumer(consumers []directConsumer, consumer directConsumer) bool {
+	for _, existing := range consumers {
+		if existing == consumer {
+			return true
+		}
+	}
+	return false
+}
+
+// ProvisionQueue creates a durable queue with the given name and topic subscriptions.
+// The queue is exclusive if exclusive is true, otherwise messages are distributed
+// between all bound receivers. Returns an error if a queue with the name already exists.
+func (broker *Broker) ProvisionQueue(name string, exclusive bool, subscriptions ...string) error {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	if _, ok := broker.queues[name]; ok {
+		return solace.NewNativeError(fmt.Sprintf("queue '%s' already exists", name), subcode.EndpointAlreadyExists)
+	}
+	queue := broker.newQueue(name, true, exclusive)
+	queue.subscriptions = append(queue.subscriptions, subscriptions...)
+	return nil
+}
+
+// DeprovisionQueue removes the queue with the given name and all messages spooled on it.
+// Receivers bound to the queue are terminated. Returns an error if the queue does not exist.
+func (broker *Broker) DeprovisionQueue(name string) error {
+	broker.lock.Lock()
+	queue, ok := broker.queues[name]
+	if !ok {
+		broker.lock.Unlock()
+		return solace.NewNativeError(fmt.Sprintf("queue '%s' does not exist", name), subcode.UnknownQueueName)
+	}
+	delete(broker.queues, name)
+	consumers := queue.consumers
+	queue.consumers = nil
+	broker.lock.Unlock()
+	reason := fmt.Sprintf("queue '%s' was deleted", name)
+	for _, consumer := range consumers {
+		consumer.shutdown(reason, solace.NewNativeError(reason, subcode.QueueShutdown))
+	}
+	return nil
+}
+
+// QueueDepth returns the number of messages spooled on the queue with the given name, including
+// messages delivered to a receiver but not yet settled, and false if the queue does not exist.
+func (broker *Broker) QueueDepth(name string) (int, bool) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	queue, ok := broker.queues[name]
+	if !ok {
+		return 0, false
+	}
+	return len(queue.entries), true
+}
+
+// bindQueue binds the receiver to the queue described by the given resource, creating the queue if it is
+// non-durable or if create is true. A name is generated for anonymous queues.
+func (broker *Broker) bindQueue(receiver *persistentMessageReceiverImpl, queueResource *resource.Queue, create bool) (*queue, error) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	name := queueResource.GetName()
+	if name == "" {
+		broker.nextID++
+		name = fmt.Sprintf("#P2P/QTMP/solacetest/%d", broker.nextID)
+	}
+	queue, ok := broker.queues[name]
+	if !ok {
+		if queueResource.IsDurable() && !create {
+			return nil, solace.NewNativeError(fmt.Sprintf("unknown queue '%s'", name), subcode.UnknownQueueName)
+		}
+		queue = broker.newQueue(name, queueResource.IsDurable(), queueResource.IsExclusivelyAccessible())
+	}
+	queue.consumers = append(queue.consumers, receiver)
+	queue.dispatch()
+	return queue, nil
+}
+
+// unbindQueue unbinds the receiver from the given queue, all messages delivered to the receiver
+// but not yet settled are redelivered. Non-durable queues are deleted with their last receiver.
+func (broker *Broker) unbindQueue(receiver *persistentMessageReceiverImpl, queue *queue) {
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	for i, consumer := range queue.consumers {
+		if consumer == receiver {
+			queue.consumers = append(queue.consumers[:i], queue.consumers[i+1:]...)
+			break
+		}
+	}
+	queue.release(receiver)
+	if !queue.durable && len(queue.consumers) == 0 {
+		delete(broker.queues, queue.name)
+	}
+}
+
+// newQueue creates a new queue, the broker lock must be held
+func (broker *Broker) newQueue(name string, durable, exclusive bool) *queue {
+	queue := &queue{
+		broker:    broker,
+		name:      name,
+		durable:   durable,
+		exclusive: exclusive,
+	}
+	broker.queues[name] = queue
+	return queue
+}
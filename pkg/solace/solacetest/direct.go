@@ -0,0 +1,440 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/internal/impl/validation"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// defaultDirectReceiverBufferCapacity is the buffer capacity of direct receivers when none is configured
+const defaultDirectReceiverBufferCapacity = 50
+
+type directMessagePublisherImpl struct {
+	basicMessagePublisher
+	failureListener solace.PublishFailureListener
+}
+
+// StartAsyncCallback starts the publisher asynchronously, calling the callback when started.
+func (publisher *directMessagePublisherImpl) StartAsyncCallback(callback func(solace.DirectMessagePublisher, error)) {
+	go func() {
+		callback(publisher, publisher.Start())
+	}()
+}
+
+// TerminateAsyncCallback terminates the publisher asynchronously, calling the callback when terminated.
+func (publisher *directMessagePublisherImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(publisher.Terminate(gracePeriod))
+	}()
+}
+
+// SetPublishFailureListener sets the listener notified of failed publishes. Publishing to the
+// in-memory broker never fails asynchronously, the listener is never called.
+func (publisher *directMessagePublisherImpl) SetPublishFailureListener(listener solace.PublishFailureListener) {
+	publisher.listenerLock.Lock()
+	defer publisher.listenerLock.Unlock()
+	publisher.failureListener = listener
+}
+
+// PublishBytes publishes a message with a byte array payload to the given topic.
+func (publisher *directMessagePublisherImpl) PublishBytes(bytes []byte, destination *resource.Topic) error {
+	msg, err := publisher.service.MessageBuilder().BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(msg, destination)
+}
+
+// PublishString publishes a message with a string payload to the given topic.
+func (publisher *directMessagePublisherImpl) PublishString(str string, destination *resource.Topic) error {
+	msg, err := publisher.service.MessageBuilder().BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(msg, destination)
+}
+
+// Publish publishes the message to the given topic.
+func (publisher *directMessagePublisherImpl) Publish(msg apimessage.OutboundMessage, destination *resource.Topic) error {
+	return publisher.PublishWithProperties(msg, destination, nil)
+}
+
+// PublishWithProperties publishes the message to the given topic with the given additional properties.
+func (publisher *directMessagePublisherImpl) PublishWithProperties(msg apimessage.OutboundMessage, destination *resource.Topic, properties config.MessagePropertiesConfigurationProvider) error {
+	if err := publisher.checkPublish(); err != nil {
+		return err
+	}
+	msgDup, err := prepareMessage(msg, properties)
+	if err != nil {
+		return err
+	}
+	if err := message.SetDestination(msgDup, destination.GetName()); err != nil {
+		return err
+	}
+	publisher.recordPublish(msgDup, metrics.DirectMessagesSent)
+	publisher.service.broker.publishToTopic(msgDup, destination.GetName())
+	return nil
+}
+
+// PublishBatch publishes the messages in order to a single topic or to one topic per message.
+func (publisher *directMessagePublisherImpl) PublishBatch(msgs []apimessage.OutboundMessage, destinations ...*resource.Topic) error {
+	if err := publisher.checkPublish(); err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	if len(destinations) != 1 && len(destinations) != len(msgs) {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PublishBatchDestinationMismatch, len(destinations), len(msgs)), nil)
+	}
+	topicName := func(index int) string {
+		if len(destinations) == 1 {
+			return destinations[0].GetName()
+		}
+		return destinations[index].GetName()
+	}
+	batch := make([]*message.OutboundMessageImpl, len(msgs))
+	for i, msg := range msgs {
+		msgDup, err := prepareMessage(msg, nil)
+		if err != nil {
+			return err
+		}
+		if err := message.SetDestination(msgDup, topicName(i)); err != nil {
+			return err
+		}
+		batch[i] = msgDup
+	}
+	for i, msgDup := range batch {
+		publisher.recordPublish(msgDup, metrics.DirectMessagesSent)
+		publisher.service.broker.publishToTopic(msgDup, topicName(i))
+	}
+	return nil
+}
+
+func (publisher *directMessagePublisherImpl) String() string {
+	return fmt.Sprintf("solacetest.DirectMessagePublisher at %p", publisher)
+}
+
+type directMessagePublisherBuilderImpl struct {
+	service *MessagingService
+}
+
+// Build creates a new direct message publisher.
+func (builder *directMessagePublisherBuilderImpl) Build() (solace.DirectMessagePublisher, error) {
+	publisher := &directMessagePublisherImpl{}
+	publisher.init(builder.service)
+	return publisher, nil
+}
+
+// OnBackPressureReject has no effect, publishers of the in-memory broker never apply back pressure.
+func (builder *directMessagePublisherBuilderImpl) OnBackPressureReject(bufferSize uint) solace.DirectMessagePublisherBuilder {
+	return builder
+}
+
+// OnBackPressureWait has no effect, publishers of the in-memory broker never apply back pressure.
+func (builder *directMessagePublisherBuilderImpl) OnBackPressureWait(bufferSize uint) solace.DirectMessagePublisherBuilder {
+	return builder
+}
+
+// FromConfigurationProvider has no effect, publishers of the in-memory broker have no configuration.
+func (builder *directMessagePublisherBuilderImpl) FromConfigurationProvider(provider config.PublisherPropertiesConfigurationProvider) solace.DirectMessagePublisherBuilder {
+	return builder
+}
+
+type directMessageReceiverImpl struct {
+	directReceiverCore
+
+	routeLock sync.Mutex
+	routes    map[string]*topicRoute
+	callback  solace.MessageHandler
+}
+
+// topicRoute is a route of a direct receiver, subscribed to the broker as a consumer of its own
+// such that messages are delivered to the route without matching them against other routes
+type topicRoute struct {
+	receiver *directMessageReceiverImpl
+	pattern  *resource.TopicPattern
+	handler  solace.TopicRouteHandler
+}
+
+func (route *topicRoute) deliverDirect(msg *message.OutboundMessageImpl) {
+	route.receiver.deliverRouted(msg, route)
+}
+
+func (route *topicRoute) handle(msg *inboundMessage) {
+	route.handler(msg, route.pattern.Capture(msg.GetDestinationName()))
+}
+
+func (receiver *directMessageReceiverImpl) init(service *MessagingService, subscriptions []string, shareName string, inbox *inbox, codecs []solace.Codec) {
+	receiver.directReceiverCore.init(service, subscriptions, shareName, inbox, codecs)
+	receiver.routes = make(map[string]*topicRoute)
+	receiver.onTerminate = receiver.terminate
+}
+
+func (receiver *directMessageReceiverImpl) terminate(ctx context.Context) error {
+	receiver.routeLock.Lock()
+	for _, route := range receiver.routes {
+		receiver.service.broker.unsubscribeAll(route)
+	}
+	receiver.routeLock.Unlock()
+	return receiver.directReceiverCore.terminate(ctx)
+}
+
+// handleMessage calls the handler of the route the message was delivered to, or the callback registered with ReceiveAsync
+func (receiver *directMessageReceiverImpl) handleMessage(msg *inboundMessage) {
+	if msg.route != nil {
+		msg.route.handle(msg)
+		return
+	}
+	receiver.routeLock.Lock()
+	callback := receiver.callback
+	receiver.routeLock.Unlock()
+	if callback != nil {
+		callback(msg)
+	} else {
+		msg.Dispose()
+		receiver.metrics.increment(metrics.ReceiverMessagesUnroutedDiscarded, 1)
+	}
+}
+
+// AddRoute subscribes to the topic pattern and routes the messages received on it to the handler.
+func (receiver *directMessageReceiverImpl) AddRoute(pattern string, handler solace.TopicRouteHandler) error {
+	if state := receiver.getState(); state != componentStateStarted {
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, componentStateNames[state]), nil)
+	}
+	topicPattern, err := resource.ParseTopicPattern(pattern)
+	if err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+	}
+	if handler == nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.TopicRouteHandlerNil, pattern), nil)
+	}
+	receiver.routeLock.Lock()
+	if _, ok := receiver.routes[pattern]; ok {
+		receiver.routeLock.Unlock()
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.TopicRouteAlreadyAdded, pattern), nil)
+	}
+	route := &topicRoute{receiver: receiver, pattern: topicPattern, handler: handler}
+	receiver.routes[pattern] = route
+	receiver.routeLock.Unlock()
+	receiver.service.broker.subscribe(route, topicPattern.Subscription().GetName(), receiver.shareName)
+	// routed messages are delivered even if no callback is registered with ReceiveAsync
+	return receiver.setAsyncHandler(receiver.handleMessage)
+}
+
+// RemoveRoute removes the route of the topic pattern and its subscription.
+func (receiver *directMessageReceiverImpl) RemoveRoute(pattern string) error {
+	if state := receiver.getState(); state != componentStateStarted {
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, componentStateNames[state]), nil)
+	}
+	receiver.routeLock.Lock()
+	route, ok := receiver.routes[pattern]
+	delete(receiver.routes, pattern)
+	receiver.routeLock.Unlock()
+	if !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.TopicRouteNotFound, pattern), nil)
+	}
+	receiver.service.broker.unsubscribeAll(route)
+	return nil
+}
+
+// StartAsyncCallback starts the receiver asynchronously, calling the callback when started.
+func (receiver *directMessageReceiverImpl) StartAsyncCallback(callback func(solace.DirectMessageReceiver, error)) {
+	go func() {
+		callback(receiver, receiver.Start())
+	}()
+}
+
+// TerminateAsyncCallback terminates the receiver asynchronously, calling the callback when terminated.
+func (receiver *directMessageReceiverImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(receiver.Terminate(gracePeriod))
+	}()
+}
+
+// ReceiveAsync registers the callback called with each received message.
+func (receiver *directMessageReceiverImpl) ReceiveAsync(callback solace.MessageHandler) error {
+	receiver.routeLock.Lock()
+	receiver.callback = callback
+	receiver.routeLock.Unlock()
+	return receiver.setAsyncHandler(receiver.handleMessage)
+}
+
+// ReceiveMessage receives a message synchronously, waiting for at most the given timeout.
+func (receiver *directMessageReceiverImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, error) {
+	if err := receiver.checkReceive(); err != nil {
+		return nil, err
+	}
+	msg, err := receiver.inbox.receiveWithTimeout(timeout, receiver.stopping)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ReceiveMessageWithContext receives a message synchronously, waiting until the context is done.
+func (receiver *directMessageReceiverImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, error) {
+	if err := receiver.checkReceive(); err != nil {
+		return nil, err
+	}
+	msg, err := receiver.inbox.receive(ctx, nil, receiver.stopping)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// RequestCachedAsync is not supported by the in-memory broker.
+func (receiver *directMessageReceiverImpl) RequestCachedAsync(cachedMessageSubscriptionRequest resource.CachedMessageSubscriptionRequest, cacheRequestID apimessage.CacheRequestID) (<-chan solace.CacheResponse, error) {
+	return nil, errNotSupported("cache requests")
+}
+
+// RequestCachedAsyncWithCallback is not supported by the in-memory broker.
+func (receiver *directMessageReceiverImpl) RequestCachedAsyncWithCallback(cachedMessageSubscriptionRequest resource.CachedMessageSubscriptionRequest, cacheRequestID apimessage.CacheRequestID, callback func(solace.CacheResponse)) error {
+	return errNotSupported("cache requests")
+}
+
+func (receiver *directMessageReceiverImpl) String() string {
+	return fmt.Sprintf("solacetest.DirectMessageReceiver at %p", receiver)
+}
+
+type directMessageReceiverBuilderImpl struct {
+	service       *MessagingService
+	properties    config.ReceiverPropertyMap
+	subscriptions []resource.Subscription
+	codecs        []solace.Codec
+}
+
+func newDirectMessageReceiverBuilder(service *MessagingService) *directMessageReceiverBuilderImpl {
+	return &directMessageReceiverBuilderImpl{
+		service: service,
+		properties: config.ReceiverPropertyMap{
+			config.ReceiverPropertyDirectBackPressureStrategy:       config.ReceiverBackPressureStrategyDropLatest,
+			config.ReceiverPropertyDirectBackPressureBufferCapacity: defaultDirectReceiverBufferCapacity,
+		},
+	}
+}
+
+// Build creates a new direct message receiver.
+func (builder *directMessageReceiverBuilderImpl) Build() (solace.DirectMessageReceiver, error) {
+	return builder.BuildWithShareName(nil)
+}
+
+// BuildWithShareName creates a new direct message receiver sharing its subscriptions with the given share name.
+func (builder *directMessageReceiverBuilderImpl) BuildWithShareName(shareName *resource.ShareName) (solace.DirectMessageReceiver, error) {
+	inbox, err := newDirectInbox(builder.properties)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := toSubscriptionNames(builder.subscriptions, constants.DirectReceiverUnsupportedSubscriptionType)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	if shareName != nil {
+		name = shareName.GetName()
+	}
+	receiver := &directMessageReceiverImpl{}
+	receiver.init(builder.service, subscriptions, name, inbox, builder.codecs)
+	return receiver, nil
+}
+
+// OnBackPressureDropLatest drops the newest message when the buffer of the given capacity is full.
+func (builder *directMessageReceiverBuilderImpl) OnBackPressureDropLatest(bufferCapacity uint) solace.DirectMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyDirectBackPressureStrategy] = config.ReceiverBackPressureStrategyDropLatest
+	builder.properties[config.ReceiverPropertyDirectBackPressureBufferCapacity] = bufferCapacity
+	return builder
+}
+
+// OnBackPressureDropOldest drops the oldest message when the buffer of the given capacity is full.
+func (builder *directMessageReceiverBuilderImpl) OnBackPressureDropOldest(bufferCapacity uint) solace.DirectMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyDirectBackPressureStrategy] = config.ReceiverBackPressureStrategyDropOldest
+	builder.properties[config.ReceiverPropertyDirectBackPressureBufferCapacity] = bufferCapacity
+	return builder
+}
+
+// WithSubscriptions sets the topic subscriptions added when the receiver starts.
+func (builder *directMessageReceiverBuilderImpl) WithSubscriptions(topics ...resource.Subscription) solace.DirectMessageReceiverBuilder {
+	builder.subscriptions = append(builder.subscriptions, topics...)
+	return builder
+}
+
+// WithCodec registers a codec used by DecodePayload.
+func (builder *directMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.DirectMessageReceiverBuilder {
+	if codec != nil {
+		builder.codecs = append(builder.codecs, codec)
+	}
+	return builder
+}
+
+// WithConcurrentDispatch has no effect, the in-memory broker delivers messages to the message handler one at a time.
+func (builder *directMessageReceiverBuilderImpl) WithConcurrentDispatch(workers uint, ordering config.OrderingPolicy) solace.DirectMessageReceiverBuilder {
+	return builder
+}
+
+// FromConfigurationProvider applies the given receiver properties.
+func (builder *directMessageReceiverBuilderImpl) FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) solace.DirectMessageReceiverBuilder {
+	if provider == nil {
+		return builder
+	}
+	for key, value := range provider.GetConfiguration() {
+		builder.properties[key] = value
+	}
+	return builder
+}
+
+// newDirectInbox creates the buffer of a direct or request-reply receiver with the back pressure
+// strategy configured in the given properties
+func newDirectInbox(properties config.ReceiverPropertyMap) (*inbox, error) {
+	strategy, _, err := validation.StringPropertyValidation(
+		string(config.ReceiverPropertyDirectBackPressureStrategy),
+		properties[config.ReceiverPropertyDirectBackPressureStrategy],
+		config.ReceiverBackPressureStrategyDropLatest,
+		config.ReceiverBackPressureStrategyDropOldest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	capacity, _, err := validation.IntegerPropertyValidation(
+		string(config.ReceiverPropertyDirectBackPressureBufferCapacity),
+		properties[config.ReceiverPropertyDirectBackPressureBufferCapacity],
+	)
+	if err != nil {
+		return nil, err
+	}
+	if capacity < 1 {
+		return nil, solace.NewError(&solace.InvalidConfigurationError{}, constants.DirectReceiverBackpressureMustBeGreaterThan0, nil)
+	}
+	return newInbox(capacity, strategy == config.ReceiverBackPressureStrategyDropOldest), nil
+}
+
+// errNotSupported returns the error returned when a feature is not supported by the in-memory broker
+func errNotSupported(feature string) error {
+	return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf("%s are not supported by solacetest", feature), nil)
+}
@@ -0,0 +1,41 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package solacetest provides an in-memory implementation of solace.MessagingService for
+// unit testing applications without a broker. Messages are routed by an in-process Broker
+// with the same topic wildcard matching as a PubSub+ broker, and persistent messages are
+// spooled on queues with acknowledgement, settlement and redelivery semantics.
+//
+// The messages built, published and received are the same as with a broker-backed service,
+// so application code can be tested unchanged.
+//
+//	service := solacetest.NewMessagingService()
+//	if err := service.Connect(); err != nil {
+//		...
+//	}
+//	receiver, _ := service.CreateDirectMessageReceiverBuilder().
+//		WithSubscriptions(resource.TopicSubscriptionOf("orders/*/created")).
+//		Build()
+//	receiver.Start()
+//	publisher, _ := service.CreateDirectMessagePublisherBuilder().Build()
+//	publisher.Start()
+//	publisher.PublishString("hello", resource.TopicOf("orders/eu/created"))
+//	msg, err := receiver.ReceiveMessage(time.Second)
+//
+// Queues can be created up front with Broker.ProvisionQueue or with the EndpointProvisioner of
+// the service. Connection events are injected with MessagingService.SimulateReconnectionAttempt,
+// MessagingService.SimulateReconnection and MessagingService.SimulateServiceInterruption.
+package solacetest
@@ -0,0 +1,176 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+)
+
+// inboundMessage is a message delivered to a receiver
+type inboundMessage struct {
+	*message.InboundMessageImpl
+	redelivered bool
+	// entry and delivery identify the delivery of a queued message
+	entry    *queueEntry
+	delivery uint64
+	// route is the topic route of a direct receiver the message was delivered to, if any
+	route *topicRoute
+}
+
+// newInboundMessage duplicates a routed message for delivery to a receiver
+func newInboundMessage(msg *message.OutboundMessageImpl, discard bool) (*inboundMessage, error) {
+	inbound, err := message.ToInboundMessage(msg, discard)
+	if err != nil {
+		return nil, err
+	}
+	return &inboundMessage{InboundMessageImpl: inbound}, nil
+}
+
+// IsRedelivered returns true if the message was delivered before and not settled.
+func (msg *inboundMessage) IsRedelivered() bool {
+	return msg.redelivered
+}
+
+// asInboundMessage returns the inboundMessage backing the given message
+func asInboundMessage(msg apimessage.InboundMessage) (*inboundMessage, bool) {
+	inbound, ok := msg.(*inboundMessage)
+	return inbound, ok
+}
+
+// inbox buffers the messages delivered to a receiver until they are received by the application
+type inbox struct {
+	lock     sync.Mutex
+	messages []*inboundMessage
+	// capacity is the maximum number of buffered messages, 0 for unlimited
+	capacity   int
+	dropOldest bool
+	// discarded is set when a message is dropped, the next buffered message carries the discard indication
+	discarded bool
+	notify    chan struct{}
+}
+
+func newInbox(capacity int, dropOldest bool) *inbox {
+	return &inbox{capacity: capacity, dropOldest: dropOldest, notify: make(chan struct{}, 1)}
+}
+
+// push buffers the message created by the given function, the function is passed true if an earlier message was
+// dropped. Returns false if the inbox is full and the message was dropped, or the message could not be created.
+func (inbox *inbox) push(newMessage func(discard bool) (*inboundMessage, error)) bool {
+	inbox.lock.Lock()
+	defer inbox.lock.Unlock()
+	if inbox.capacity > 0 && len(inbox.messages) >= inbox.capacity {
+		if !inbox.dropOldest {
+			inbox.discarded = true
+			return false
+		}
+		inbox.messages[0] = nil
+		inbox.messages = inbox.messages[1:]
+		inbox.discarded = true
+	}
+	msg, err := newMessage(inbox.discarded)
+	if err != nil {
+		return false
+	}
+	inbox.discarded = false
+	inbox.messages = append(inbox.messages, msg)
+	select {
+	case inbox.notify <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// pop removes the oldest buffered message
+func (inbox *inbox) pop() (*inboundMessage, bool) {
+	inbox.lock.Lock()
+	defer inbox.lock.Unlock()
+	if len(inbox.messages) == 0 {
+		return nil, false
+	}
+	msg := inbox.messages[0]
+	inbox.messages[0] = nil
+	inbox.messages = inbox.messages[1:]
+	if len(inbox.messages) > 0 {
+		select {
+		case inbox.notify <- struct{}{}:
+		default:
+		}
+	}
+	return msg, true
+}
+
+// removeIf removes and returns all buffered messages matching the given predicate
+func (inbox *inbox) removeIf(predicate func(msg *inboundMessage) bool) []*inboundMessage {
+	inbox.lock.Lock()
+	defer inbox.lock.Unlock()
+	var removed []*inboundMessage
+	remaining := make([]*inboundMessage, 0, len(inbox.messages))
+	for _, msg := range inbox.messages {
+		if predicate(msg) {
+			removed = append(removed, msg)
+		} else {
+			remaining = append(remaining, msg)
+		}
+	}
+	inbox.messages = remaining
+	return removed
+}
+
+// clear removes all buffered messages and returns the number of messages removed
+func (inbox *inbox) clear() int {
+	return len(inbox.removeIf(func(*inboundMessage) bool { return true }))
+}
+
+// receive waits for a message until the context is done, the timeout fires or the receiver terminates.
+// A nil timeout waits without a timeout.
+func (inbox *inbox) receive(ctx context.Context, timeout <-chan time.Time, terminated <-chan struct{}) (*inboundMessage, error) {
+	for {
+		if msg, ok := inbox.pop(); ok {
+			return msg, nil
+		}
+		select {
+		case <-inbox.notify:
+		case <-timeout:
+			return nil, solace.NewError(&solace.TimeoutError{}, constants.ReceiverTimedOutWaitingForMessage, nil)
+		case <-terminated:
+			if msg, ok := inbox.pop(); ok {
+				return msg, nil
+			}
+			return nil, solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveAlreadyTerminated, nil)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// receiveWithTimeout waits for a message for at most the given timeout, a negative timeout waits indefinitely
+func (inbox *inbox) receiveWithTimeout(timeout time.Duration, terminated <-chan struct{}) (*inboundMessage, error) {
+	var timeoutChannel <-chan time.Time
+	if timeout >= 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		timeoutChannel = timer.C
+	}
+	return inbox.receive(context.Background(), timeoutChannel, terminated)
+}
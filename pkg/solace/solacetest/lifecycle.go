@@ -0,0 +1,293 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+type componentState int
+
+const (
+	componentStateNotStarted componentState = iota
+	componentStateStarted
+	componentStateTerminating
+	componentStateTerminated
+)
+
+var componentStateNames = map[componentState]string{
+	componentStateNotStarted:  "NotStarted",
+	componentStateStarted:     "Started",
+	componentStateTerminating: "Terminating",
+	componentStateTerminated:  "Terminated",
+}
+
+// componentMessages holds the error messages of a kind of component
+type componentMessages struct {
+	notConnected, alreadyTerminated, notStarted string
+}
+
+var publisherMessages = componentMessages{
+	notConnected:      constants.UnableToStartPublisherParentServiceNotStarted,
+	alreadyTerminated: constants.UnableToStartPublisher,
+	notStarted:        constants.UnableToTerminatePublisher,
+}
+
+var receiverMessages = componentMessages{
+	notConnected:      constants.UnableToStartReceiverParentServiceNotStarted,
+	alreadyTerminated: constants.UnableToStartReceiver,
+	notStarted:        constants.UnableToTerminateReceiver,
+}
+
+// component implements the lifecycle shared by all publishers and receivers
+type component struct {
+	service  *MessagingService
+	messages componentMessages
+
+	lock                sync.Mutex
+	state               componentState
+	terminationListener solace.TerminationNotificationListener
+	terminated          chan struct{}
+
+	// onStart is called with the component lock held when the component starts
+	onStart func() error
+	// onTerminate is called once when a started component terminates, delivering buffered messages until
+	// the context is done. The context is already done when the component is terminated by the messaging
+	// service. Returns an error if messages were discarded.
+	onTerminate func(ctx context.Context) error
+	// onReconnect is called when a reconnection of the messaging service is simulated
+	onReconnect func()
+}
+
+func (component *component) init(service *MessagingService, messages componentMessages) {
+	component.service = service
+	component.messages = messages
+	component.terminated = make(chan struct{})
+}
+
+// Start starts the component synchronously.
+func (component *component) Start() error {
+	component.lock.Lock()
+	defer component.lock.Unlock()
+	switch component.state {
+	case componentStateStarted:
+		return nil
+	case componentStateTerminating, componentStateTerminated:
+		return solace.NewError(&solace.IllegalStateError{}, component.messages.alreadyTerminated, nil)
+	}
+	if !component.service.register(component) {
+		return solace.NewError(&solace.IllegalStateError{}, component.messages.notConnected, nil)
+	}
+	if component.onStart != nil {
+		if err := component.onStart(); err != nil {
+			component.service.unregister(component)
+			return err
+		}
+	}
+	component.state = componentStateStarted
+	return nil
+}
+
+// StartAsync starts the component asynchronously.
+func (component *component) StartAsync() <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- component.Start()
+	}()
+	return result
+}
+
+// Terminate terminates the component, delivering buffered messages for at most the given grace period.
+func (component *component) Terminate(gracePeriod time.Duration) error {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if gracePeriod < 0 {
+		ctx, cancel = context.WithCancel(context.Background())
+	} else {
+		ctx, cancel = context.WithTimeout(context.Background(), gracePeriod)
+	}
+	defer cancel()
+	return component.TerminateWithContext(ctx)
+}
+
+// TerminateWithContext terminates the component, delivering buffered messages until the given context is done.
+func (component *component) TerminateWithContext(ctx context.Context) error {
+	component.lock.Lock()
+	switch component.state {
+	case componentStateNotStarted:
+		component.lock.Unlock()
+		return solace.NewError(&solace.IllegalStateError{}, component.messages.notStarted, nil)
+	case componentStateTerminating:
+		component.lock.Unlock()
+		<-component.terminated
+		return nil
+	case componentStateTerminated:
+		component.lock.Unlock()
+		return nil
+	}
+	component.state = componentStateTerminating
+	component.lock.Unlock()
+	var err error
+	if component.onTerminate != nil {
+		err = component.onTerminate(ctx)
+	}
+	component.setTerminated()
+	return err
+}
+
+// TerminateAsync terminates the component asynchronously.
+func (component *component) TerminateAsync(gracePeriod time.Duration) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- component.Terminate(gracePeriod)
+	}()
+	return result
+}
+
+// shutdown terminates the component immediately, notifying the termination listener if the component was started
+func (component *component) shutdown(message string, cause error) {
+	component.lock.Lock()
+	state := component.state
+	if state == componentStateTerminating || state == componentStateTerminated {
+		component.lock.Unlock()
+		return
+	}
+	component.state = componentStateTerminating
+	listener := component.terminationListener
+	component.lock.Unlock()
+	if state == componentStateStarted && component.onTerminate != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		component.onTerminate(ctx)
+	}
+	component.setTerminated()
+	if state == componentStateStarted && listener != nil {
+		listener(&terminationEvent{timestamp: time.Now(), message: message, cause: cause})
+	}
+}
+
+func (component *component) setTerminated() {
+	component.lock.Lock()
+	component.state = componentStateTerminated
+	component.lock.Unlock()
+	close(component.terminated)
+	component.service.unregister(component)
+}
+
+// IsRunning checks if the component is started and not terminating.
+func (component *component) IsRunning() bool {
+	return component.getState() == componentStateStarted
+}
+
+// IsTerminated checks if the component is terminated.
+func (component *component) IsTerminated() bool {
+	return component.getState() == componentStateTerminated
+}
+
+// IsTerminating checks if the component is terminating.
+func (component *component) IsTerminating() bool {
+	return component.getState() == componentStateTerminating
+}
+
+// SetTerminationNotificationListener sets the listener notified when the component is terminated unexpectedly.
+func (component *component) SetTerminationNotificationListener(listener solace.TerminationNotificationListener) {
+	component.lock.Lock()
+	defer component.lock.Unlock()
+	component.terminationListener = listener
+}
+
+func (component *component) getState() componentState {
+	component.lock.Lock()
+	defer component.lock.Unlock()
+	return component.state
+}
+
+type terminationEvent struct {
+	timestamp time.Time
+	message   string
+	cause     error
+}
+
+// GetTimestamp retrieves the timestamp of the event.
+func (event *terminationEvent) GetTimestamp() time.Time {
+	return event.timestamp
+}
+
+// GetMessage retrieves the event message.
+func (event *terminationEvent) GetMessage() string {
+	return event.message
+}
+
+// GetCause retrieves the cause of the termination, if any.
+func (event *terminationEvent) GetCause() error {
+	return event.cause
+}
+
+// publisherMetrics holds the metrics of a single publisher
+type publisherMetrics struct {
+	values [metrics.PublisherMetricCount]uint64
+}
+
+// GetValue retrieves the value of the given PublisherMetric.
+func (publisherMetrics *publisherMetrics) GetValue(metric metrics.PublisherMetric) uint64 {
+	if metric < 0 || int(metric) >= metrics.PublisherMetricCount {
+		return 0
+	}
+	return atomic.LoadUint64(&publisherMetrics.values[metric])
+}
+
+// Reset resets all publisher metrics.
+func (publisherMetrics *publisherMetrics) Reset() {
+	for i := range publisherMetrics.values {
+		atomic.StoreUint64(&publisherMetrics.values[i], 0)
+	}
+}
+
+func (publisherMetrics *publisherMetrics) increment(metric metrics.PublisherMetric, amount uint64) {
+	atomic.AddUint64(&publisherMetrics.values[metric], amount)
+}
+
+// receiverMetrics holds the metrics of a single receiver
+type receiverMetrics struct {
+	values [metrics.ReceiverMetricCount]uint64
+}
+
+// GetValue retrieves the value of the given ReceiverMetric.
+func (receiverMetrics *receiverMetrics) GetValue(metric metrics.ReceiverMetric) uint64 {
+	if metric < 0 || int(metric) >= metrics.ReceiverMetricCount {
+		return 0
+	}
+	return atomic.LoadUint64(&receiverMetrics.values[metric])
+}
+
+// Reset resets all receiver metrics.
+func (receiverMetrics *receiverMetrics) Reset() {
+	for i := range receiverMetrics.values {
+		atomic.StoreUint64(&receiverMetrics.values[i], 0)
+	}
+}
+
+func (receiverMetrics *receiverMetrics) increment(metric metrics.ReceiverMetric, amount uint64) {
+	atomic.AddUint64(&receiverMetrics.values[metric], amount)
+}
@@ -0,0 +1,109 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"fmt"
+	"sync"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+// basicMessagePublisher implements the state checks and readiness shared by all publishers.
+// Publishers of the in-memory broker never apply back pressure and are always ready.
+type basicMessagePublisher struct {
+	component
+	metrics publisherMetrics
+
+	listenerLock      sync.Mutex
+	readinessListener solace.PublisherReadinessListener
+}
+
+func (publisher *basicMessagePublisher) init(service *MessagingService) {
+	publisher.component.init(service, publisherMessages)
+}
+
+// Metrics returns the metrics of the publisher.
+func (publisher *basicMessagePublisher) Metrics() metrics.PublisherMetrics {
+	return &publisher.metrics
+}
+
+// IsReady checks if the publisher can publish messages.
+func (publisher *basicMessagePublisher) IsReady() bool {
+	return publisher.IsRunning()
+}
+
+// SetPublisherReadinessListener sets the listener notified when NotifyWhenReady is called.
+func (publisher *basicMessagePublisher) SetPublisherReadinessListener(listener solace.PublisherReadinessListener) {
+	publisher.listenerLock.Lock()
+	defer publisher.listenerLock.Unlock()
+	publisher.readinessListener = listener
+}
+
+// NotifyWhenReady notifies the readiness listener immediately as the publisher is never blocked.
+func (publisher *basicMessagePublisher) NotifyWhenReady() {
+	publisher.listenerLock.Lock()
+	listener := publisher.readinessListener
+	publisher.listenerLock.Unlock()
+	if listener != nil && publisher.IsReady() {
+		go listener()
+	}
+}
+
+// checkPublish returns an error if messages cannot be published in the current state
+func (publisher *basicMessagePublisher) checkPublish() error {
+	switch state := publisher.getState(); state {
+	case componentStateStarted:
+		return nil
+	case componentStateTerminating, componentStateTerminated:
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToPublishAlreadyTerminated, nil)
+	default:
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToPublishNotStarted+componentStateNames[state], nil)
+	}
+}
+
+// recordPublish updates the metrics with a published message
+func (publisher *basicMessagePublisher) recordPublish(msg *message.OutboundMessageImpl, serviceMetric metrics.Metric) {
+	publisher.metrics.increment(metrics.PublisherMessagesSent, 1)
+	publisher.metrics.increment(metrics.PublisherBytesSent, message.GetOutboundMessagePayloadSize(msg))
+	publisher.service.metrics.increment(serviceMetric, 1)
+}
+
+// prepareMessage duplicates the given message and applies the additional properties, if any.
+// The published message is routed by the broker and must not be modified after it is published.
+func prepareMessage(msg apimessage.OutboundMessage, properties config.MessagePropertiesConfigurationProvider) (*message.OutboundMessageImpl, error) {
+	msgImpl, ok := msg.(*message.OutboundMessageImpl)
+	if !ok {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.InvalidOutboundMessageType, msg), nil)
+	}
+	msgDup, err := message.DuplicateOutboundMessage(msgImpl)
+	if err != nil {
+		return nil, err
+	}
+	if properties != nil {
+		if err := message.SetProperties(msgDup, properties.GetConfiguration()); err != nil {
+			msgDup.Dispose()
+			return nil, err
+		}
+	}
+	return msgDup, nil
+}
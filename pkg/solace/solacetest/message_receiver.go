@@ -0,0 +1,358 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/codec"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// drainPollInterval is the interval at which a terminating receiver checks if its buffer is drained
+const drainPollInterval = time.Millisecond
+
+// basicMessageReceiver implements the buffering and asynchronous dispatch shared by all receivers
+type basicMessageReceiver struct {
+	component
+	inbox   *inbox
+	metrics receiverMetrics
+	codecs  *codec.Registry
+
+	// stopping is closed when a terminating receiver stops delivering messages
+	stopping chan struct{}
+
+	asyncLock    sync.Mutex
+	asyncHandler func(msg *inboundMessage)
+	asyncRunning bool
+
+	pauseLock sync.Mutex
+	// resumed is nil unless the receiver is paused, it is closed when the receiver is resumed
+	resumed chan struct{}
+}
+
+func (receiver *basicMessageReceiver) init(service *MessagingService, inbox *inbox, codecs []solace.Codec) {
+	receiver.component.init(service, receiverMessages)
+	receiver.inbox = inbox
+	receiver.stopping = make(chan struct{})
+	if len(codecs) > 0 {
+		receiver.codecs = codec.NewRegistry(codecs...)
+	}
+}
+
+// Metrics returns the metrics of the receiver.
+func (receiver *basicMessageReceiver) Metrics() metrics.ReceiverMetrics {
+	return &receiver.metrics
+}
+
+// DecodePayload decodes the payload of the given message into value with the codec matching
+// the HTTP content type or application message type of the message.
+func (receiver *basicMessageReceiver) DecodePayload(msg apimessage.InboundMessage, value interface{}) error {
+	if receiver.codecs != nil {
+		if matched, ok := receiver.codecs.ForMessage(msg); ok {
+			return matched.Decode(msg, value)
+		}
+	}
+	return codec.Decode(msg, value)
+}
+
+// recordReceive updates the metrics with a message buffered for the application
+func (receiver *basicMessageReceiver) recordReceive(msg *inboundMessage, serviceMetric metrics.Metric) {
+	receiver.metrics.increment(metrics.ReceiverMessagesReceived, 1)
+	receiver.metrics.increment(metrics.ReceiverBytesReceived, message.GetInboundMessagePayloadSize(msg.InboundMessageImpl))
+	receiver.service.metrics.increment(serviceMetric, 1)
+}
+
+// setAsyncHandler registers the handler of asynchronously dispatched messages, and starts
+// dispatching if the receiver is started
+func (receiver *basicMessageReceiver) setAsyncHandler(handler func(msg *inboundMessage)) error {
+	state := receiver.getState()
+	if state == componentStateTerminating || state == componentStateTerminated {
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToRegisterCallbackReceiverTerminating, nil)
+	}
+	receiver.asyncLock.Lock()
+	defer receiver.asyncLock.Unlock()
+	receiver.asyncHandler = handler
+	if state == componentStateStarted && !receiver.asyncRunning {
+		receiver.asyncRunning = true
+		go receiver.dispatch()
+	}
+	return nil
+}
+
+// startDispatch starts dispatching messages if a handler is registered, called when the receiver starts
+func (receiver *basicMessageReceiver) startDispatch() {
+	receiver.asyncLock.Lock()
+	defer receiver.asyncLock.Unlock()
+	if receiver.asyncHandler != nil && !receiver.asyncRunning {
+		receiver.asyncRunning = true
+		go receiver.dispatch()
+	}
+}
+
+func (receiver *basicMessageReceiver) dispatch() {
+	for {
+		if !receiver.awaitResumed() {
+			return
+		}
+		msg, err := receiver.inbox.receive(context.Background(), nil, receiver.stopping)
+		if err != nil {
+			return
+		}
+		receiver.asyncLock.Lock()
+		handler := receiver.asyncHandler
+		receiver.asyncLock.Unlock()
+		handler(msg)
+	}
+}
+
+// checkReceive returns an error if messages cannot be received synchronously in the current state
+func (receiver *basicMessageReceiver) checkReceive() error {
+	switch receiver.getState() {
+	case componentStateNotStarted:
+		return solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveNotStarted, nil)
+	case componentStateTerminated:
+		return solace.NewError(&solace.IllegalStateError{}, constants.ReceiverCannotReceiveAlreadyTerminated, nil)
+	}
+	return nil
+}
+
+// pause stops the asynchronous dispatch of messages until resume is called
+func (receiver *basicMessageReceiver) pause() {
+	receiver.pauseLock.Lock()
+	defer receiver.pauseLock.Unlock()
+	if receiver.resumed == nil {
+		receiver.resumed = make(chan struct{})
+	}
+}
+
+func (receiver *basicMessageReceiver) resume() {
+	receiver.pauseLock.Lock()
+	defer receiver.pauseLock.Unlock()
+	if receiver.resumed != nil {
+		close(receiver.resumed)
+		receiver.resumed = nil
+	}
+}
+
+func (receiver *basicMessageReceiver) isPaused() bool {
+	receiver.pauseLock.Lock()
+	defer receiver.pauseLock.Unlock()
+	return receiver.resumed != nil
+}
+
+// awaitResumed waits until the receiver is not paused, returns false if the receiver stops first
+func (receiver *basicMessageReceiver) awaitResumed() bool {
+	receiver.pauseLock.Lock()
+	resumed := receiver.resumed
+	receiver.pauseLock.Unlock()
+	if resumed == nil {
+		return true
+	}
+	select {
+	case <-resumed:
+		return true
+	case <-receiver.stopping:
+		return false
+	}
+}
+
+// drain waits for the buffered messages to be received for at most the grace period, then stops
+// delivery and discards the remaining messages. Returns the discarded messages.
+func (receiver *basicMessageReceiver) drain(ctx context.Context) []*inboundMessage {
+	for ctx.Err() == nil && !receiver.isPaused() {
+		receiver.inbox.lock.Lock()
+		buffered := len(receiver.inbox.messages)
+		receiver.inbox.lock.Unlock()
+		if buffered == 0 {
+			break
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(drainPollInterval):
+		}
+	}
+	close(receiver.stopping)
+	discarded := receiver.inbox.removeIf(func(*inboundMessage) bool { return true })
+	receiver.metrics.increment(metrics.ReceiverMessagesTerminationDiscarded, uint64(len(discarded)))
+	return discarded
+}
+
+// incompleteReceptionError returns the error returned by Terminate when messages were discarded
+func incompleteReceptionError(discarded int) error {
+	if discarded == 0 {
+		return nil
+	}
+	return solace.NewError(&solace.IncompleteMessageDeliveryError{}, fmt.Sprintf(constants.IncompleteMessageReceptionMessage, discarded), nil)
+}
+
+// directReceiverCore implements the topic subscriptions of direct and request-reply receivers
+type directReceiverCore struct {
+	basicMessageReceiver
+	shareName string
+
+	subscriptionLock sync.Mutex
+	subscriptions    []string
+}
+
+func (receiver *directReceiverCore) init(service *MessagingService, subscriptions []string, shareName string, inbox *inbox, codecs []solace.Codec) {
+	receiver.basicMessageReceiver.init(service, inbox, codecs)
+	receiver.subscriptions = subscriptions
+	receiver.shareName = shareName
+	receiver.onStart = receiver.start
+	receiver.onTerminate = receiver.terminate
+}
+
+func (receiver *directReceiverCore) start() error {
+	receiver.subscriptionLock.Lock()
+	for _, subscription := range receiver.subscriptions {
+		receiver.service.broker.subscribe(receiver, subscription, receiver.shareName)
+	}
+	receiver.subscriptionLock.Unlock()
+	receiver.startDispatch()
+	return nil
+}
+
+func (receiver *directReceiverCore) terminate(ctx context.Context) error {
+	receiver.service.broker.unsubscribeAll(receiver)
+	return incompleteReceptionError(len(receiver.drain(ctx)))
+}
+
+// deliverDirect buffers a message matching one of the subscriptions
+func (receiver *directReceiverCore) deliverDirect(msg *message.OutboundMessageImpl) {
+	receiver.deliverRouted(msg, nil)
+}
+
+// deliverRouted buffers a message matching the subscription of the given route, or one of the subscriptions if nil
+func (receiver *directReceiverCore) deliverRouted(msg *message.OutboundMessageImpl, route *topicRoute) {
+	if !receiver.IsRunning() {
+		return
+	}
+	var delivered *inboundMessage
+	pushed := receiver.inbox.push(func(discard bool) (*inboundMessage, error) {
+		inbound, err := newInboundMessage(msg, discard)
+		if inbound != nil {
+			inbound.route = route
+		}
+		delivered = inbound
+		return inbound, err
+	})
+	if !pushed {
+		receiver.metrics.increment(metrics.ReceiverMessagesBackpressureDiscarded, 1)
+		receiver.service.metrics.increment(metrics.InternalDiscardNotifications, 1)
+		return
+	}
+	receiver.recordReceive(delivered, metrics.DirectMessagesReceived)
+}
+
+// AddSubscription adds a topic subscription to the receiver.
+func (receiver *directReceiverCore) AddSubscription(subscription resource.Subscription) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	receiver.subscriptionLock.Lock()
+	defer receiver.subscriptionLock.Unlock()
+	for _, existing := range receiver.subscriptions {
+		if existing == subscription.GetName() {
+			return nil
+		}
+	}
+	receiver.subscriptions = append(receiver.subscriptions, subscription.GetName())
+	receiver.service.broker.subscribe(receiver, subscription.GetName(), receiver.shareName)
+	return nil
+}
+
+// RemoveSubscription removes a topic subscription from the receiver.
+func (receiver *directReceiverCore) RemoveSubscription(subscription resource.Subscription) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	receiver.subscriptionLock.Lock()
+	defer receiver.subscriptionLock.Unlock()
+	for i, existing := range receiver.subscriptions {
+		if existing == subscription.GetName() {
+			receiver.subscriptions = append(receiver.subscriptions[:i], receiver.subscriptions[i+1:]...)
+			break
+		}
+	}
+	receiver.service.broker.unsubscribe(receiver, subscription.GetName(), receiver.shareName)
+	return nil
+}
+
+// AddSubscriptionAsync adds a topic subscription to the receiver, notifying the listener when done.
+func (receiver *directReceiverCore) AddSubscriptionAsync(subscription resource.Subscription, listener solace.SubscriptionChangeListener) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	go func() {
+		err := receiver.AddSubscription(subscription)
+		if listener != nil {
+			listener(subscription, solace.SubscriptionAdded, err)
+		}
+	}()
+	return nil
+}
+
+// RemoveSubscriptionAsync removes a topic subscription from the receiver, notifying the listener when done.
+func (receiver *directReceiverCore) RemoveSubscriptionAsync(subscription resource.Subscription, listener solace.SubscriptionChangeListener) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	go func() {
+		err := receiver.RemoveSubscription(subscription)
+		if listener != nil {
+			listener(subscription, solace.SubscriptionRemoved, err)
+		}
+	}()
+	return nil
+}
+
+func (receiver *directReceiverCore) checkSubscription(subscription resource.Subscription) error {
+	if state := receiver.getState(); state != componentStateStarted {
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, componentStateNames[state]), nil)
+	}
+	if _, ok := subscription.(*resource.TopicSubscription); !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.DirectReceiverUnsupportedSubscriptionType, subscription), nil)
+	}
+	if err := resource.ValidateTopicSubscription(subscription.GetName()); err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+	}
+	return nil
+}
+
+// toSubscriptionNames returns the names of the given topic subscriptions, or an error if a subscription is not a valid topic subscription
+func toSubscriptionNames(subscriptions []resource.Subscription, unsupportedTypeMessage string) ([]string, error) {
+	names := make([]string, len(subscriptions))
+	for i, subscription := range subscriptions {
+		if _, ok := subscription.(*resource.TopicSubscription); !ok {
+			return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(unsupportedTypeMessage, subscription), nil)
+		}
+		if err := resource.ValidateTopicSubscription(subscription.GetName()); err != nil {
+			return nil, solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+		}
+		names[i] = subscription.GetName()
+	}
+	return names, nil
+}
@@ -0,0 +1,523 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/core"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+)
+
+// brokerURI is reported as the broker URI of all service events
+const brokerURI = "solacetest://broker"
+
+type serviceState int
+
+const (
+	serviceStateNotConnected serviceState = iota
+	serviceStateConnected
+	serviceStateDisconnected
+)
+
+var serviceStateNames = map[serviceState]string{
+	serviceStateNotConnected: "NotConnected",
+	serviceStateConnected:    "Connected",
+	serviceStateDisconnected: "Disconnected",
+}
+
+// serviceCount is used to generate unique application IDs
+var serviceCount uint64
+
+// MessagingService is an in-memory solace.MessagingService connected to a Broker.
+// It supports direct, persistent and request-reply publishers and receivers, and queue provisioning.
+// Queue browsers, transactional messaging services, topic endpoints and cache requests are not supported.
+//
+// Service events can be simulated with SimulateReconnectionAttempt, SimulateReconnection and
+// SimulateServiceInterruption. Listeners are notified before these functions return.
+type MessagingService struct {
+	broker        *Broker
+	applicationID string
+	metrics       apiMetrics
+
+	lock                         sync.Mutex
+	state                        serviceState
+	components                   map[*component]struct{}
+	nextListenerID               uint64
+	reconnectionListeners        map[uint64]solace.ReconnectionListener
+	reconnectionAttemptListeners map[uint64]solace.ReconnectionAttemptListener
+	interruptionListeners        map[uint64]solace.ServiceInterruptionListener
+}
+
+// NewMessagingService creates a new messaging service connected to a new Broker.
+// The service must be connected before publishers and receivers can be started.
+func NewMessagingService() *MessagingService {
+	return NewBroker().NewMessagingService()
+}
+
+// NewMessagingService creates a new messaging service connected to the broker. Messages published
+// with the service are delivered to the receivers of all services created from the same broker.
+func (broker *Broker) NewMessagingService() *MessagingService {
+	return &MessagingService{
+		broker:                       broker,
+		applicationID:                fmt.Sprintf("solacetest/%d", atomic.AddUint64(&serviceCount, 1)),
+		components:                   make(map[*component]struct{}),
+		reconnectionListeners:        make(map[uint64]solace.ReconnectionListener),
+		reconnectionAttemptListeners: make(map[uint64]solace.ReconnectionAttemptListener),
+		interruptionListeners:        make(map[uint64]solace.ServiceInterruptionListener),
+	}
+}
+
+// Broker returns the broker the messaging service is connected to.
+func (service *MessagingService) Broker() *Broker {
+	return service.broker
+}
+
+// Connect connects the messaging service.
+// Returns solace/errors.*IllegalStateError if the service was disconnected.
+func (service *MessagingService) Connect() error {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	switch service.state {
+	case serviceStateConnected:
+		return nil
+	case serviceStateDisconnected:
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToConnectAlreadyDisconnectedService, serviceStateNames[service.state]), nil)
+	}
+	service.state = serviceStateConnected
+	service.metrics.increment(metrics.ConnectionAttempts, 1)
+	return nil
+}
+
+// ConnectWithContext connects the messaging service, see Connect.
+func (service *MessagingService) ConnectWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return service.Connect()
+}
+
+// ConnectAsync connects the messaging service asynchronously.
+func (service *MessagingService) ConnectAsync() <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- service.Connect()
+	}()
+	return result
+}
+
+// ConnectAsyncWithCallback connects the messaging service asynchronously, calling the callback when done.
+func (service *MessagingService) ConnectAsyncWithCallback(callback func(solace.MessagingService, error)) {
+	go func() {
+		callback(service, service.Connect())
+	}()
+}
+
+// Disconnect disconnects the messaging service, terminating all of its publishers and receivers.
+func (service *MessagingService) Disconnect() error {
+	service.lock.Lock()
+	if service.state == serviceStateNotConnected {
+		service.lock.Unlock()
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToDisconnectUnstartedService, serviceStateNames[service.state]), nil)
+	}
+	service.state = serviceStateDisconnected
+	components := service.takeComponents()
+	service.lock.Unlock()
+	for _, component := range components {
+		component.shutdown(constants.TerminatedOnMessagingServiceShutdown, nil)
+	}
+	return nil
+}
+
+// DisconnectWithContext disconnects the messaging service unless the context is already done.
+func (service *MessagingService) DisconnectWithContext(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return service.Disconnect()
+}
+
+// DisconnectAsync disconnects the messaging service asynchronously.
+func (service *MessagingService) DisconnectAsync() <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- service.Disconnect()
+	}()
+	return result
+}
+
+// DisconnectAsyncWithCallback disconnects the messaging service asynchronously, calling the callback when done.
+func (service *MessagingService) DisconnectAsyncWithCallback(callback func(error)) {
+	go func() {
+		callback(service.Disconnect())
+	}()
+}
+
+// IsConnected returns true if the messaging service is connected.
+func (service *MessagingService) IsConnected() bool {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	return service.state == serviceStateConnected
+}
+
+// SimulateReconnectionAttempt notifies the reconnection attempt listeners as if the connection was lost with the given cause.
+func (service *MessagingService) SimulateReconnectionAttempt(cause error) {
+	service.lock.Lock()
+	listeners := make([]solace.ReconnectionAttemptListener, 0, len(service.reconnectionAttemptListeners))
+	for _, listener := range service.reconnectionAttemptListeners {
+		listeners = append(listeners, listener)
+	}
+	service.lock.Unlock()
+	event := newServiceEvent("reconnecting", cause)
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// SimulateReconnection notifies the reconnection listeners as if the service reconnected.
+// All messages delivered to persistent receivers and not yet settled are redelivered.
+func (service *MessagingService) SimulateReconnection() {
+	service.lock.Lock()
+	components := make([]*component, 0, len(service.components))
+	for component := range service.components {
+		components = append(components, component)
+	}
+	listeners := make([]solace.ReconnectionListener, 0, len(service.reconnectionListeners))
+	for _, listener := range service.reconnectionListeners {
+		listeners = append(listeners, listener)
+	}
+	service.lock.Unlock()
+	for _, component := range components {
+		if component.onReconnect != nil {
+			component.onReconnect()
+		}
+	}
+	event := newServiceEvent("reconnected", nil)
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// SimulateServiceInterruption disconnects the service as if the connection was lost with the given cause.
+// All publishers and receivers are terminated and notify their termination listeners, then the
+// service interruption listeners are notified.
+func (service *MessagingService) SimulateServiceInterruption(cause error) {
+	service.lock.Lock()
+	if service.state != serviceStateConnected {
+		service.lock.Unlock()
+		return
+	}
+	service.state = serviceStateDisconnected
+	components := service.takeComponents()
+	listeners := make([]solace.ServiceInterruptionListener, 0, len(service.interruptionListeners))
+	for _, listener := range service.interruptionListeners {
+		listeners = append(listeners, listener)
+	}
+	service.lock.Unlock()
+	for _, component := range components {
+		component.shutdown(constants.TerminatedOnMessagingServiceShutdown, cause)
+	}
+	event := newServiceEvent("service interrupted", cause)
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// register adds a starting component, returns false if the service is not connected
+func (service *MessagingService) register(component *component) bool {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	if service.state != serviceStateConnected {
+		return false
+	}
+	service.components[component] = struct{}{}
+	return true
+}
+
+func (service *MessagingService) unregister(component *component) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	delete(service.components, component)
+}
+
+// takeComponents removes all components from the service, the service lock must be held
+func (service *MessagingService) takeComponents() []*component {
+	components := make([]*component, 0, len(service.components))
+	for component := range service.components {
+		components = append(components, component)
+		delete(service.components, component)
+	}
+	return components
+}
+
+// CreateDirectMessagePublisherBuilder creates a builder for direct message publishers.
+func (service *MessagingService) CreateDirectMessagePublisherBuilder() solace.DirectMessagePublisherBuilder {
+	return &directMessagePublisherBuilderImpl{service: service}
+}
+
+// CreateDirectMessageReceiverBuilder creates a builder for direct message receivers.
+func (service *MessagingService) CreateDirectMessageReceiverBuilder() solace.DirectMessageReceiverBuilder {
+	return newDirectMessageReceiverBuilder(service)
+}
+
+// CreatePersistentMessagePublisherBuilder creates a builder for persistent message publishers.
+func (service *MessagingService) CreatePersistentMessagePublisherBuilder() solace.PersistentMessagePublisherBuilder {
+	return &persistentMessagePublisherBuilderImpl{service: service}
+}
+
+// CreatePersistentMessageReceiverBuilder creates a builder for persistent message receivers.
+func (service *MessagingService) CreatePersistentMessageReceiverBuilder() solace.PersistentMessageReceiverBuilder {
+	return newPersistentMessageReceiverBuilder(service)
+}
+
+// CreateQueueBrowserBuilder creates a builder for queue browsers. Queue browsers are not supported,
+// the builder fails to build.
+func (service *MessagingService) CreateQueueBrowserBuilder() solace.QueueBrowserBuilder {
+	return &queueBrowserBuilderImpl{}
+}
+
+// CreateTransactionalMessagingServiceBuilder creates a builder for transactional messaging services.
+// Transactions are not supported, the builder fails to build.
+func (service *MessagingService) CreateTransactionalMessagingServiceBuilder() solace.TransactionalMessagingServiceBuilder {
+	return &transactionalMessagingServiceBuilderImpl{}
+}
+
+// MessageBuilder creates a builder for outbound messages.
+func (service *MessagingService) MessageBuilder() solace.OutboundMessageBuilder {
+	return message.NewOutboundMessageBuilder()
+}
+
+// EndpointProvisioner creates a provisioner for queues on the broker.
+func (service *MessagingService) EndpointProvisioner() solace.EndpointProvisioner {
+	return newEndpointProvisioner(service)
+}
+
+// RequestReply returns the builders for request-reply publishers and receivers.
+func (service *MessagingService) RequestReply() solace.RequestReplyMessagingService {
+	return &requestReplyService{service: service}
+}
+
+// AddReconnectionListener adds a listener notified by SimulateReconnection.
+func (service *MessagingService) AddReconnectionListener(listener solace.ReconnectionListener) uint64 {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	service.nextListenerID++
+	service.reconnectionListeners[service.nextListenerID] = listener
+	return service.nextListenerID
+}
+
+// AddReconnectionAttemptListener adds a listener notified by SimulateReconnectionAttempt.
+func (service *MessagingService) AddReconnectionAttemptListener(listener solace.ReconnectionAttemptListener) uint64 {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	service.nextListenerID++
+	service.reconnectionAttemptListeners[service.nextListenerID] = listener
+	return service.nextListenerID
+}
+
+// RemoveReconnectionListener removes a listener added with AddReconnectionListener.
+func (service *MessagingService) RemoveReconnectionListener(listenerID uint64) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	delete(service.reconnectionListeners, listenerID)
+}
+
+// RemoveReconnectionAttemptListener removes a listener added with AddReconnectionAttemptListener.
+func (service *MessagingService) RemoveReconnectionAttemptListener(listenerID uint64) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	delete(service.reconnectionAttemptListeners, listenerID)
+}
+
+// AddServiceInterruptionListener adds a listener notified by SimulateServiceInterruption.
+func (service *MessagingService) AddServiceInterruptionListener(listener solace.ServiceInterruptionListener) uint64 {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	service.nextListenerID++
+	service.interruptionListeners[service.nextListenerID] = listener
+	return service.nextListenerID
+}
+
+// RemoveServiceInterruptionListener removes a listener added with AddServiceInterruptionListener.
+func (service *MessagingService) RemoveServiceInterruptionListener(listenerID uint64) {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	delete(service.interruptionListeners, listenerID)
+}
+
+// GetApplicationID returns the unique application ID generated for the service.
+func (service *MessagingService) GetApplicationID() string {
+	return service.applicationID
+}
+
+// GetClientName returns the client name of the service, which is its generated application ID.
+func (service *MessagingService) GetClientName() string {
+	return service.applicationID
+}
+
+// GetWebTransportProtocolInUse always returns an empty string as the service is not connected over a web transport.
+func (service *MessagingService) GetWebTransportProtocolInUse() config.WebTransportProtocol {
+	return ""
+}
+
+// Metrics returns the metrics of the service. Only the message and byte counts of
+// direct and persistent messages and the connection attempts are maintained.
+func (service *MessagingService) Metrics() metrics.APIMetrics {
+	return &service.metrics
+}
+
+// LatencyMetrics returns the latency metrics of the service, which are never recorded.
+func (service *MessagingService) LatencyMetrics() metrics.LatencyMetrics {
+	return latencyMetrics{}
+}
+
+// Info returns the API information of the service.
+func (service *MessagingService) Info() metrics.APIInfo {
+	version, buildDate, variant := core.GetVersion()
+	return &apiInfo{version: version, buildDate: buildDate, vendor: variant, userID: service.applicationID}
+}
+
+// UpdateProperty accepts and ignores any property while the service is not disconnected.
+func (service *MessagingService) UpdateProperty(property config.ServiceProperty, value interface{}) error {
+	service.lock.Lock()
+	defer service.lock.Unlock()
+	if service.state == serviceStateDisconnected {
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToModifyPropertyOfDisconnectedService, nil)
+	}
+	return nil
+}
+
+func (service *MessagingService) String() string {
+	return fmt.Sprintf("solacetest.MessagingService at %p", service)
+}
+
+type requestReplyService struct {
+	service *MessagingService
+}
+
+// CreateRequestReplyMessagePublisherBuilder creates a builder for request-reply message publishers.
+func (requestReply *requestReplyService) CreateRequestReplyMessagePublisherBuilder() solace.RequestReplyMessagePublisherBuilder {
+	return &requestReplyMessagePublisherBuilderImpl{service: requestReply.service}
+}
+
+// CreateRequestReplyMessageReceiverBuilder creates a builder for request-reply message receivers.
+func (requestReply *requestReplyService) CreateRequestReplyMessageReceiverBuilder() solace.RequestReplyMessageReceiverBuilder {
+	return newRequestReplyMessageReceiverBuilder(requestReply.service)
+}
+
+type serviceEvent struct {
+	timestamp time.Time
+	message   string
+	cause     error
+}
+
+func newServiceEvent(message string, cause error) *serviceEvent {
+	return &serviceEvent{timestamp: time.Now(), message: message, cause: cause}
+}
+
+// GetTimestamp retrieves the timestamp of the event.
+func (event *serviceEvent) GetTimestamp() time.Time {
+	return event.timestamp
+}
+
+// GetBrokerURI retrieves the URI of the broker.
+func (event *serviceEvent) GetBrokerURI() string {
+	return brokerURI
+}
+
+// GetMessage retrieves the event message.
+func (event *serviceEvent) GetMessage() string {
+	return event.message
+}
+
+// GetCause retrieves the cause of the event, if any.
+func (event *serviceEvent) GetCause() error {
+	return event.cause
+}
+
+// apiMetrics holds the metrics of a messaging service
+type apiMetrics struct {
+	values     [metrics.MetricCount]uint64
+	resetCount uint64
+}
+
+// GetValue retrieves the value of the given Metric.
+func (apiMetrics *apiMetrics) GetValue(metric metrics.Metric) uint64 {
+	if metric < 0 || int(metric) >= metrics.MetricCount {
+		return 0
+	}
+	return atomic.LoadUint64(&apiMetrics.values[metric])
+}
+
+// Reset resets all metrics.
+func (apiMetrics *apiMetrics) Reset() {
+	for i := range apiMetrics.values {
+		atomic.StoreUint64(&apiMetrics.values[i], 0)
+	}
+	atomic.AddUint64(&apiMetrics.resetCount, 1)
+}
+
+// GetResetCount retrieves the number of times the metrics were reset.
+func (apiMetrics *apiMetrics) GetResetCount() uint64 {
+	return atomic.LoadUint64(&apiMetrics.resetCount)
+}
+
+func (apiMetrics *apiMetrics) increment(metric metrics.Metric, amount uint64) {
+	atomic.AddUint64(&apiMetrics.values[metric], amount)
+}
+
+type latencyMetrics struct{}
+
+// GetSnapshot returns an empty snapshot.
+func (latencyMetrics) GetSnapshot(metric metrics.LatencyMetric) metrics.LatencySnapshot {
+	return metrics.LatencySnapshot{}
+}
+
+// Reset does nothing.
+func (latencyMetrics) Reset() {}
+
+type apiInfo struct {
+	buildDate, version, userID, vendor string
+}
+
+// GetAPIBuildDate returns the build date of the native library.
+func (info *apiInfo) GetAPIBuildDate() string {
+	return info.buildDate
+}
+
+// GetAPIVersion returns the version of the native library.
+func (info *apiInfo) GetAPIVersion() string {
+	return info.version
+}
+
+// GetAPIUserID returns the application ID of the service.
+func (info *apiInfo) GetAPIUserID() string {
+	return info.userID
+}
+
+// GetAPIImplementationVendor returns the variant of the native library.
+func (info *apiInfo) GetAPIImplementationVendor() string {
+	return info.vendor
+}
@@ -0,0 +1,803 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/executor"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/internal/impl/validation"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+type persistentMessagePublisherImpl struct {
+	basicMessagePublisher
+	receiptListener solace.MessagePublishReceiptListener
+	// receipts delivers the publish receipts to the listener in publish order
+	receipts executor.Executor
+}
+
+func (publisher *persistentMessagePublisherImpl) start() error {
+	go publisher.receipts.Run()
+	return nil
+}
+
+func (publisher *persistentMessagePublisherImpl) terminate(ctx context.Context) error {
+	publisher.receipts.AwaitTermination()
+	return nil
+}
+
+// StartAsyncCallback starts the publisher asynchronously, calling the callback when started.
+func (publisher *persistentMessagePublisherImpl) StartAsyncCallback(callback func(solace.PersistentMessagePublisher, error)) {
+	go func() {
+		callback(publisher, publisher.Start())
+	}()
+}
+
+// TerminateAsyncCallback terminates the publisher asynchronously, calling the callback when terminated.
+func (publisher *persistentMessagePublisherImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(publisher.Terminate(gracePeriod))
+	}()
+}
+
+// SetMessagePublishReceiptListener sets the listener notified of the outcome of each message published with Publish.
+func (publisher *persistentMessagePublisherImpl) SetMessagePublishReceiptListener(listener solace.MessagePublishReceiptListener) {
+	publisher.listenerLock.Lock()
+	defer publisher.listenerLock.Unlock()
+	publisher.receiptListener = listener
+}
+
+// GetMessagePublishReceiptListener returns the listener set with SetMessagePublishReceiptListener.
+func (publisher *persistentMessagePublisherImpl) GetMessagePublishReceiptListener() solace.MessagePublishReceiptListener {
+	publisher.listenerLock.Lock()
+	defer publisher.listenerLock.Unlock()
+	return publisher.receiptListener
+}
+
+// PublishBytes publishes a message with a byte array payload to the given destination.
+func (publisher *persistentMessagePublisherImpl) PublishBytes(bytes []byte, destination resource.Destination) error {
+	msg, err := publisher.service.MessageBuilder().BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(msg, destination, nil, nil)
+}
+
+// PublishString publishes a message with a string payload to the given destination.
+func (publisher *persistentMessagePublisherImpl) PublishString(str string, destination resource.Destination) error {
+	msg, err := publisher.service.MessageBuilder().BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(msg, destination, nil, nil)
+}
+
+// Publish publishes the message to the given destination, the receipt listener is notified with
+// the given context once the message is spooled.
+func (publisher *persistentMessagePublisherImpl) Publish(msg apimessage.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider, context interface{}) error {
+	msgDup, err := publisher.prepare(msg, destination, properties)
+	if err != nil {
+		return err
+	}
+	publisher.submitReceipt(msgDup, context, publisher.route(msgDup, destination))
+	return nil
+}
+
+// PublishBatch publishes the messages in order to a single destination or to one destination per message.
+func (publisher *persistentMessagePublisherImpl) PublishBatch(msgs []apimessage.OutboundMessage, destinations ...resource.Destination) error {
+	if err := publisher.checkPublish(); err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	if len(destinations) != 1 && len(destinations) != len(msgs) {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PublishBatchDestinationMismatch, len(destinations), len(msgs)), nil)
+	}
+	batch := make([]*message.OutboundMessageImpl, len(msgs))
+	for i, msg := range msgs {
+		msgDup, err := publisher.prepare(msg, batchDestination(destinations, i), nil)
+		if err != nil {
+			return err
+		}
+		batch[i] = msgDup
+	}
+	for i, msgDup := range batch {
+		destination := batchDestination(destinations, i)
+		publisher.submitReceipt(msgDup, nil, publisher.route(msgDup, destination))
+	}
+	return nil
+}
+
+// submitReceipt delivers the publish receipt of the message to the listener
+func (publisher *persistentMessagePublisherImpl) submitReceipt(msg *message.OutboundMessageImpl, context interface{}, err error) {
+	receipt := &publishReceipt{
+		userContext: context,
+		timestamp:   time.Now(),
+		message:     msg,
+		err:         err,
+	}
+	publisher.receipts.Submit(func() {
+		publisher.listenerLock.Lock()
+		listener := publisher.receiptListener
+		publisher.listenerLock.Unlock()
+		if listener != nil {
+			listener(receipt)
+		}
+	})
+}
+
+// batchDestination returns the destination of the message at the given index of a batch
+func batchDestination(destinations []resource.Destination, index int) resource.Destination {
+	if len(destinations) == 1 {
+		return destinations[0]
+	}
+	return destinations[index]
+}
+
+// PublishAwaitAcknowledgement publishes the message to the given destination and waits until it is spooled.
+func (publisher *persistentMessagePublisherImpl) PublishAwaitAcknowledgement(msg apimessage.OutboundMessage, destination resource.Destination, timeout time.Duration, properties config.MessagePropertiesConfigurationProvider) error {
+	msgDup, err := publisher.prepare(msg, destination, properties)
+	if err != nil {
+		return err
+	}
+	return publisher.route(msgDup, destination)
+}
+
+// PublishAwaitAcknowledgementWithContext publishes the message to the given destination and waits until it is spooled.
+func (publisher *persistentMessagePublisherImpl) PublishAwaitAcknowledgementWithContext(ctx context.Context, msg apimessage.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return publisher.PublishAwaitAcknowledgement(msg, destination, -1, properties)
+}
+
+// prepare validates the destination and returns a persistent copy of the message addressed to it
+func (publisher *persistentMessagePublisherImpl) prepare(msg apimessage.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider) (*message.OutboundMessageImpl, error) {
+	if err := publisher.checkPublish(); err != nil {
+		return nil, err
+	}
+	msgDup, err := prepareMessage(msg, properties)
+	if err != nil {
+		return nil, err
+	}
+	if err := message.SetDeliveryMode(msgDup, message.DeliveryModePersistent); err != nil {
+		return nil, err
+	}
+	switch dest := destination.(type) {
+	case *resource.Topic:
+		err = message.SetDestination(msgDup, dest.GetName())
+	case *resource.Queue:
+		if dest.GetName() == "" {
+			return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentPublisherAnonymousQueueDestination, nil)
+		}
+		err = message.SetQueueDestination(msgDup, dest.GetName(), dest.IsDurable())
+	case nil:
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentPublisherMissingDestination, nil)
+	default:
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PersistentPublisherUnsupportedDestinationType, destination), nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return msgDup, nil
+}
+
+// route spools the message on the queues matching the destination and updates the metrics
+func (publisher *persistentMessagePublisherImpl) route(msg *message.OutboundMessageImpl, destination resource.Destination) error {
+	publisher.recordPublish(msg, metrics.PersistentMessagesSent)
+	var err error
+	if queue, ok := destination.(*resource.Queue); ok {
+		err = publisher.service.broker.publishToQueue(msg, queue.GetName())
+	} else {
+		publisher.service.broker.publishToTopic(msg, destination.GetName())
+	}
+	if err != nil {
+		publisher.metrics.increment(metrics.PublisherMessagesFailed, 1)
+	} else {
+		publisher.metrics.increment(metrics.PublisherMessagesAcknowledged, 1)
+	}
+	return err
+}
+
+func (publisher *persistentMessagePublisherImpl) String() string {
+	return fmt.Sprintf("solacetest.PersistentMessagePublisher at %p", publisher)
+}
+
+type publishReceipt struct {
+	userContext interface{}
+	timestamp   time.Time
+	message     apimessage.OutboundMessage
+	err         error
+}
+
+// GetUserContext retrieves the context passed to Publish.
+func (receipt *publishReceipt) GetUserContext() interface{} {
+	return receipt.userContext
+}
+
+// GetTimeStamp retrieves the time the message was spooled.
+func (receipt *publishReceipt) GetTimeStamp() time.Time {
+	return receipt.timestamp
+}
+
+// GetMessage retrieves the published message.
+func (receipt *publishReceipt) GetMessage() apimessage.OutboundMessage {
+	return receipt.message
+}
+
+// GetError retrieves the error if the message could not be spooled.
+func (receipt *publishReceipt) GetError() error {
+	return receipt.err
+}
+
+// IsPersisted returns true if the message was spooled.
+func (receipt *publishReceipt) IsPersisted() bool {
+	return receipt.err == nil
+}
+
+type persistentMessagePublisherBuilderImpl struct {
+	service *MessagingService
+}
+
+// Build creates a new persistent message publisher.
+func (builder *persistentMessagePublisherBuilderImpl) Build() (solace.PersistentMessagePublisher, error) {
+	publisher := &persistentMessagePublisherImpl{receipts: executor.NewExecutor()}
+	publisher.init(builder.service)
+	publisher.onStart = publisher.start
+	publisher.onTerminate = publisher.terminate
+	return publisher, nil
+}
+
+// OnBackPressureReject has no effect, publishers of the in-memory broker never apply back pressure.
+func (builder *persistentMessagePublisherBuilderImpl) OnBackPressureReject(bufferSize uint) solace.PersistentMessagePublisherBuilder {
+	return builder
+}
+
+// OnBackPressureWait has no effect, publishers of the in-memory broker never apply back pressure.
+func (builder *persistentMessagePublisherBuilderImpl) OnBackPressureWait(bufferSize uint) solace.PersistentMessagePublisherBuilder {
+	return builder
+}
+
+// FromConfigurationProvider has no effect, publishers of the in-memory broker have no configuration.
+func (builder *persistentMessagePublisherBuilderImpl) FromConfigurationProvider(provider config.PublisherPropertiesConfigurationProvider) solace.PersistentMessagePublisherBuilder {
+	return builder
+}
+
+type persistentMessageReceiverImpl struct {
+	basicMessageReceiver
+	queueResource *resource.Queue
+	create        bool
+	autoAck       bool
+	maxUnacked    int
+	outcomes      map[config.MessageSettlementOutcome]bool
+	subscriptions []string
+
+	// accepting is set while the receiver accepts messages from its queue
+	accepting int32
+	// queue is the queue the receiver is bound to, guarded by the broker lock
+	queue *queue
+}
+
+func (receiver *persistentMessageReceiverImpl) start() error {
+	broker := receiver.service.broker
+	atomic.StoreInt32(&receiver.accepting, 1)
+	queue, err := broker.bindQueue(receiver, receiver.queueResource, receiver.create)
+	if err != nil {
+		atomic.StoreInt32(&receiver.accepting, 0)
+		return err
+	}
+	broker.lock.Lock()
+	receiver.queue = queue
+	for _, subscription := range receiver.subscriptions {
+		queue.addSubscription(subscription)
+	}
+	broker.lock.Unlock()
+	receiver.startDispatch()
+	return nil
+}
+
+func (receiver *persistentMessageReceiverImpl) terminate(ctx context.Context) error {
+	atomic.StoreInt32(&receiver.accepting, 0)
+	discarded := receiver.drain(ctx)
+	broker := receiver.service.broker
+	broker.lock.Lock()
+	queue := receiver.queue
+	broker.lock.Unlock()
+	broker.unbindQueue(receiver, queue)
+	return incompleteReceptionError(len(discarded))
+}
+
+// reconnect returns all unsettled messages to the queue for redelivery, as if the flow was rebound
+func (receiver *persistentMessageReceiverImpl) reconnect() {
+	broker := receiver.service.broker
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	receiver.inbox.clear()
+	if receiver.queue != nil {
+		receiver.queue.release(receiver)
+	}
+}
+
+// canAccept checks if a message can be delivered to the receiver, called with the broker lock held
+func (receiver *persistentMessageReceiverImpl) canAccept(queue *queue) bool {
+	if atomic.LoadInt32(&receiver.accepting) == 0 || receiver.isPaused() {
+		return false
+	}
+	return receiver.maxUnacked <= 0 || queue.outstanding(receiver) < receiver.maxUnacked
+}
+
+// deliverQueued buffers a message delivered by the queue, called with the broker lock held
+func (receiver *persistentMessageReceiverImpl) deliverQueued(entry *queueEntry) {
+	var delivered *inboundMessage
+	receiver.inbox.push(func(discard bool) (*inboundMessage, error) {
+		inbound, err := newInboundMessage(entry.message, false)
+		if err != nil {
+			return nil, err
+		}
+		inbound.redelivered = entry.isRedelivered
+		inbound.entry = entry
+		inbound.delivery = entry.delivery
+		delivered = inbound
+		return inbound, nil
+	})
+	if delivered != nil {
+		receiver.recordReceive(delivered, metrics.PersistentMessagesReceived)
+	}
+}
+
+// StartAsyncCallback starts the receiver asynchronously, calling the callback when started.
+func (receiver *persistentMessageReceiverImpl) StartAsyncCallback(callback func(solace.PersistentMessageReceiver, error)) {
+	go func() {
+		callback(receiver, receiver.Start())
+	}()
+}
+
+// TerminateAsyncCallback terminates the receiver asynchronously, calling the callback when terminated.
+func (receiver *persistentMessageReceiverImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(receiver.Terminate(gracePeriod))
+	}()
+}
+
+// ReceiveAsync registers the callback called with each received message.
+func (receiver *persistentMessageReceiverImpl) ReceiveAsync(callback solace.MessageHandler) error {
+	return receiver.setAsyncHandler(func(msg *inboundMessage) {
+		callback(msg)
+		if receiver.autoAck {
+			receiver.settle(msg, config.PersistentReceiverAcceptedOutcome)
+		}
+	})
+}
+
+// ReceiveMessage receives a message synchronously, waiting for at most the given timeout.
+func (receiver *persistentMessageReceiverImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, error) {
+	if err := receiver.checkReceive(); err != nil {
+		return nil, err
+	}
+	msg, err := receiver.inbox.receiveWithTimeout(timeout, receiver.stopping)
+	if err != nil {
+		return nil, err
+	}
+	if receiver.autoAck {
+		receiver.settle(msg, config.PersistentReceiverAcceptedOutcome)
+	}
+	return msg, nil
+}
+
+// ReceiveMessageWithContext receives a message synchronously, waiting until the context is done.
+func (receiver *persistentMessageReceiverImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, error) {
+	if err := receiver.checkReceive(); err != nil {
+		return nil, err
+	}
+	msg, err := receiver.inbox.receive(ctx, nil, receiver.stopping)
+	if err != nil {
+		return nil, err
+	}
+	if receiver.autoAck {
+		receiver.settle(msg, config.PersistentReceiverAcceptedOutcome)
+	}
+	return msg, nil
+}
+
+// Ack acknowledges the message, removing it from the queue.
+func (receiver *persistentMessageReceiverImpl) Ack(msg apimessage.InboundMessage) error {
+	return receiver.Settle(msg, config.PersistentReceiverAcceptedOutcome)
+}
+
+// Settle settles the message with the given outcome. Accepted and rejected messages are removed from
+// the queue, failed messages are redelivered. The failed and rejected outcomes must be enabled with
+// WithRequiredMessageOutcomeSupport.
+func (receiver *persistentMessageReceiverImpl) Settle(msg apimessage.InboundMessage, outcome config.MessageSettlementOutcome) error {
+	state := receiver.getState()
+	if state != componentStateStarted && state != componentStateTerminating {
+		if state == componentStateTerminated {
+			return solace.NewError(&solace.IllegalStateError{}, constants.UnableToSettleAlreadyTerminated, nil)
+		}
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToSettleNotStarted, nil)
+	}
+	inbound, ok := asInboundMessage(msg)
+	if !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.InvalidInboundMessageType, msg), nil)
+	}
+	if inbound.entry == nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, constants.UnableToRetrieveMessageID, nil)
+	}
+	if outcome != config.PersistentReceiverAcceptedOutcome && !receiver.outcomes[outcome] {
+		return solace.NewError(&solace.IllegalArgumentError{}, constants.InvalidMessageSettlementOutcome, nil)
+	}
+	// messages accepted on an auto-acking receiver were already settled when auto-acknowledged
+	if receiver.autoAck && outcome == config.PersistentReceiverAcceptedOutcome {
+		return nil
+	}
+	receiver.settle(inbound, outcome)
+	return nil
+}
+
+// settle settles the delivery of the message on the queue and updates the metrics
+func (receiver *persistentMessageReceiverImpl) settle(msg *inboundMessage, outcome config.MessageSettlementOutcome) {
+	broker := receiver.service.broker
+	broker.lock.Lock()
+	settled := receiver.queue.settle(receiver, msg.entry, msg.delivery, outcome == config.PersistentReceiverFailedOutcome)
+	broker.lock.Unlock()
+	if !settled {
+		return
+	}
+	switch outcome {
+	case config.PersistentReceiverAcceptedOutcome:
+		receiver.metrics.increment(metrics.ReceiverMessagesAcknowledged, 1)
+	case config.PersistentReceiverFailedOutcome:
+		receiver.metrics.increment(metrics.ReceiverMessagesFailed, 1)
+	case config.PersistentReceiverRejectedOutcome:
+		receiver.metrics.increment(metrics.ReceiverMessagesRejected, 1)
+	}
+	receiver.service.metrics.increment(metrics.PersistentAcknowledgeSent, 1)
+}
+
+// Pause stops the delivery of messages to the receiver until Resume is called.
+func (receiver *persistentMessageReceiverImpl) Pause() error {
+	state := receiver.getState()
+	if state != componentStateStarted && state != componentStateTerminating {
+		return solace.NewError(&solace.IllegalStateError{}, constants.PersistentReceiverCannotPauseBadState, nil)
+	}
+	receiver.pause()
+	return nil
+}
+
+// Resume resumes the delivery of messages to the receiver.
+func (receiver *persistentMessageReceiverImpl) Resume() error {
+	state := receiver.getState()
+	if state != componentStateStarted && state != componentStateTerminating {
+		return solace.NewError(&solace.IllegalStateError{}, constants.PersistentReceiverCannotUnpauseBadState, nil)
+	}
+	receiver.resume()
+	broker := receiver.service.broker
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	if receiver.queue != nil {
+		receiver.queue.dispatch()
+	}
+	return nil
+}
+
+// ReceiverInfo returns the name and durability of the queue the receiver is bound to.
+func (receiver *persistentMessageReceiverImpl) ReceiverInfo() (solace.PersistentReceiverInfo, error) {
+	if receiver.getState() != componentStateStarted {
+		return nil, solace.NewError(&solace.IllegalStateError{}, "cannot access receiver info when not in started state", nil)
+	}
+	broker := receiver.service.broker
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	return &resourceInfo{name: receiver.queue.name, durable: receiver.queue.durable}, nil
+}
+
+// AddSubscription adds a topic subscription to the queue the receiver is bound to.
+func (receiver *persistentMessageReceiverImpl) AddSubscription(subscription resource.Subscription) error {
+	return receiver.updateSubscription(subscription, true)
+}
+
+// RemoveSubscription removes a topic subscription from the queue the receiver is bound to.
+func (receiver *persistentMessageReceiverImpl) RemoveSubscription(subscription resource.Subscription) error {
+	return receiver.updateSubscription(subscription, false)
+}
+
+// AddSubscriptionAsync adds a topic subscription to the queue, notifying the listener when done.
+func (receiver *persistentMessageReceiverImpl) AddSubscriptionAsync(subscription resource.Subscription, listener solace.SubscriptionChangeListener) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	go func() {
+		err := receiver.updateSubscription(subscription, true)
+		if listener != nil {
+			listener(subscription, solace.SubscriptionAdded, err)
+		}
+	}()
+	return nil
+}
+
+// RemoveSubscriptionAsync removes a topic subscription from the queue, notifying the listener when done.
+func (receiver *persistentMessageReceiverImpl) RemoveSubscriptionAsync(subscription resource.Subscription, listener solace.SubscriptionChangeListener) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	go func() {
+		err := receiver.updateSubscription(subscription, false)
+		if listener != nil {
+			listener(subscription, solace.SubscriptionRemoved, err)
+		}
+	}()
+	return nil
+}
+
+func (receiver *persistentMessageReceiverImpl) updateSubscription(subscription resource.Subscription, add bool) error {
+	if err := receiver.checkSubscription(subscription); err != nil {
+		return err
+	}
+	broker := receiver.service.broker
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	if add {
+		receiver.queue.addSubscription(subscription.GetName())
+	} else {
+		receiver.queue.removeSubscription(subscription.GetName())
+	}
+	return nil
+}
+
+func (receiver *persistentMessageReceiverImpl) checkSubscription(subscription resource.Subscription) error {
+	if state := receiver.getState(); state != componentStateStarted {
+		return solace.NewError(&solace.IllegalStateError{}, fmt.Sprintf(constants.UnableToModifySubscriptionBadState, componentStateNames[state]), nil)
+	}
+	if _, ok := subscription.(*resource.TopicSubscription); !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, fmt.Sprintf(constants.PersistentReceiverUnsupportedSubscriptionType, subscription), nil)
+	}
+	if err := resource.ValidateTopicSubscription(subscription.GetName()); err != nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, err.Error(), nil)
+	}
+	return nil
+}
+
+func (receiver *persistentMessageReceiverImpl) String() string {
+	return fmt.Sprintf("solacetest.PersistentMessageReceiver at %p", receiver)
+}
+
+type resourceInfo struct {
+	name    string
+	durable bool
+}
+
+// GetResourceInfo returns the information of the queue.
+func (info *resourceInfo) GetResourceInfo() solace.ResourceInfo {
+	return info
+}
+
+// GetName returns the name of the queue.
+func (info *resourceInfo) GetName() string {
+	return info.name
+}
+
+// IsDurable returns true if the queue is durable.
+func (info *resourceInfo) IsDurable() bool {
+	return info.durable
+}
+
+type persistentMessageReceiverBuilderImpl struct {
+	service       *MessagingService
+	properties    config.ReceiverPropertyMap
+	subscriptions []resource.Subscription
+	codecs        []solace.Codec
+}
+
+func newPersistentMessageReceiverBuilder(service *MessagingService) *persistentMessageReceiverBuilderImpl {
+	return &persistentMessageReceiverBuilderImpl{
+		service: service,
+		properties: config.ReceiverPropertyMap{
+			config.ReceiverPropertyPersistentMessageAckStrategy:              config.PersistentReceiverClientAck,
+			config.ReceiverPropertyPersistentMissingResourceCreationStrategy: config.PersistentReceiverDoNotCreateMissingResources,
+			config.ReceiverPropertyPersistentFlowMaxUnackedMessages:          -1,
+		},
+	}
+}
+
+// Build creates a new persistent message receiver bound to the given queue when started.
+func (builder *persistentMessageReceiverBuilderImpl) Build(queue *resource.Queue) (solace.PersistentMessageReceiver, error) {
+	if queue == nil {
+		return nil, solace.NewError(&solace.IllegalArgumentError{}, constants.PersistentReceiverMissingQueue, nil)
+	}
+	ackStrategy, _, err := validation.StringPropertyValidation(
+		string(config.ReceiverPropertyPersistentMessageAckStrategy),
+		builder.properties[config.ReceiverPropertyPersistentMessageAckStrategy],
+		config.PersistentReceiverAutoAck,
+		config.PersistentReceiverClientAck,
+	)
+	if err != nil {
+		return nil, err
+	}
+	createStrategy, _, err := validation.StringPropertyValidation(
+		string(config.ReceiverPropertyPersistentMissingResourceCreationStrategy),
+		fmt.Sprint(builder.properties[config.ReceiverPropertyPersistentMissingResourceCreationStrategy]),
+		string(config.PersistentReceiverDoNotCreateMissingResources),
+		string(config.PersistentReceiverCreateOnStartMissingResources),
+	)
+	if err != nil {
+		return nil, err
+	}
+	maxUnacked, _, err := validation.IntegerPropertyValidation(
+		string(config.ReceiverPropertyPersistentFlowMaxUnackedMessages),
+		builder.properties[config.ReceiverPropertyPersistentFlowMaxUnackedMessages],
+	)
+	if err != nil {
+		return nil, err
+	}
+	outcomes := make(map[config.MessageSettlementOutcome]bool)
+	if value, ok := builder.properties[config.ReceiverPropertyPersistentMessageRequiredOutcomeSupport]; ok {
+		for _, outcome := range strings.Split(fmt.Sprint(value), ",") {
+			if _, _, err := validation.StringPropertyValidation(
+				string(config.ReceiverPropertyPersistentMessageRequiredOutcomeSupport),
+				outcome,
+				string(config.PersistentReceiverAcceptedOutcome),
+				string(config.PersistentReceiverFailedOutcome),
+				string(config.PersistentReceiverRejectedOutcome),
+			); err != nil {
+				return nil, err
+			}
+			outcomes[config.MessageSettlementOutcome(outcome)] = true
+		}
+	}
+	subscriptions, err := toSubscriptionNames(builder.subscriptions, constants.PersistentReceiverUnsupportedSubscriptionType)
+	if err != nil {
+		return nil, err
+	}
+	receiver := &persistentMessageReceiverImpl{
+		queueResource: queue,
+		create:        createStrategy == string(config.PersistentReceiverCreateOnStartMissingResources),
+		autoAck:       ackStrategy == config.PersistentReceiverAutoAck,
+		maxUnacked:    maxUnacked,
+		outcomes:      outcomes,
+		subscriptions: subscriptions,
+	}
+	receiver.basicMessageReceiver.init(builder.service, newInbox(0, false), builder.codecs)
+	receiver.onStart = receiver.start
+	receiver.onTerminate = receiver.terminate
+	receiver.onReconnect = receiver.reconnect
+	return receiver, nil
+}
+
+// BuildWithTopicEndpoint is not supported by the in-memory broker.
+func (builder *persistentMessageReceiverBuilderImpl) BuildWithTopicEndpoint(topicEndpoint *resource.TopicEndpoint, subscription *resource.TopicSubscription) (solace.PersistentMessageReceiver, error) {
+	return nil, errNotSupported("topic endpoints")
+}
+
+// WithActivationPassivationSupport has no effect, receivers of the in-memory broker are always active.
+func (builder *persistentMessageReceiverBuilderImpl) WithActivationPassivationSupport(listener solace.ReceiverStateChangeListener) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithMessageAutoAcknowledgement acknowledges messages once they are received.
+func (builder *persistentMessageReceiverBuilderImpl) WithMessageAutoAcknowledgement() solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentMessageAckStrategy] = config.PersistentReceiverAutoAck
+	return builder
+}
+
+// WithMessageClientAcknowledgement requires messages to be acknowledged by the application.
+func (builder *persistentMessageReceiverBuilderImpl) WithMessageClientAcknowledgement() solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentMessageAckStrategy] = config.PersistentReceiverClientAck
+	return builder
+}
+
+// WithMessageSelector has no effect, selectors are not supported by the in-memory broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithMessageSelector(filterSelectorExpression string) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithFlowWindowSize has no effect, messages are not windowed by the in-memory broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithFlowWindowSize(windowSize uint) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithMaxUnackedMessages sets the maximum number of messages delivered and not yet settled, -1 for unlimited.
+func (builder *persistentMessageReceiverBuilderImpl) WithMaxUnackedMessages(maxUnackedMessages int) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentFlowMaxUnackedMessages] = maxUnackedMessages
+	return builder
+}
+
+// WithAckThreshold has no effect, acknowledgements are applied immediately by the in-memory broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithAckThreshold(percentage uint) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithAckTimer has no effect, acknowledgements are applied immediately by the in-memory broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithAckTimer(interval time.Duration) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithFlowReconnectAttempts has no effect.
+func (builder *persistentMessageReceiverBuilderImpl) WithFlowReconnectAttempts(attempts int) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithBindTimeout has no effect, binding to the in-memory broker never blocks.
+func (builder *persistentMessageReceiverBuilderImpl) WithBindTimeout(timeout time.Duration) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithMissingResourcesCreationStrategy sets whether a missing durable queue is created when the receiver starts.
+func (builder *persistentMessageReceiverBuilderImpl) WithMissingResourcesCreationStrategy(strategy config.MissingResourcesCreationStrategy) solace.PersistentMessageReceiverBuilder {
+	builder.properties[config.ReceiverPropertyPersistentMissingResourceCreationStrategy] = strategy
+	return builder
+}
+
+// WithMessageReplay has no effect, replay is not supported by the in-memory broker.
+func (builder *persistentMessageReceiverBuilderImpl) WithMessageReplay(strategy config.ReplayStrategy) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithSubscriptions sets the topic subscriptions added to the queue when the receiver starts.
+func (builder *persistentMessageReceiverBuilderImpl) WithSubscriptions(topics ...resource.Subscription) solace.PersistentMessageReceiverBuilder {
+	builder.subscriptions = append(builder.subscriptions, topics...)
+	return builder
+}
+
+// WithCodec registers a codec used by DecodePayload.
+func (builder *persistentMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.PersistentMessageReceiverBuilder {
+	if codec != nil {
+		builder.codecs = append(builder.codecs, codec)
+	}
+	return builder
+}
+
+// WithRequiredMessageOutcomeSupport enables the given settlement outcomes in addition to the accepted outcome.
+func (builder *persistentMessageReceiverBuilderImpl) WithRequiredMessageOutcomeSupport(messageSettlementOutcomes ...config.MessageSettlementOutcome) solace.PersistentMessageReceiverBuilder {
+	outcomes := make([]string, len(messageSettlementOutcomes))
+	for i, outcome := range messageSettlementOutcomes {
+		outcomes[i] = string(outcome)
+	}
+	builder.properties[config.ReceiverPropertyPersistentMessageRequiredOutcomeSupport] = strings.Join(outcomes, ",")
+	return builder
+}
+
+// WithConcurrentDispatch has no effect, the in-memory broker delivers messages to the message handler one at a time.
+func (builder *persistentMessageReceiverBuilderImpl) WithConcurrentDispatch(workers uint, ordering config.OrderingPolicy) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// WithKeyedDispatch has no effect, the in-memory broker delivers messages to the message handler one at a time.
+func (builder *persistentMessageReceiverBuilderImpl) WithKeyedDispatch(workers uint, key solace.MessageKeyFunction) solace.PersistentMessageReceiverBuilder {
+	return builder
+}
+
+// FromConfigurationProvider applies the given receiver properties.
+func (builder *persistentMessageReceiverBuilderImpl) FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) solace.PersistentMessageReceiverBuilder {
+	if provider == nil {
+		return builder
+	}
+	for key, value := range provider.GetConfiguration() {
+		builder.properties[key] = value
+	}
+	return builder
+}
@@ -0,0 +1,304 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/validation"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/resource"
+	"solace.dev/go/messaging/pkg/solace/subcode"
+)
+
+// endpointProvisioner provisions queues on the broker of a messaging service.
+// The durability, exclusivity and maximum redelivery properties are honoured, other properties are ignored.
+type endpointProvisioner struct {
+	service    *MessagingService
+	properties config.EndpointPropertyMap
+}
+
+func newEndpointProvisioner(service *MessagingService) *endpointProvisioner {
+	return &endpointProvisioner{
+		service: service,
+		properties: config.EndpointPropertyMap{
+			config.EndpointPropertyDurable:   true,
+			config.EndpointPropertyExclusive: true,
+		},
+	}
+}
+
+// Provision provisions a queue with the given name and the configured properties.
+func (provisioner *endpointProvisioner) Provision(queueName string, ignoreExists bool) solace.ProvisionOutcome {
+	return provisioner.ProvisionWithContext(context.Background(), queueName, ignoreExists)
+}
+
+// ProvisionWithContext provisions a queue with the given name and the configured properties.
+func (provisioner *endpointProvisioner) ProvisionWithContext(ctx context.Context, queueName string, ignoreExists bool) solace.ProvisionOutcome {
+	if err := ctx.Err(); err != nil {
+		return &provisionOutcome{err: err}
+	}
+	if err := provisioner.checkConnected(); err != nil {
+		return &provisionOutcome{err: err}
+	}
+	durable, _, err := validation.BooleanPropertyValidation(string(config.EndpointPropertyDurable), provisioner.properties[config.EndpointPropertyDurable])
+	if err != nil {
+		return &provisionOutcome{err: err}
+	}
+	exclusive, _, err := validation.BooleanPropertyValidation(string(config.EndpointPropertyExclusive), provisioner.properties[config.EndpointPropertyExclusive])
+	if err != nil {
+		return &provisionOutcome{err: err}
+	}
+	maxRedelivery, _, err := validation.IntegerPropertyValidation(string(config.EndpointPropertyMaxMessageRedelivery), provisioner.properties[config.EndpointPropertyMaxMessageRedelivery])
+	if err != nil {
+		return &provisionOutcome{err: err}
+	}
+	broker := provisioner.service.broker
+	broker.lock.Lock()
+	defer broker.lock.Unlock()
+	if _, ok := broker.queues[queueName]; ok {
+		if ignoreExists {
+			return &provisionOutcome{ok: true}
+		}
+		return &provisionOutcome{err: solace.NewNativeError(fmt.Sprintf("queue '%s' already exists", queueName), subcode.EndpointAlreadyExists)}
+	}
+	queue := broker.newQueue(queueName, durable, exclusive)
+	queue.maxRedelivery = uint(maxRedelivery)
+	return &provisionOutcome{ok: true}
+}
+
+// ProvisionAsync provisions a queue asynchronously.
+func (provisioner *endpointProvisioner) ProvisionAsync(queueName string, ignoreExists bool) <-chan solace.ProvisionOutcome {
+	result := make(chan solace.ProvisionOutcome, 1)
+	go func() {
+		result <- provisioner.Provision(queueName, ignoreExists)
+	}()
+	return result
+}
+
+// ProvisionAsyncWithCallback provisions a queue asynchronously, calling the callback with the outcome.
+func (provisioner *endpointProvisioner) ProvisionAsyncWithCallback(queueName string, ignoreExists bool, callback func(solace.ProvisionOutcome)) {
+	go func() {
+		callback(provisioner.Provision(queueName, ignoreExists))
+	}()
+}
+
+// Deprovision removes the queue with the given name, terminating the receivers bound to it.
+func (provisioner *endpointProvisioner) Deprovision(queueName string, ignoreMissing bool) error {
+	return provisioner.DeprovisionWithContext(context.Background(), queueName, ignoreMissing)
+}
+
+// DeprovisionWithContext removes the queue with the given name, terminating the receivers bound to it.
+func (provisioner *endpointProvisioner) DeprovisionWithContext(ctx context.Context, queueName string, ignoreMissing bool) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := provisioner.checkConnected(); err != nil {
+		return err
+	}
+	err := provisioner.service.broker.DeprovisionQueue(queueName)
+	if err != nil && ignoreMissing {
+		return nil
+	}
+	return err
+}
+
+// DeprovisionAsync removes a queue asynchronously.
+func (provisioner *endpointProvisioner) DeprovisionAsync(queueName string, ignoreMissing bool) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- provisioner.Deprovision(queueName, ignoreMissing)
+	}()
+	return result
+}
+
+// DeprovisionAsyncWithCallback removes a queue asynchronously, calling the callback with the result.
+func (provisioner *endpointProvisioner) DeprovisionAsyncWithCallback(queueName string, ignoreMissing bool, callback func(err error)) {
+	go func() {
+		callback(provisioner.Deprovision(queueName, ignoreMissing))
+	}()
+}
+
+// ProvisionTopicEndpoint is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) ProvisionTopicEndpoint(topicEndpointName string, ignoreExists bool) solace.ProvisionOutcome {
+	return &provisionOutcome{err: errNotSupported("topic endpoints")}
+}
+
+// ProvisionTopicEndpointWithContext is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) ProvisionTopicEndpointWithContext(ctx context.Context, topicEndpointName string, ignoreExists bool) solace.ProvisionOutcome {
+	return provisioner.ProvisionTopicEndpoint(topicEndpointName, ignoreExists)
+}
+
+// ProvisionTopicEndpointAsync is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) ProvisionTopicEndpointAsync(topicEndpointName string, ignoreExists bool) <-chan solace.ProvisionOutcome {
+	result := make(chan solace.ProvisionOutcome, 1)
+	result <- provisioner.ProvisionTopicEndpoint(topicEndpointName, ignoreExists)
+	return result
+}
+
+// ProvisionTopicEndpointAsyncWithCallback is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) ProvisionTopicEndpointAsyncWithCallback(topicEndpointName string, ignoreExists bool, callback func(solace.ProvisionOutcome)) {
+	go callback(provisioner.ProvisionTopicEndpoint(topicEndpointName, ignoreExists))
+}
+
+// DeprovisionTopicEndpoint is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) DeprovisionTopicEndpoint(topicEndpointName string, ignoreMissing bool) error {
+	return errNotSupported("topic endpoints")
+}
+
+// DeprovisionTopicEndpointWithContext is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) DeprovisionTopicEndpointWithContext(ctx context.Context, topicEndpointName string, ignoreMissing bool) error {
+	return provisioner.DeprovisionTopicEndpoint(topicEndpointName, ignoreMissing)
+}
+
+// DeprovisionTopicEndpointAsync is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) DeprovisionTopicEndpointAsync(topicEndpointName string, ignoreMissing bool) <-chan error {
+	result := make(chan error, 1)
+	result <- provisioner.DeprovisionTopicEndpoint(topicEndpointName, ignoreMissing)
+	return result
+}
+
+// DeprovisionTopicEndpointAsyncWithCallback is not supported by the in-memory broker.
+func (provisioner *endpointProvisioner) DeprovisionTopicEndpointAsyncWithCallback(topicEndpointName string, ignoreMissing bool, callback func(err error)) {
+	go callback(provisioner.DeprovisionTopicEndpoint(topicEndpointName, ignoreMissing))
+}
+
+// FromConfigurationProvider applies the given endpoint properties.
+func (provisioner *endpointProvisioner) FromConfigurationProvider(properties config.EndpointPropertiesConfigurationProvider) solace.EndpointProvisioner {
+	if properties == nil {
+		return provisioner
+	}
+	for key, value := range properties.GetConfiguration() {
+		provisioner.properties[key] = value
+	}
+	return provisioner
+}
+
+// GetConfiguration returns a copy of the configured endpoint properties.
+func (provisioner *endpointProvisioner) GetConfiguration() config.EndpointPropertyMap {
+	ret := make(config.EndpointPropertyMap, len(provisioner.properties))
+	for key, value := range provisioner.properties {
+		ret[key] = value
+	}
+	return ret
+}
+
+// WithProperty sets an endpoint property.
+func (provisioner *endpointProvisioner) WithProperty(propertyName config.EndpointProperty, propertyValue interface{}) solace.EndpointProvisioner {
+	provisioner.properties[propertyName] = propertyValue
+	return provisioner
+}
+
+// WithDurability sets the durability of the provisioned queues.
+func (provisioner *endpointProvisioner) WithDurability(durable bool) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyDurable, durable)
+}
+
+// WithExclusiveAccess sets whether the provisioned queues are exclusive.
+func (provisioner *endpointProvisioner) WithExclusiveAccess(exclusive bool) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyExclusive, exclusive)
+}
+
+// WithDiscardNotification has no effect on the in-memory broker.
+func (provisioner *endpointProvisioner) WithDiscardNotification(notifySender bool) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyNotifySender, notifySender)
+}
+
+// WithMaxMessageRedelivery sets the number of times a message is redelivered before it is discarded.
+func (provisioner *endpointProvisioner) WithMaxMessageRedelivery(count uint) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyMaxMessageRedelivery, count)
+}
+
+// WithMaxMessageSize has no effect on the in-memory broker.
+func (provisioner *endpointProvisioner) WithMaxMessageSize(count uint) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyMaxMessageSize, count)
+}
+
+// WithPermission has no effect on the in-memory broker.
+func (provisioner *endpointProvisioner) WithPermission(permission config.EndpointPermission) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyPermission, permission)
+}
+
+// WithQuotaMB has no effect on the in-memory broker.
+func (provisioner *endpointProvisioner) WithQuotaMB(quota uint) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyQuotaMB, quota)
+}
+
+// WithTTLPolicy has no effect on the in-memory broker.
+func (provisioner *endpointProvisioner) WithTTLPolicy(respect bool) solace.EndpointProvisioner {
+	return provisioner.WithProperty(config.EndpointPropertyRespectsTTL, respect)
+}
+
+func (provisioner *endpointProvisioner) checkConnected() error {
+	if !provisioner.service.IsConnected() {
+		return solace.NewError(&solace.IllegalStateError{}, constants.UnableToProvisionParentServiceNotStarted, nil)
+	}
+	return nil
+}
+
+type provisionOutcome struct {
+	err error
+	ok  bool
+}
+
+// GetError returns the error if the queue could not be provisioned.
+func (outcome *provisionOutcome) GetError() error {
+	return outcome.err
+}
+
+// GetStatus returns true if the queue was provisioned.
+func (outcome *provisionOutcome) GetStatus() bool {
+	return outcome.ok
+}
+
+type queueBrowserBuilderImpl struct{}
+
+// Build is not supported by the in-memory broker.
+func (builder *queueBrowserBuilderImpl) Build(queue *resource.Queue) (solace.QueueBrowser, error) {
+	return nil, errNotSupported("queue browsers")
+}
+
+// WithMessageSelector has no effect.
+func (builder *queueBrowserBuilderImpl) WithMessageSelector(filterSelectorExpression string) solace.QueueBrowserBuilder {
+	return builder
+}
+
+// WithQueueBrowserWindowSize has no effect.
+func (builder *queueBrowserBuilderImpl) WithQueueBrowserWindowSize(windowSize uint) solace.QueueBrowserBuilder {
+	return builder
+}
+
+// FromConfigurationProvider has no effect.
+func (builder *queueBrowserBuilderImpl) FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) solace.QueueBrowserBuilder {
+	return builder
+}
+
+type transactionalMessagingServiceBuilderImpl struct{}
+
+// Build is not supported by the in-memory broker.
+func (builder *transactionalMessagingServiceBuilderImpl) Build() (solace.TransactionalMessagingService, error) {
+	return nil, errNotSupported("transactional messaging services")
+}
+
+// WithRequestTimeout has no effect.
+func (builder *transactionalMessagingServiceBuilderImpl) WithRequestTimeout(timeout time.Duration) solace.TransactionalMessagingServiceBuilder {
+	return builder
+}
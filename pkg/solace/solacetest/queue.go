@@ -0,0 +1,177 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"solace.dev/go/messaging/internal/impl/message"
+)
+
+// queue spools messages until they are settled by a bound receiver. All fields are guarded by the broker lock.
+type queue struct {
+	broker    *Broker
+	name      string
+	durable   bool
+	exclusive bool
+	// maxRedelivery is the number of times a message is redelivered before it is discarded, 0 for unlimited
+	maxRedelivery uint
+
+	subscriptions []string
+	entries       []*queueEntry
+	consumers     []*persistentMessageReceiverImpl
+	cursor        int
+	nextEntryID   uint64
+}
+
+// queueEntry is a message spooled on a queue
+type queueEntry struct {
+	id      uint64
+	message *message.OutboundMessageImpl
+	// consumer is the receiver the message is delivered to, nil while the message awaits delivery
+	consumer *persistentMessageReceiverImpl
+	// delivery is incremented on every delivery, a settlement of an earlier delivery is ignored
+	delivery      uint64
+	redeliveries  uint
+	isRedelivered bool
+}
+
+func (queue *queue) matches(topic string) bool {
+	for _, subscription := range queue.subscriptions {
+		if topicMatches(subscription, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// outstanding returns the number of messages delivered to the given receiver and not yet settled
+func (queue *queue) outstanding(consumer *persistentMessageReceiverImpl) int {
+	count := 0
+	for _, entry := range queue.entries {
+		if entry.consumer == consumer {
+			count++
+		}
+	}
+	return count
+}
+
+func (queue *queue) addSubscription(subscription string) {
+	for _, existing := range queue.subscriptions {
+		if existing == subscription {
+			return
+		}
+	}
+	queue.subscriptions = append(queue.subscriptions, subscription)
+}
+
+func (queue *queue) removeSubscription(subscription string) {
+	for i, existing := range queue.subscriptions {
+		if existing == subscription {
+			queue.subscriptions = append(queue.subscriptions[:i], queue.subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (queue *queue) enqueue(msg *message.OutboundMessageImpl) {
+	queue.nextEntryID++
+	queue.entries = append(queue.entries, &queueEntry{id: queue.nextEntryID, message: msg})
+	queue.dispatch()
+}
+
+// dispatch delivers all undelivered messages to the bound receivers that can accept them, in spool order.
+// Exclusive queues only deliver to the first bound receiver, other queues deliver round robin.
+func (queue *queue) dispatch() {
+	for _, entry := range queue.entries {
+		if entry.consumer != nil {
+			continue
+		}
+		consumer := queue.nextConsumer()
+		if consumer == nil {
+			return
+		}
+		entry.consumer = consumer
+		entry.delivery++
+		consumer.deliverQueued(entry)
+	}
+}
+
+// nextConsumer returns the receiver to deliver the next message to, or nil if no receiver can accept a message
+func (queue *queue) nextConsumer() *persistentMessageReceiverImpl {
+	if len(queue.consumers) == 0 {
+		return nil
+	}
+	if queue.exclusive {
+		if queue.consumers[0].canAccept(queue) {
+			return queue.consumers[0]
+		}
+		return nil
+	}
+	for i := 0; i < len(queue.consumers); i++ {
+		consumer := queue.consumers[(queue.cursor+i)%len(queue.consumers)]
+		if consumer.canAccept(queue) {
+			queue.cursor = (queue.cursor + i + 1) % len(queue.consumers)
+			return consumer
+		}
+	}
+	return nil
+}
+
+// settle removes the given delivery of a message from the queue, or returns it for redelivery if
+// redeliver is true. Returns false if the delivery is no longer outstanding on the given receiver.
+func (queue *queue) settle(consumer *persistentMessageReceiverImpl, entry *queueEntry, delivery uint64, redeliver bool) bool {
+	if entry.consumer != consumer || entry.delivery != delivery {
+		return false
+	}
+	if redeliver {
+		queue.redeliver(entry)
+	} else {
+		queue.remove(entry)
+	}
+	queue.dispatch()
+	return true
+}
+
+// release returns all messages delivered to the given receiver and not yet settled for redelivery
+func (queue *queue) release(consumer *persistentMessageReceiverImpl) {
+	for _, entry := range queue.entries {
+		if entry.consumer == consumer {
+			queue.redeliver(entry)
+		}
+	}
+	queue.dispatch()
+}
+
+// redeliver marks the message as redelivered and returns it to the queue, or discards it
+// if the maximum number of redeliveries has been reached
+func (queue *queue) redeliver(entry *queueEntry) {
+	if queue.maxRedelivery > 0 && entry.redeliveries >= queue.maxRedelivery {
+		queue.remove(entry)
+		return
+	}
+	entry.consumer = nil
+	entry.isRedelivered = true
+	entry.redeliveries++
+}
+
+func (queue *queue) remove(entry *queueEntry) {
+	for i, existing := range queue.entries {
+		if existing == entry {
+			queue.entries = append(queue.entries[:i], queue.entries[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,422 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"solace.dev/go/messaging/internal/impl/constants"
+	"solace.dev/go/messaging/internal/impl/executor"
+	"solace.dev/go/messaging/internal/impl/message"
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// pendingRequest is a request awaiting its reply
+type pendingRequest struct {
+	handler     solace.ReplyMessageHandler
+	userContext interface{}
+	timer       *time.Timer
+}
+
+type requestReplyMessagePublisherImpl struct {
+	basicMessagePublisher
+	replyTopic string
+
+	requestLock   sync.Mutex
+	pending       map[string]*pendingRequest
+	nextRequestID uint64
+	// replies calls the reply handlers in the order the replies are received
+	replies executor.Executor
+}
+
+func (publisher *requestReplyMessagePublisherImpl) start() error {
+	go publisher.replies.Run()
+	publisher.service.broker.subscribe(publisher, publisher.replyTopic, "")
+	return nil
+}
+
+func (publisher *requestReplyMessagePublisherImpl) terminate(ctx context.Context) error {
+	publisher.service.broker.unsubscribeAll(publisher)
+	publisher.requestLock.Lock()
+	pending := publisher.pending
+	publisher.pending = make(map[string]*pendingRequest)
+	publisher.requestLock.Unlock()
+	for _, request := range pending {
+		request := request
+		if request.timer != nil {
+			request.timer.Stop()
+		}
+		publisher.replies.Submit(func() {
+			request.handler(nil, request.userContext, solace.NewError(&solace.IllegalStateError{}, constants.RequestReplyPublisherCannotReceiveReplyAlreadyTerminated, nil))
+		})
+	}
+	publisher.replies.AwaitTermination()
+	return nil
+}
+
+// deliverDirect correlates a reply with its pending request
+func (publisher *requestReplyMessagePublisherImpl) deliverDirect(msg *message.OutboundMessageImpl) {
+	reply, err := newInboundMessage(msg, false)
+	if err != nil {
+		return
+	}
+	correlationID, ok := reply.GetCorrelationID()
+	if !ok {
+		return
+	}
+	request, ok := publisher.takeRequest(correlationID)
+	if !ok {
+		return
+	}
+	if request.timer != nil {
+		request.timer.Stop()
+	}
+	publisher.service.metrics.increment(metrics.DirectMessagesReceived, 1)
+	publisher.replies.Submit(func() {
+		request.handler(reply, request.userContext, nil)
+	})
+}
+
+func (publisher *requestReplyMessagePublisherImpl) takeRequest(correlationID string) (*pendingRequest, bool) {
+	publisher.requestLock.Lock()
+	defer publisher.requestLock.Unlock()
+	request, ok := publisher.pending[correlationID]
+	if ok {
+		delete(publisher.pending, correlationID)
+	}
+	return request, ok
+}
+
+// StartAsyncCallback starts the publisher asynchronously, calling the callback when started.
+func (publisher *requestReplyMessagePublisherImpl) StartAsyncCallback(callback func(solace.RequestReplyMessagePublisher, error)) {
+	go func() {
+		callback(publisher, publisher.Start())
+	}()
+}
+
+// TerminateAsyncCallback terminates the publisher asynchronously, calling the callback when terminated.
+func (publisher *requestReplyMessagePublisherImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(publisher.Terminate(gracePeriod))
+	}()
+}
+
+// PublishBytes publishes a request with a byte array payload.
+func (publisher *requestReplyMessagePublisherImpl) PublishBytes(bytes []byte, replyMessageHandler solace.ReplyMessageHandler, destination *resource.Topic, replyTimeout time.Duration, userContext interface{}) error {
+	msg, err := publisher.service.MessageBuilder().BuildWithByteArrayPayload(bytes)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(msg, replyMessageHandler, destination, replyTimeout, nil, userContext)
+}
+
+// PublishString publishes a request with a string payload.
+func (publisher *requestReplyMessagePublisherImpl) PublishString(str string, replyMessageHandler solace.ReplyMessageHandler, destination *resource.Topic, replyTimeout time.Duration, userContext interface{}) error {
+	msg, err := publisher.service.MessageBuilder().BuildWithStringPayload(str)
+	if err != nil {
+		return err
+	}
+	return publisher.Publish(msg, replyMessageHandler, destination, replyTimeout, nil, userContext)
+}
+
+// Publish publishes a request, the handler is called with the reply or an error if no reply is
+// received within the reply timeout. A negative reply timeout waits indefinitely.
+func (publisher *requestReplyMessagePublisherImpl) Publish(requestMessage apimessage.OutboundMessage, replyMessageHandler solace.ReplyMessageHandler,
+	requestsDestination *resource.Topic, replyTimeout time.Duration,
+	properties config.MessagePropertiesConfigurationProvider, userContext interface{}) error {
+	if replyMessageHandler == nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, constants.MissingReplyMessageHandler, nil)
+	}
+	_, err := publisher.publish(requestMessage, replyMessageHandler, requestsDestination, replyTimeout, properties, userContext)
+	return err
+}
+
+// PublishAwaitResponse publishes a request and waits for at most the reply timeout for the reply.
+func (publisher *requestReplyMessagePublisherImpl) PublishAwaitResponse(requestMessage apimessage.OutboundMessage, requestDestination *resource.Topic,
+	replyTimeout time.Duration, properties config.MessagePropertiesConfigurationProvider) (apimessage.InboundMessage, error) {
+	return publisher.publishAwaitResponse(context.Background(), requestMessage, requestDestination, replyTimeout, properties)
+}
+
+// PublishAwaitResponseWithContext publishes a request and waits for the reply until the context is done.
+func (publisher *requestReplyMessagePublisherImpl) PublishAwaitResponseWithContext(ctx context.Context, requestMessage apimessage.OutboundMessage, requestDestination *resource.Topic,
+	properties config.MessagePropertiesConfigurationProvider) (apimessage.InboundMessage, error) {
+	return publisher.publishAwaitResponse(ctx, requestMessage, requestDestination, -1, properties)
+}
+
+func (publisher *requestReplyMessagePublisherImpl) publishAwaitResponse(ctx context.Context, requestMessage apimessage.OutboundMessage, requestDestination *resource.Topic,
+	replyTimeout time.Duration, properties config.MessagePropertiesConfigurationProvider) (apimessage.InboundMessage, error) {
+	type result struct {
+		reply apimessage.InboundMessage
+		err   error
+	}
+	results := make(chan result, 1)
+	handler := func(reply apimessage.InboundMessage, userContext interface{}, err error) {
+		results <- result{reply, err}
+	}
+	correlationID, err := publisher.publish(requestMessage, handler, requestDestination, replyTimeout, properties, nil)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case result := <-results:
+		return result.reply, result.err
+	case <-ctx.Done():
+		if request, ok := publisher.takeRequest(correlationID); ok && request.timer != nil {
+			request.timer.Stop()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// publish sends the request with a correlation ID and the reply topic of the publisher, returns the correlation ID
+func (publisher *requestReplyMessagePublisherImpl) publish(requestMessage apimessage.OutboundMessage, replyMessageHandler solace.ReplyMessageHandler,
+	requestsDestination *resource.Topic, replyTimeout time.Duration,
+	properties config.MessagePropertiesConfigurationProvider, userContext interface{}) (string, error) {
+	if err := publisher.checkPublish(); err != nil {
+		return "", err
+	}
+	msgDup, err := prepareMessage(requestMessage, properties)
+	if err != nil {
+		return "", err
+	}
+	publisher.requestLock.Lock()
+	publisher.nextRequestID++
+	correlationID := fmt.Sprintf("#REQ%d", publisher.nextRequestID)
+	publisher.requestLock.Unlock()
+	if err := message.SetDestination(msgDup, requestsDestination.GetName()); err != nil {
+		return "", err
+	}
+	if err := message.SetReplyToDestination(msgDup, publisher.replyTopic); err != nil {
+		return "", err
+	}
+	if err := message.SetCorrelationID(msgDup, correlationID); err != nil {
+		return "", err
+	}
+	request := &pendingRequest{handler: replyMessageHandler, userContext: userContext}
+	publisher.requestLock.Lock()
+	publisher.pending[correlationID] = request
+	if replyTimeout >= 0 {
+		request.timer = time.AfterFunc(replyTimeout, func() {
+			if _, ok := publisher.takeRequest(correlationID); ok {
+				publisher.replies.Submit(func() {
+					replyMessageHandler(nil, userContext, solace.NewError(&solace.TimeoutError{}, constants.RequestReplyPublisherTimedOutWaitingForReply, nil))
+				})
+			}
+		})
+	}
+	publisher.requestLock.Unlock()
+	publisher.recordPublish(msgDup, metrics.DirectMessagesSent)
+	publisher.service.broker.publishToTopic(msgDup, requestsDestination.GetName())
+	return correlationID, nil
+}
+
+func (publisher *requestReplyMessagePublisherImpl) String() string {
+	return fmt.Sprintf("solacetest.RequestReplyMessagePublisher at %p", publisher)
+}
+
+type requestReplyMessagePublisherBuilderImpl struct {
+	service *MessagingService
+}
+
+// Build creates a new request-reply message publisher.
+func (builder *requestReplyMessagePublisherBuilderImpl) Build() (solace.RequestReplyMessagePublisher, error) {
+	broker := builder.service.broker
+	broker.lock.Lock()
+	broker.nextID++
+	replyTopic := fmt.Sprintf("#P2P/solacetest/%s/%d", builder.service.applicationID, broker.nextID)
+	broker.lock.Unlock()
+	publisher := &requestReplyMessagePublisherImpl{
+		replyTopic: replyTopic,
+		pending:    make(map[string]*pendingRequest),
+		replies:    executor.NewExecutor(),
+	}
+	publisher.init(builder.service)
+	publisher.onStart = publisher.start
+	publisher.onTerminate = publisher.terminate
+	return publisher, nil
+}
+
+// OnBackPressureReject has no effect, publishers of the in-memory broker never apply back pressure.
+func (builder *requestReplyMessagePublisherBuilderImpl) OnBackPressureReject(bufferSize uint) solace.RequestReplyMessagePublisherBuilder {
+	return builder
+}
+
+// OnBackPressureWait has no effect, publishers of the in-memory broker never apply back pressure.
+func (builder *requestReplyMessagePublisherBuilderImpl) OnBackPressureWait(bufferSize uint) solace.RequestReplyMessagePublisherBuilder {
+	return builder
+}
+
+// FromConfigurationProvider has no effect, publishers of the in-memory broker have no configuration.
+func (builder *requestReplyMessagePublisherBuilderImpl) FromConfigurationProvider(provider config.PublisherPropertiesConfigurationProvider) solace.RequestReplyMessagePublisherBuilder {
+	return builder
+}
+
+type requestReplyMessageReceiverImpl struct {
+	directReceiverCore
+}
+
+// StartAsyncCallback starts the receiver asynchronously, calling the callback when started.
+func (receiver *requestReplyMessageReceiverImpl) StartAsyncCallback(callback func(solace.RequestReplyMessageReceiver, error)) {
+	go func() {
+		callback(receiver, receiver.Start())
+	}()
+}
+
+// TerminateAsyncCallback terminates the receiver asynchronously, calling the callback when terminated.
+func (receiver *requestReplyMessageReceiverImpl) TerminateAsyncCallback(gracePeriod time.Duration, callback func(error)) {
+	go func() {
+		callback(receiver.Terminate(gracePeriod))
+	}()
+}
+
+// ReceiveAsync registers the handler called with each received request and its replier.
+// The replier is nil if the request has no reply destination or correlation ID.
+func (receiver *requestReplyMessageReceiverImpl) ReceiveAsync(messageHandler solace.RequestMessageHandler) error {
+	return receiver.setAsyncHandler(func(msg *inboundMessage) {
+		messageHandler(msg, receiver.newReplier(msg))
+	})
+}
+
+// ReceiveMessage receives a request synchronously, waiting for at most the given timeout.
+func (receiver *requestReplyMessageReceiverImpl) ReceiveMessage(timeout time.Duration) (apimessage.InboundMessage, solace.Replier, error) {
+	if err := receiver.checkReceive(); err != nil {
+		return nil, nil, err
+	}
+	msg, err := receiver.inbox.receiveWithTimeout(timeout, receiver.stopping)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, receiver.newReplier(msg), nil
+}
+
+// ReceiveMessageWithContext receives a request synchronously, waiting until the context is done.
+func (receiver *requestReplyMessageReceiverImpl) ReceiveMessageWithContext(ctx context.Context) (apimessage.InboundMessage, solace.Replier, error) {
+	if err := receiver.checkReceive(); err != nil {
+		return nil, nil, err
+	}
+	msg, err := receiver.inbox.receive(ctx, nil, receiver.stopping)
+	if err != nil {
+		return nil, nil, err
+	}
+	return msg, receiver.newReplier(msg), nil
+}
+
+// newReplier returns the replier of a request, or nil if the request expects no reply
+func (receiver *requestReplyMessageReceiverImpl) newReplier(msg *inboundMessage) solace.Replier {
+	replyToDestination, ok := message.GetReplyToDestinationName(msg.InboundMessageImpl)
+	if !ok {
+		return nil
+	}
+	correlationID, ok := msg.GetCorrelationID()
+	if !ok {
+		return nil
+	}
+	return &replier{service: receiver.service, replyToDestination: replyToDestination, correlationID: correlationID}
+}
+
+func (receiver *requestReplyMessageReceiverImpl) String() string {
+	return fmt.Sprintf("solacetest.RequestReplyMessageReceiver at %p", receiver)
+}
+
+type replier struct {
+	service            *MessagingService
+	replyToDestination string
+	correlationID      string
+}
+
+// Reply publishes the reply to the reply destination of the request.
+func (replier *replier) Reply(msg apimessage.OutboundMessage) error {
+	if msg == nil {
+		return solace.NewError(&solace.IllegalArgumentError{}, "Replier must have OutboundMessage not nil", nil)
+	}
+	if _, ok := msg.(*message.OutboundMessageImpl); !ok {
+		return solace.NewError(&solace.IllegalArgumentError{}, "Replier must have OutboundMessage from OutboundMessageBuilder", nil)
+	}
+	replyMsg, err := prepareMessage(msg, nil)
+	if err != nil {
+		return err
+	}
+	if err := message.SetAsReplyMessage(replyMsg, replier.replyToDestination, replier.correlationID); err != nil {
+		return err
+	}
+	replier.service.metrics.increment(metrics.DirectMessagesSent, 1)
+	replier.service.broker.publishToTopic(replyMsg, replier.replyToDestination)
+	return nil
+}
+
+type requestReplyMessageReceiverBuilderImpl struct {
+	directReceiverBuilder *directMessageReceiverBuilderImpl
+}
+
+func newRequestReplyMessageReceiverBuilder(service *MessagingService) *requestReplyMessageReceiverBuilderImpl {
+	return &requestReplyMessageReceiverBuilderImpl{directReceiverBuilder: newDirectMessageReceiverBuilder(service)}
+}
+
+// Build creates a new request-reply message receiver receiving the requests matching the given subscription.
+func (builder *requestReplyMessageReceiverBuilderImpl) Build(requestTopicSubscription resource.Subscription) (solace.RequestReplyMessageReceiver, error) {
+	return builder.BuildWithSharedSubscription(requestTopicSubscription, nil)
+}
+
+// BuildWithSharedSubscription creates a new request-reply message receiver sharing the given subscription with the given share name.
+func (builder *requestReplyMessageReceiverBuilderImpl) BuildWithSharedSubscription(requestTopicSubscription resource.Subscription, shareName *resource.ShareName) (solace.RequestReplyMessageReceiver, error) {
+	inbox, err := newDirectInbox(builder.directReceiverBuilder.properties)
+	if err != nil {
+		return nil, err
+	}
+	subscriptions, err := toSubscriptionNames([]resource.Subscription{requestTopicSubscription}, constants.DirectReceiverUnsupportedSubscriptionType)
+	if err != nil {
+		return nil, err
+	}
+	var name string
+	if shareName != nil {
+		name = shareName.GetName()
+	}
+	receiver := &requestReplyMessageReceiverImpl{}
+	receiver.init(builder.directReceiverBuilder.service, subscriptions, name, inbox, builder.directReceiverBuilder.codecs)
+	return receiver, nil
+}
+
+// WithCodec registers a codec used by DecodePayload.
+func (builder *requestReplyMessageReceiverBuilderImpl) WithCodec(codec solace.Codec) solace.RequestReplyMessageReceiverBuilder {
+	builder.directReceiverBuilder.WithCodec(codec)
+	return builder
+}
+
+// OnBackPressureDropLatest drops the newest request when the buffer of the given capacity is full.
+func (builder *requestReplyMessageReceiverBuilderImpl) OnBackPressureDropLatest(bufferCapacity uint) solace.RequestReplyMessageReceiverBuilder {
+	builder.directReceiverBuilder.OnBackPressureDropLatest(bufferCapacity)
+	return builder
+}
+
+// OnBackPressureDropOldest drops the oldest request when the buffer of the given capacity is full.
+func (builder *requestReplyMessageReceiverBuilderImpl) OnBackPressureDropOldest(bufferCapacity uint) solace.RequestReplyMessageReceiverBuilder {
+	builder.directReceiverBuilder.OnBackPressureDropOldest(bufferCapacity)
+	return builder
+}
+
+// FromConfigurationProvider applies the given receiver properties.
+func (builder *requestReplyMessageReceiverBuilderImpl) FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) solace.RequestReplyMessageReceiverBuilder {
+	builder.directReceiverBuilder.FromConfigurationProvider(provider)
+	return builder
+}
@@ -0,0 +1,434 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solacetest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace"
+	"solace.dev/go/messaging/pkg/solace/config"
+	apimessage "solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+func TestTopicMatches(t *testing.T) {
+	testCases := []struct {
+		subscription, topic string
+		expected            bool
+	}{
+		{"a/b/c", "a/b/c", true},
+		{"a/b/c", "a/b", false},
+		{"a/b", "a/b/c", false},
+		{"a/*/c", "a/b/c", true},
+		{"a/*/c", "a/b/d", false},
+		{"a/b*/c", "a/bcd/c", true},
+		{"a/b*/c", "a/x/c", false},
+		{"a/>", "a/b", true},
+		{"a/>", "a/b/c/d", true},
+		{"a/>", "a", false},
+		{">", "a", true},
+		{"a/>/c", "a/>/c", true},
+		{"a/>/c", "a/b/c", false},
+		{">", "#P2P/solacetest/app/1", false},
+		{"#P2P/solacetest/>", "#P2P/solacetest/app/1", true},
+	}
+	for _, testCase := range testCases {
+		if actual := topicMatches(testCase.subscription, testCase.topic); actual != testCase.expected {
+			t.Errorf("expected topicMatches(%q, %q) to be %t", testCase.subscription, testCase.topic, testCase.expected)
+		}
+	}
+}
+
+func TestParseSubscription(t *testing.T) {
+	testCases := []struct {
+		subscription, topic, shareName string
+	}{
+		{"a/b", "a/b", ""},
+		{"#share/group/a/>", "a/>", "group"},
+		{"#noexport/a/b", "a/b", ""},
+		{"#noexport/#share/group/a", "a", "group"},
+	}
+	for _, testCase := range testCases {
+		topic, shareName := parseSubscription(testCase.subscription)
+		if topic != testCase.topic || shareName != testCase.shareName {
+			t.Errorf("expected parseSubscription(%q) to return (%q, %q), got (%q, %q)",
+				testCase.subscription, testCase.topic, testCase.shareName, topic, shareName)
+		}
+	}
+}
+
+func connect(t *testing.T, service *MessagingService) {
+	if err := service.Connect(); err != nil {
+		t.Fatalf("failed to connect: %s", err)
+	}
+	t.Cleanup(func() {
+		service.Disconnect()
+	})
+}
+
+func start(t *testing.T, component solace.LifecycleControl, err error) {
+	if err != nil {
+		t.Fatalf("failed to build: %s", err)
+	}
+	if err := component.Start(); err != nil {
+		t.Fatalf("failed to start: %s", err)
+	}
+}
+
+func payload(t *testing.T, msg apimessage.InboundMessage) string {
+	str, ok := msg.GetPayloadAsString()
+	if !ok {
+		t.Fatalf("expected string payload")
+	}
+	return str
+}
+
+func TestDirectPublishReceive(t *testing.T) {
+	broker := NewBroker()
+	publisherService := broker.NewMessagingService()
+	receiverService := broker.NewMessagingService()
+	connect(t, publisherService)
+	connect(t, receiverService)
+
+	receiver, err := receiverService.CreateDirectMessageReceiverBuilder().
+		WithSubscriptions(resource.TopicSubscriptionOf("orders/*/created")).Build()
+	start(t, receiver, err)
+	publisher, err := publisherService.CreateDirectMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+
+	if err := publisher.PublishString("ignored", resource.TopicOf("orders/eu/deleted")); err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.PublishString("hello", resource.TopicOf("orders/eu/created")); err != nil {
+		t.Fatal(err)
+	}
+	msg, err := receiver.ReceiveMessage(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload(t, msg) != "hello" {
+		t.Errorf("unexpected payload %q", payload(t, msg))
+	}
+	if msg.GetDestinationName() != "orders/eu/created" {
+		t.Errorf("unexpected destination %q", msg.GetDestinationName())
+	}
+	if _, err := receiver.ReceiveMessage(10 * time.Millisecond); !errors.As(err, new(*solace.TimeoutError)) {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
+
+func TestSharedSubscriptionRoundRobin(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	var receivers []solace.DirectMessageReceiver
+	for i := 0; i < 2; i++ {
+		receiver, err := service.CreateDirectMessageReceiverBuilder().
+			WithSubscriptions(resource.TopicSubscriptionOf("a/>")).
+			BuildWithShareName(resource.ShareNameOf("group"))
+		start(t, receiver, err)
+		receivers = append(receivers, receiver)
+	}
+	publisher, err := service.CreateDirectMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+	for i := 0; i < 4; i++ {
+		publisher.PublishString("message", resource.TopicOf("a/b"))
+	}
+	for _, receiver := range receivers {
+		if received := receiver.Metrics().GetValue(metrics.ReceiverMessagesReceived); received != 2 {
+			t.Errorf("expected each receiver to receive 2 messages, got %d", received)
+		}
+	}
+}
+
+func TestQueueSettlementAndRedelivery(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	if err := service.Broker().ProvisionQueue("q", true, "orders/>"); err != nil {
+		t.Fatal(err)
+	}
+	receiver, err := service.CreatePersistentMessageReceiverBuilder().
+		WithRequiredMessageOutcomeSupport(config.PersistentReceiverFailedOutcome).
+		Build(resource.QueueDurableExclusive("q"))
+	start(t, receiver, err)
+	publisher, err := service.CreatePersistentMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+
+	msg, err := service.MessageBuilder().BuildWithStringPayload("order")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.PublishAwaitAcknowledgement(msg, resource.TopicOf("orders/1"), time.Second, nil); err != nil {
+		t.Fatal(err)
+	}
+	received, err := receiver.ReceiveMessage(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if received.IsRedelivered() {
+		t.Error("expected first delivery not to be redelivered")
+	}
+	if err := receiver.Settle(received, config.PersistentReceiverFailedOutcome); err != nil {
+		t.Fatal(err)
+	}
+	redelivered, err := receiver.ReceiveMessage(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !redelivered.IsRedelivered() {
+		t.Error("expected failed message to be redelivered")
+	}
+	if err := receiver.Settle(redelivered, config.PersistentReceiverRejectedOutcome); err == nil {
+		t.Error("expected rejected outcome to be unsupported")
+	}
+	if err := receiver.Ack(redelivered); err != nil {
+		t.Fatal(err)
+	}
+	if depth, _ := service.Broker().QueueDepth("q"); depth != 0 {
+		t.Errorf("expected empty queue after ack, got depth %d", depth)
+	}
+}
+
+func TestUnackedMessagesRedeliveredOnReconnection(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	receiver, err := service.CreatePersistentMessageReceiverBuilder().
+		WithMissingResourcesCreationStrategy(config.PersistentReceiverCreateOnStartMissingResources).
+		Build(resource.QueueDurableExclusive("q"))
+	start(t, receiver, err)
+	publisher, err := service.CreatePersistentMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+	msg, _ := service.MessageBuilder().BuildWithStringPayload("order")
+	if err := publisher.PublishAwaitAcknowledgement(msg, resource.QueueDurableExclusive("q"), time.Second, nil); err != nil {
+		t.Fatal(err)
+	}
+	first, err := receiver.ReceiveMessage(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reconnected := make(chan struct{}, 1)
+	service.AddReconnectionListener(func(solace.ServiceEvent) { reconnected <- struct{}{} })
+	service.SimulateReconnection()
+	select {
+	case <-reconnected:
+	default:
+		t.Error("expected reconnection listener to be called")
+	}
+	second, err := receiver.ReceiveMessage(time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !second.IsRedelivered() {
+		t.Error("expected unacknowledged message to be redelivered after reconnection")
+	}
+	// the settlement of the stale delivery is ignored
+	receiver.Ack(first)
+	if depth, _ := service.Broker().QueueDepth("q"); depth != 1 {
+		t.Errorf("expected stale ack to be ignored, got depth %d", depth)
+	}
+	receiver.Ack(second)
+	if depth, _ := service.Broker().QueueDepth("q"); depth != 0 {
+		t.Errorf("expected empty queue after ack, got depth %d", depth)
+	}
+}
+
+func TestRequestReply(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	receiver, err := service.RequestReply().CreateRequestReplyMessageReceiverBuilder().
+		Build(resource.TopicSubscriptionOf("requests/>"))
+	start(t, receiver, err)
+	receiver.ReceiveAsync(func(request apimessage.InboundMessage, replier solace.Replier) {
+		reply, _ := service.MessageBuilder().BuildWithStringPayload("reply to " + payload(t, request))
+		replier.Reply(reply)
+	})
+	publisher, err := service.RequestReply().CreateRequestReplyMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+	request, _ := service.MessageBuilder().BuildWithStringPayload("ping")
+	reply, err := publisher.PublishAwaitResponse(request, resource.TopicOf("requests/ping"), time.Second, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payload(t, reply) != "reply to ping" {
+		t.Errorf("unexpected reply %q", payload(t, reply))
+	}
+	if _, err := publisher.PublishAwaitResponse(request, resource.TopicOf("unanswered"), 10*time.Millisecond, nil); !errors.As(err, new(*solace.TimeoutError)) {
+		t.Errorf("expected timeout error, got %v", err)
+	}
+}
+
+func TestServiceInterruptionTerminatesComponents(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	receiver, err := service.CreateDirectMessageReceiverBuilder().Build()
+	start(t, receiver, err)
+	terminated := make(chan solace.TerminationEvent, 1)
+	receiver.SetTerminationNotificationListener(func(event solace.TerminationEvent) { terminated <- event })
+	cause := errors.New("connection lost")
+	service.SimulateServiceInterruption(cause)
+	select {
+	case event := <-terminated:
+		if event.GetCause() != cause {
+			t.Errorf("expected termination cause %v, got %v", cause, event.GetCause())
+		}
+	default:
+		t.Error("expected termination listener to be called")
+	}
+	if !receiver.IsTerminated() {
+		t.Error("expected receiver to be terminated")
+	}
+	if service.IsConnected() {
+		t.Error("expected service to be disconnected")
+	}
+}
+
+func TestPublishBatch(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	if err := service.Broker().ProvisionQueue("q", true, "orders/>"); err != nil {
+		t.Fatal(err)
+	}
+	publisher, err := service.CreatePersistentMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+	receipts := make(chan solace.PublishReceipt, 3)
+	publisher.SetMessagePublishReceiptListener(func(receipt solace.PublishReceipt) { receipts <- receipt })
+
+	var msgs []apimessage.OutboundMessage
+	for _, str := range []string{"one", "two", "three"} {
+		msg, _ := service.MessageBuilder().BuildWithStringPayload(str)
+		msgs = append(msgs, msg)
+	}
+	if err := publisher.PublishBatch(msgs, resource.TopicOf("orders/1"), resource.TopicOf("other"), resource.QueueDurableExclusive("q")); err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.PublishBatch(msgs, resource.TopicOf("orders/1"), resource.TopicOf("orders/2")); !errors.As(err, new(*solace.IllegalArgumentError)) {
+		t.Errorf("expected illegal argument error, got %v", err)
+	}
+	for i := 0; i < len(msgs); i++ {
+		select {
+		case receipt := <-receipts:
+			if receipt.GetError() != nil {
+				t.Errorf("unexpected receipt error %s", receipt.GetError())
+			}
+		case <-time.After(time.Second):
+			t.Fatal("expected a receipt for every message of the batch")
+		}
+	}
+	if depth, _ := service.Broker().QueueDepth("q"); depth != 2 {
+		t.Errorf("expected the batch to spool 2 messages, got depth %d", depth)
+	}
+}
+
+func TestTopicRouter(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	receiver, err := service.CreateDirectMessageReceiverBuilder().Build()
+	start(t, receiver, err)
+	publisher, err := service.CreateDirectMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+
+	created := make(chan map[string]string, 1)
+	if err := receiver.AddRoute("orders/{region}/*/created/>", func(msg apimessage.InboundMessage, levels map[string]string) {
+		created <- levels
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := receiver.AddRoute("orders/{region}/*/created/>", func(apimessage.InboundMessage, map[string]string) {}); !errors.As(err, new(*solace.IllegalArgumentError)) {
+		t.Errorf("expected illegal argument error adding a route twice, got %v", err)
+	}
+	if err := receiver.AddRoute("orders/{id}/{id}", func(apimessage.InboundMessage, map[string]string) {}); !errors.As(err, new(*solace.IllegalArgumentError)) {
+		t.Errorf("expected illegal argument error adding an invalid pattern, got %v", err)
+	}
+	unrouted := make(chan string, 1)
+	if err := receiver.ReceiveAsync(func(msg apimessage.InboundMessage) { unrouted <- msg.GetDestinationName() }); err != nil {
+		t.Fatal(err)
+	}
+	if err := receiver.AddSubscription(resource.TopicSubscriptionOf("orders/>")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := publisher.PublishString("hello", resource.TopicOf("orders/eu/web/created/1234")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case levels := <-created:
+		if len(levels) != 1 || levels["region"] != "eu" {
+			t.Errorf("expected region eu to be captured, got %v", levels)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected message to be routed")
+	}
+	if destination := <-unrouted; destination != "orders/eu/web/created/1234" {
+		t.Errorf("expected message to also be delivered to the receiver's subscription, got %s", destination)
+	}
+
+	if err := receiver.RemoveRoute("orders/{region}/*/created/>"); err != nil {
+		t.Fatal(err)
+	}
+	if err := receiver.RemoveRoute("orders/{region}/*/created/>"); !errors.As(err, new(*solace.IllegalArgumentError)) {
+		t.Errorf("expected illegal argument error removing a removed route, got %v", err)
+	}
+	if err := publisher.PublishString("hello", resource.TopicOf("orders/us/web/created/5678")); err != nil {
+		t.Fatal(err)
+	}
+	if destination := <-unrouted; destination != "orders/us/web/created/5678" {
+		t.Errorf("unexpected destination %s", destination)
+	}
+	select {
+	case levels := <-created:
+		t.Errorf("expected no message to be routed after the route is removed, got %v", levels)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestTopicRouterDiscardsUnroutedMessagesWithoutHandler(t *testing.T) {
+	service := NewMessagingService()
+	connect(t, service)
+	receiver, err := service.CreateDirectMessageReceiverBuilder().Build()
+	start(t, receiver, err)
+	publisher, err := service.CreateDirectMessagePublisherBuilder().Build()
+	start(t, publisher, err)
+
+	routed := make(chan string, 1)
+	if err := receiver.AddRoute("orders/{region}", func(msg apimessage.InboundMessage, levels map[string]string) {
+		routed <- levels["region"]
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := receiver.AddSubscription(resource.TopicSubscriptionOf("other/>")); err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.PublishString("hello", resource.TopicOf("other/1")); err != nil {
+		t.Fatal(err)
+	}
+	if err := publisher.PublishString("hello", resource.TopicOf("orders/eu")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case region := <-routed:
+		if region != "eu" {
+			t.Errorf("expected region eu to be captured, got %s", region)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected message to be routed")
+	}
+	if discarded := receiver.Metrics().GetValue(metrics.ReceiverMessagesUnroutedDiscarded); discarded != 1 {
+		t.Errorf("expected the unrouted message to be discarded, got %d discarded", discarded)
+	}
+}
@@ -0,0 +1,54 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solace
+
+import (
+	"solace.dev/go/messaging/pkg/solace/message"
+)
+
+// TopicRouteHandler is a callback that can be registered with a TopicRouter to receive the messages
+// matching a topic pattern, along with the values of the named levels of the pattern keyed by name.
+type TopicRouteHandler func(inboundMessage message.InboundMessage, levels map[string]string)
+
+// TopicRouter routes received messages to handlers registered by topic pattern, similar to an HTTP
+// request multiplexer. Topic patterns are topic subscriptions where levels may be named by enclosing the
+// name in braces, such as "orders/{region}/*/created/>". A named level matches any single level like the
+// "*" wildcard, and its value in the topic of a message is passed to the handler under its name.
+// Refer to [solace.dev/go/messaging/pkg/solace/resource.ParseTopicPattern] for details.
+//
+// Each route is subscribed to with its own message dispatch, so that messages are dispatched to their route
+// as they are matched to its subscription rather than matched again against every route. As a result, a message
+// matching the patterns of several routes, or also matching a subscription of the receiver, is delivered once
+// for each of them. Routed messages are delivered asynchronously in the same way as messages delivered to the
+// handler registered with ReceiveAsync, which receives the messages matching no route. Messages matching no
+// route are discarded when no handler is registered with ReceiveAsync, and counted by the
+// metrics.ReceiverMessagesUnroutedDiscarded receiver metric. Routes should not be combined with synchronous
+// ReceiveMessage calls.
+type TopicRouter interface {
+	// AddRoute adds a subscription to the specified topic pattern, and routes the messages received on it
+	// to the specified handler. Will block until the subscription is added.
+	// Returns a solace/errors.*IllegalStateError if the receiver is not running.
+	// Returns a solace/errors.*IllegalArgumentError if the topic pattern is invalid or already has a route.
+	AddRoute(pattern string, handler TopicRouteHandler) error
+
+	// RemoveRoute removes the route added with the specified topic pattern and its subscription. Messages
+	// of the route received before the subscription is removed are still delivered to its handler.
+	// Will block until the subscription is removed.
+	// Returns a solace/errors.*IllegalStateError if the receiver is not running.
+	// Returns a solace/errors.*IllegalArgumentError if the topic pattern has no route.
+	RemoveRoute(pattern string) error
+}
@@ -0,0 +1,234 @@
+// pubsubplus-go-client
+//
+// Copyright 2021-2025 Solace Corporation. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package solace
+
+import (
+	"context"
+	"time"
+
+	"solace.dev/go/messaging/pkg/solace/config"
+	"solace.dev/go/messaging/pkg/solace/message"
+	"solace.dev/go/messaging/pkg/solace/metrics"
+	"solace.dev/go/messaging/pkg/solace/resource"
+)
+
+// TransactionalMessagingService allows for the publishing and receiving of persistent messages
+// (guaranteed messages) within local transactions. Messages published by a TransactionalMessagePublisher
+// are not delivered, and messages received by a TransactionalMessageReceiver are not removed from their
+// queue, until the transaction is committed. Publishers and receivers created from the same
+// TransactionalMessagingService share its transaction.
+// A TransactionalMessagingService is created from a connected MessagingService with
+// MessagingService.CreateTransactionalMessagingServiceBuilder, and it is not safe to commit or
+// roll back a transaction concurrently with publishing or receiving messages in that transaction.
+type TransactionalMessagingService interface {
+	// Connect connects the transactional messaging service by creating a transacted session
+	// on the parent MessagingService. This function blocks until the connection attempt is completed.
+	// This function is idempotent.
+	// Returns nil if successful, otherwise an error containing failure details, which may be the following:
+	// - solace/errors.*PubSubPlusClientError - If a connection error occurs.
+	// - solace/errors.*IllegalStateError - If the parent MessagingService is not connected.
+	Connect() error
+
+	// Disconnect disconnects the transactional messaging service. All publishers and receivers
+	// created from the service are terminated and any uncommitted transaction is rolled back.
+	// This function is idempotent.
+	Disconnect() error
+
+	// IsConnected determines if the transactional messaging service is connected.
+	// Returns true if connected, otherwise false.
+	IsConnected() bool
+
+	// CreateTransactionalMessagePublisherBuilder creates a TransactionalMessagePublisherBuilder
+	// that can be used to configure transactional message publisher instances.
+	CreateTransactionalMessagePublisherBuilder() TransactionalMessagePublisherBuilder
+
+	// CreateTransactionalMessageReceiverBuilder creates a TransactionalMessageReceiverBuilder
+	// that can be used to configure transactional message receiver instances.
+	CreateTransactionalMessageReceiverBuilder() TransactionalMessageReceiverBuilder
+
+	// Commit commits the current transaction, delivering all messages published and
+	// acknowledging all messages received within it. A new transaction is started afterwards.
+	// This function blocks until the broker confirms the outcome of the commit.
+	// Returns nil if successful, otherwise an error containing failure details, which may be the following:
+	// - solace/errors.*NativeError - If the transaction was rolled back by the broker instead of being
+	//   committed. The subcode of the error indicates the reason for the rollback, for example
+	//   subcode.TransactionFailure or subcode.CommitStatusUnknown.
+	// - solace/errors.*IllegalStateError - If the service is not connected.
+	Commit() error
+
+	// Rollback rolls back the current transaction, discarding all messages published and
+	// redelivering all messages received within it. A new transaction is started afterwards.
+	// This function blocks until the broker confirms the rollback.
+	// Returns nil if successful, otherwise an error containing failure details, which may be the following:
+	// - solace/errors.*PubSubPlusClientError - If the rollback could not be completed.
+	// - solace/errors.*IllegalStateError - If the service is not connected.
+	Rollback() error
+}
+
+// TransactionalMessagingServiceBuilder is used to configure and build TransactionalMessagingService instances.
+type TransactionalMessagingServiceBuilder interface {
+	// Build creates a TransactionalMessagingService based on the provided configuration.
+	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+	Build() (transactionalMessagingService TransactionalMessagingService, err error)
+
+	// WithRequestTimeout sets the maximum time to wait for the broker to respond to
+	// transaction requests such as Commit and Rollback. The minimum timeout is one second.
+	WithRequestTimeout(timeout time.Duration) TransactionalMessagingServiceBuilder
+}
+
+// TransactionalMessagePublisher allows for the publishing of persistent messages (guaranteed messages)
+// within the transaction of the TransactionalMessagingService that created it.
+// Messages can be published to a *resource.Topic or directly to a *resource.Queue. Any other
+// destination type results in a solace/errors.*IllegalArgumentError.
+type TransactionalMessagePublisher interface {
+	MessagePublisher
+
+	// StartAsyncCallback starts the TransactionalMessagePublisher asynchronously.
+	// Calls the callback when started with an error if one occurred, otherwise nil
+	// when successful.
+	StartAsyncCallback(callback func(TransactionalMessagePublisher, error))
+
+	// TerminateAsyncCallback terminates the TransactionalMessagePublisher asynchronously.
+	// Calls the callback when terminated with nil if successful, otherwise an error if
+	// one occurred. When gracePeriod is a value less than 0, the function waits indefinitely.
+	TerminateAsyncCallback(gracePeriod time.Duration, callback func(error))
+
+	// PublishBytes sends a message of type byte array to the specified destination
+	// within the current transaction.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
+	// Possible errors include:
+	// - solace/errors.*PubSubPlusClientError - If the message could not be sent.
+	// - solace/errors.*PublisherOverflowError - If the transacted session cannot currently accept messages.
+	PublishBytes(message []byte, destination resource.Destination) error
+
+	// PublishString sends a message of type string to the specified destination
+	// within the current transaction.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
+	// Possible errors include:
+	// - solace/errors.*PubSubPlusClientError - If the message could not be sent.
+	// - solace/errors.*PublisherOverflowError - If the transacted session cannot currently accept messages.
+	PublishString(message string, destination resource.Destination) error
+
+	// Publish sends the specified message of type OutboundMessage built by a
+	// OutboundMessageBuilder to the specified destination within the current transaction.
+	// The destination can be either a *resource.Topic or a *resource.Queue.
+	// Optionally, you can provide properties in the form of OutboundMessageProperties to override
+	// any properties set on OutboundMessage. The properties argument can be nil to
+	// not set any properties. Possible errors include:
+	// - solace/errors.*PubSubPlusClientError - If the message could not be sent.
+	// - solace/errors.*PublisherOverflowError - If the transacted session cannot currently accept messages.
+	Publish(message message.OutboundMessage, destination resource.Destination, properties config.MessagePropertiesConfigurationProvider) error
+}
+
+// TransactionalMessagePublisherBuilder allows for configuration of transactional message publisher instances.
+type TransactionalMessagePublisherBuilder interface {
+	// Build returns a new TransactionalMessagePublisher based on the configured properties.
+	// Returns solace/errors.*IllegalStateError if the TransactionalMessagingService is not connected.
+	Build() (messagePublisher TransactionalMessagePublisher, err error)
+}
+
+// TransactionalMessageReceiver allows for receiving persistent messages (guaranteed messages)
+// within the transaction of the TransactionalMessagingService that created it.
+// Received messages are acknowledged when the transaction is committed, and are redelivered
+// when the transaction is rolled back.
+type TransactionalMessageReceiver interface {
+	// Extend LifecycleControl for various lifecycle management functionality
+	LifecycleControl
+	PayloadDecoder
+
+	// StartAsyncCallback starts the TransactionalMessageReceiver asynchronously.
+	// Calls the callback when started with an error if one occurred, otherwise nil
+	// if successful.
+	StartAsyncCallback(callback func(TransactionalMessageReceiver, error))
+
+	// TerminateAsyncCallback terminates the TransactionalMessageReceiver asynchronously.
+	// Calls the callback when terminated with nil if successful, otherwise an error if
+	// one occurred. If gracePeriod is less than 0, the function waits indefinitely.
+	TerminateAsyncCallback(gracePeriod time.Duration, callback func(error))
+
+	// Metrics returns the metrics of this receiver, such as the number of messages received and
+	// acknowledged. The metrics are maintained alongside the APIMetrics of the MessagingService
+	// the receiver was built from.
+	Metrics() metrics.ReceiverMetrics
+
+	// ReceiveAsync registers a callback to be called when new messages
+	// are received. Returns an error if one occurred while registering the callback.
+	// If a callback is already registered, it is replaced by the specified
+	// callback.
+	ReceiveAsync(callback MessageHandler) error
+
+	// ReceiveMessage receives a message synchronously from the receiver.
+	// Returns an error if the receiver is not started or already terminated.
+	// This function waits until the specified timeout to receive a message or waits
+	// forever if timeout value is negative. If a timeout occurs, a solace.TimeoutError
+	// is returned.
+	ReceiveMessage(timeout time.Duration) (message.InboundMessage, error)
+
+	// ReceiveMessageWithContext receives a message synchronously from the receiver.
+	// Returns an error if the receiver is not started or already terminated.
+	// This function waits until a message is received or the specified context is done,
+	// in which case ctx.Err() is returned.
+	ReceiveMessageWithContext(ctx context.Context) (message.InboundMessage, error)
+
+	// Pause pauses the receiver's message delivery to asynchronous message handlers.
+	// Pausing an already paused receiver has no effect.
+	// Returns an IllegalStateError if the receiver has not started or has already terminated.
+	Pause() error
+
+	// Resume unpause the receiver's message delivery to asynchronous message handlers.
+	// Resume a receiver that is not paused has no effect.
+	// Returns an IllegalStateError if the receiver has not started or has already terminated.
+	Resume() error
+
+	// ReceiverInfo returns a runtime accessor for the receiver information such as the remote
+	// resource to which it connects.
+	// Returns an IllegalStateError if the receiver has not started or has already terminated.
+	ReceiverInfo() (info PersistentReceiverInfo, err error)
+}
+
+// TransactionalMessageReceiverBuilder is used for configuration of TransactionalMessageReceiver.
+type TransactionalMessageReceiverBuilder interface {
+	// Build creates a TransactionalMessageReceiver bound to the specified queue with the specified properties.
+	// Returns solace/errors.*IllegalArgumentError if the queue is nil.
+	// Returns solace/errors.*IllegalStateError if the TransactionalMessagingService is not connected.
+	// Returns solace/errors.*InvalidConfigurationError if an invalid configuration is provided.
+	Build(queue *resource.Queue) (receiver TransactionalMessageReceiver, err error)
+
+	// WithMessageSelector sets the message selector to the specified string.
+	// If an empty string is provided, the filter is cleared.
+	WithMessageSelector(filterSelectorExpression string) TransactionalMessageReceiverBuilder
+	// WithCodec registers the specified codec to decode message payloads with DecodePayload.
+	// Codecs are matched by the HTTP content type or application message type of a message.
+	// Multiple codecs can be registered with different content types.
+	WithCodec(codec Codec) TransactionalMessageReceiverBuilder
+
+	// WithFlowWindowSize sets the maximum number of messages that can be in transit from the broker
+	// to the receiver before they are delivered to the application.
+	// The valid range is 1 to 255. The default is 255.
+	WithFlowWindowSize(windowSize uint) TransactionalMessageReceiverBuilder
+
+	// WithMissingResourcesCreationStrategy sets the missing resource creation strategy
+	// defining what actions the API may take when missing resources are detected.
+	WithMissingResourcesCreationStrategy(strategy config.MissingResourcesCreationStrategy) TransactionalMessageReceiverBuilder
+
+	// FromConfigurationProvider configures the transactional receiver with the specified properties.
+	// The built-in ReceiverPropertiesConfigurationProvider implementations include:
+	//   ReceiverPropertyMap, a map of ReceiverProperty keys to values
+	// Only the message selector, flow window size and missing resources creation strategy
+	// properties are applied to transactional receivers.
+	FromConfigurationProvider(provider config.ReceiverPropertiesConfigurationProvider) TransactionalMessageReceiverBuilder
+}